@@ -186,10 +186,14 @@ func SerializeMiddlewareResponseIntoJson(responseContainer interface{}) (jsonRes
 func WriteMiddlewareResponseHeadersAndBody(grpcResp *http.Response, responseJson []byte, w http.ResponseWriter) ErrorJson {
 	var statusCodeHeader string
 	for h, vs := range grpcResp.Header {
-		// We don't want to expose any gRPC metadata in the HTTP response, so we skip forwarding metadata headers.
+		// We don't want to expose gRPC metadata in the HTTP response, so we only forward the metadata headers meant for clients.
 		if strings.HasPrefix(h, "Grpc-Metadata") {
-			if h == "Grpc-Metadata-"+grpc.HttpCodeMetadataKey {
+			switch h {
+			case "Grpc-Metadata-" + grpc.HttpCodeMetadataKey:
 				statusCodeHeader = vs[0]
+			case "Grpc-Metadata-" + grpc.PrunedSlotMetadataKey, "Grpc-Metadata-" + grpc.PruneTargetSlotMetadataKey,
+				"Grpc-Metadata-" + grpc.BackfillSlotMetadataKey, "Grpc-Metadata-" + grpc.BackfillOriginSlotMetadataKey:
+				w.Header().Set(strings.TrimPrefix(h, "Grpc-Metadata-"), vs[0])
 			}
 		} else {
 			for _, v := range vs {
@@ -318,6 +318,49 @@ func TestWriteMiddlewareResponseHeadersAndBody(t *testing.T) {
 		assert.Equal(t, 204, writer.Code)
 	})
 
+	t.Run("GET_prune_status_headers", func(t *testing.T) {
+		response := &http.Response{
+			Header: http.Header{
+				"Grpc-Metadata-" + grpc.PrunedSlotMetadataKey:      []string{"64"},
+				"Grpc-Metadata-" + grpc.PruneTargetSlotMetadataKey: []string{"128"},
+				"Grpc-Metadata-Foo": []string{"foo"},
+			},
+			StatusCode: 200,
+		}
+		container := defaultResponseContainer()
+		responseJson, err := json.Marshal(container)
+		require.NoError(t, err)
+		writer := httptest.NewRecorder()
+
+		errJson := WriteMiddlewareResponseHeadersAndBody(response, responseJson, writer)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, "64", writer.Header().Get(grpc.PrunedSlotMetadataKey))
+		assert.Equal(t, "128", writer.Header().Get(grpc.PruneTargetSlotMetadataKey))
+		_, ok := writer.Header()["Foo"]
+		assert.Equal(t, false, ok)
+		_, ok = writer.Header()["Grpc-Metadata-Foo"]
+		assert.Equal(t, false, ok)
+	})
+
+	t.Run("GET_backfill_status_headers", func(t *testing.T) {
+		response := &http.Response{
+			Header: http.Header{
+				"Grpc-Metadata-" + grpc.BackfillSlotMetadataKey:       []string{"64"},
+				"Grpc-Metadata-" + grpc.BackfillOriginSlotMetadataKey: []string{"128"},
+			},
+			StatusCode: 200,
+		}
+		container := defaultResponseContainer()
+		responseJson, err := json.Marshal(container)
+		require.NoError(t, err)
+		writer := httptest.NewRecorder()
+
+		errJson := WriteMiddlewareResponseHeadersAndBody(response, responseJson, writer)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, "64", writer.Header().Get(grpc.BackfillSlotMetadataKey))
+		assert.Equal(t, "128", writer.Header().Get(grpc.BackfillOriginSlotMetadataKey))
+	})
+
 	t.Run("GET_invalid_status_code", func(t *testing.T) {
 		response := &http.Response{
 			Header: http.Header{},
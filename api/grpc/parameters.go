@@ -6,3 +6,19 @@ const CustomErrorMetadataKey = "Custom-Error"
 
 // HttpCodeMetadataKey is the key to use when setting custom HTTP status codes in gRPC metadata.
 const HttpCodeMetadataKey = "X-Http-Code"
+
+// PrunedSlotMetadataKey is the key to use when reporting in gRPC metadata the slot below which
+// the database history was pruned. The API middleware forwards it as an HTTP header.
+const PrunedSlotMetadataKey = "X-Pruned-Slot"
+
+// PruneTargetSlotMetadataKey is the key to use when reporting in gRPC metadata the slot below which
+// the database history is to be pruned. The API middleware forwards it as an HTTP header.
+const PruneTargetSlotMetadataKey = "X-Prune-Target-Slot"
+
+// BackfillSlotMetadataKey is the key to use when reporting in gRPC metadata the slot of the lowest block
+// backfilled below the checkpoint sync origin. The API middleware forwards it as an HTTP header.
+const BackfillSlotMetadataKey = "X-Backfill-Slot"
+
+// BackfillOriginSlotMetadataKey is the key to use when reporting in gRPC metadata the slot of the checkpoint
+// sync origin, from which blocks are backfilled. The API middleware forwards it as an HTTP header.
+const BackfillOriginSlotMetadataKey = "X-Backfill-Origin-Slot"
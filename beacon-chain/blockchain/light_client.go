@@ -0,0 +1,148 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	lightclient "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+)
+
+// LightClientFetcher retrieves the latest light client updates derived by the beacon node.
+type LightClientFetcher interface {
+	LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate
+	LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate
+}
+
+// LightClientFinalityUpdate returns the latest light client finality update, or nil if there is none.
+func (s *Service) LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.lightClientFinalityUpdate
+}
+
+// LightClientOptimisticUpdate returns the latest light client optimistic update, or nil if there is none.
+func (s *Service) LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.lightClientOptimisticUpdate
+}
+
+// processLightClientUpdates derives the light client update signed by the sync aggregate of the block.
+// The update is saved as the best update of its sync committee period if it is better than the one in the DB,
+// and it replaces the latest finality and optimistic updates when it is more recent than them.
+func (s *Service) processLightClientUpdates(ctx context.Context, signed interfaces.SignedBeaconBlock) error {
+	if signed.Version() == version.Phase0 {
+		return nil
+	}
+	syncAggregate, err := signed.Block().Body().SyncAggregate()
+	if err != nil {
+		return errors.Wrap(err, "could not get sync aggregate")
+	}
+	if syncAggregate.SyncCommitteeBits.Count() < lightclient.MinSyncCommitteeParticipants {
+		return nil
+	}
+
+	attestedRoot := signed.Block().ParentRoot()
+	attestedBlock, err := s.getBlock(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested block")
+	}
+	if attestedBlock.Version() == version.Phase0 {
+		return nil
+	}
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested state")
+	}
+	var finalizedBlock interfaces.SignedBeaconBlock
+	finalizedRoot := attestedState.FinalizedCheckpoint().Root
+	if bytes.Equal(finalizedRoot, params.BeaconConfig().ZeroHash[:]) {
+		finalizedBlock, err = s.cfg.BeaconDB.GenesisBlock(ctx)
+	} else {
+		finalizedBlock, err = s.cfg.BeaconDB.Block(ctx, bytesutil.ToBytes32(finalizedRoot))
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+
+	update, err := lightclient.NewLightClientUpdate(ctx, signed, attestedState, attestedBlock, finalizedBlock)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client update")
+	}
+
+	if err := s.saveBestLightClientUpdate(ctx, update); err != nil {
+		return err
+	}
+
+	finalityUpdate, optimisticUpdate := s.setLatestLightClientUpdates(update)
+	// Only updates of the current slot are forwarded, as peers ignore the ones received too late.
+	if signed.Block().Slot() != s.CurrentSlot() {
+		return nil
+	}
+	if finalityUpdate != nil {
+		if err := s.cfg.P2p.Broadcast(ctx, finalityUpdate); err != nil {
+			return errors.Wrap(err, "could not broadcast light client finality update")
+		}
+	}
+	if optimisticUpdate != nil {
+		if err := s.cfg.P2p.Broadcast(ctx, optimisticUpdate); err != nil {
+			return errors.Wrap(err, "could not broadcast light client optimistic update")
+		}
+	}
+	return nil
+}
+
+// saveBestLightClientUpdate saves the update as the best update of its sync committee period if it is better than
+// the one in the DB. The lock is held from the read to the save, so that blocks processed concurrently cannot
+// overwrite a better update.
+func (s *Service) saveBestLightClientUpdate(ctx context.Context, update *ethpb.LightClientUpdate) error {
+	s.lightClientLock.Lock()
+	defer s.lightClientLock.Unlock()
+
+	period := lightclient.UpdatePeriod(update)
+	best, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		return errors.Wrap(err, "could not get best light client update")
+	}
+	if best != nil && !lightclient.IsBetterUpdate(update, best) {
+		return nil
+	}
+	if err := s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update); err != nil {
+		return errors.Wrap(err, "could not save light client update")
+	}
+	return nil
+}
+
+// setLatestLightClientUpdates replaces the latest finality and optimistic updates with the ones derived from
+// the update when they are more recent, and returns the ones that were replaced.
+func (s *Service) setLatestLightClientUpdates(update *ethpb.LightClientUpdate) (*ethpb.LightClientFinalityUpdate, *ethpb.LightClientOptimisticUpdate) {
+	s.lightClientLock.Lock()
+	defer s.lightClientLock.Unlock()
+
+	var finalityUpdate *ethpb.LightClientFinalityUpdate
+	if lightclient.IsFinalityUpdate(update) {
+		latest := s.lightClientFinalityUpdate
+		hasSupermajority := func(aggregate *ethpb.SyncAggregate) bool {
+			return aggregate.SyncCommitteeBits.Count()*3 >= aggregate.SyncCommitteeBits.Len()*2
+		}
+		if latest == nil ||
+			update.FinalizedHeader.Slot > latest.FinalizedHeader.Slot ||
+			(update.FinalizedHeader.Slot == latest.FinalizedHeader.Slot && hasSupermajority(update.SyncAggregate) && !hasSupermajority(latest.SyncAggregate)) {
+			finalityUpdate = lightclient.NewLightClientFinalityUpdate(update)
+			s.lightClientFinalityUpdate = finalityUpdate
+		}
+	}
+
+	var optimisticUpdate *ethpb.LightClientOptimisticUpdate
+	if s.lightClientOptimisticUpdate == nil || update.AttestedHeader.Slot > s.lightClientOptimisticUpdate.AttestedHeader.Slot {
+		optimisticUpdate = lightclient.NewLightClientOptimisticUpdate(update)
+		s.lightClientOptimisticUpdate = optimisticUpdate
+	}
+	return finalityUpdate, optimisticUpdate
+}
@@ -0,0 +1,93 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	testDB "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestService_processLightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	fcs := doublylinkedtree.New()
+	broadcaster := &mockBroadcaster{}
+	service, err := NewService(ctx,
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB, fcs)),
+		WithForkChoiceStore(fcs),
+		WithP2PBroadcaster(broadcaster),
+	)
+	require.NoError(t, err)
+	service.genesisTime = time.Now().Add(-2 * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+
+	genesis := util.NewBeaconBlockAltair()
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	attestedState, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, attestedState.SetSlot(1))
+	stRoot, err := attestedState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	attestedBlock := util.NewBeaconBlockAltair()
+	attestedBlock.Block.Slot = 1
+	attestedBlock.Block.ParentRoot = genesisRoot[:]
+	attestedBlock.Block.StateRoot = stRoot[:]
+	util.SaveBlock(t, ctx, beaconDB, attestedBlock)
+	attestedRoot, err := attestedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, attestedState, attestedRoot))
+
+	newBlock := func(participants uint64) *blocks.SignedBeaconBlock {
+		b := util.NewBeaconBlockAltair()
+		b.Block.Slot = 2
+		b.Block.ParentRoot = attestedRoot[:]
+		for i := uint64(0); i < participants; i++ {
+			b.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+		}
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		return blk.(*blocks.SignedBeaconBlock)
+	}
+
+	// Blocks without sync committee participation do not sign any update.
+	require.NoError(t, service.processLightClientUpdates(ctx, newBlock(0)))
+	update, err := beaconDB.LightClientUpdate(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, true, update == nil)
+	assert.Equal(t, false, broadcaster.broadcastCalled)
+
+	require.NoError(t, service.processLightClientUpdates(ctx, newBlock(10)))
+	update, err = beaconDB.LightClientUpdate(ctx, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.Equal(t, uint64(10), update.SyncAggregate.SyncCommitteeBits.Count())
+	assert.Equal(t, types.Slot(1), update.AttestedHeader.Slot)
+	require.NotNil(t, service.LightClientFinalityUpdate())
+	require.NotNil(t, service.LightClientOptimisticUpdate())
+	assert.Equal(t, types.Slot(2), service.LightClientOptimisticUpdate().SignatureSlot)
+	assert.Equal(t, true, broadcaster.broadcastCalled)
+
+	// A better update replaces the best update of the period, a worse one does not.
+	require.NoError(t, service.processLightClientUpdates(ctx, newBlock(400)))
+	require.NoError(t, service.processLightClientUpdates(ctx, newBlock(20)))
+	update, err = beaconDB.LightClientUpdate(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(400), update.SyncAggregate.SyncCommitteeBits.Count())
+	// The latest finality update is replaced when it reaches a supermajority for the same finalized header.
+	assert.Equal(t, uint64(400), service.LightClientFinalityUpdate().SyncAggregate.SyncCommitteeBits.Count())
+	// The latest optimistic update is only replaced by updates of more recent attested headers.
+	assert.Equal(t, uint64(10), service.LightClientOptimisticUpdate().SyncAggregate.SyncCommitteeBits.Count())
+}
@@ -217,6 +217,16 @@ func (s *Service) onBlock(ctx context.Context, signed interfaces.SignedBeaconBlo
 		},
 	})
 
+	// Deriving light client updates requires the attested state, which is expensive to fetch and
+	// is done in the background to avoid adding more load to this critical code path.
+	if features.Get().EnableLightClient {
+		go func() {
+			if err := s.processLightClientUpdates(s.ctx, signed); err != nil {
+				log.WithError(err).Debug("Could not process light client updates")
+			}
+		}()
+	}
+
 	// Updating next slot state cache can happen in the background. It shouldn't block rest of the process.
 	go func() {
 		// Use a custom deadline here, since this method runs asynchronously.
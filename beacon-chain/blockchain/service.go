@@ -44,21 +44,24 @@ import (
 // Service represents a service that handles the internal
 // logic of managing the full PoS beacon chain.
 type Service struct {
-	cfg                     *config
-	ctx                     context.Context
-	cancel                  context.CancelFunc
-	genesisTime             time.Time
-	head                    *head
-	headLock                sync.RWMutex
-	originBlockRoot         [32]byte // genesis root, or weak subjectivity checkpoint root, depending on how the node is initialized
-	nextEpochBoundarySlot   types.Slot
-	boundaryRoots           [][32]byte
-	checkpointStateCache    *cache.CheckpointStateCache
-	initSyncBlocks          map[[32]byte]interfaces.SignedBeaconBlock
-	initSyncBlocksLock      sync.RWMutex
-	justifiedBalances       *stateBalanceCache
-	wsVerifier              *WeakSubjectivityVerifier
-	processAttestationsLock sync.Mutex
+	cfg                         *config
+	ctx                         context.Context
+	cancel                      context.CancelFunc
+	genesisTime                 time.Time
+	head                        *head
+	headLock                    sync.RWMutex
+	originBlockRoot             [32]byte // genesis root, or weak subjectivity checkpoint root, depending on how the node is initialized
+	nextEpochBoundarySlot       types.Slot
+	boundaryRoots               [][32]byte
+	checkpointStateCache        *cache.CheckpointStateCache
+	initSyncBlocks              map[[32]byte]interfaces.SignedBeaconBlock
+	initSyncBlocksLock          sync.RWMutex
+	justifiedBalances           *stateBalanceCache
+	wsVerifier                  *WeakSubjectivityVerifier
+	processAttestationsLock     sync.Mutex
+	lightClientLock             sync.RWMutex
+	lightClientFinalityUpdate   *ethpb.LightClientFinalityUpdate
+	lightClientOptimisticUpdate *ethpb.LightClientOptimisticUpdate
 }
 
 // config options for the service.
@@ -189,6 +192,11 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 	if err := s.initializeHeadFromDB(s.ctx); err != nil {
 		return errors.Wrap(err, "could not set up chain info")
 	}
+	headState, err := s.HeadState(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	s.cfg.AttService.SetHeadState(headState)
 	spawnCountdownIfPreGenesis(s.ctx, s.genesisTime, s.cfg.BeaconDB)
 
 	justified, err := s.cfg.BeaconDB.JustifiedCheckpoint(s.ctx)
@@ -412,6 +420,7 @@ func (s *Service) initializeBeaconChain(
 	}
 
 	s.cfg.AttService.SetGenesisTime(genesisState.GenesisTime())
+	s.cfg.AttService.SetHeadState(genesisState)
 
 	return genesisState, nil
 }
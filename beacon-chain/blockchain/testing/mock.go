@@ -64,6 +64,8 @@ type ChainService struct {
 	ReceiveBlockMockErr         error
 	OptimisticCheckRootReceived [32]byte
 	FinalizedRoots              map[[32]byte]bool
+	FinalityUpdate              *ethpb.LightClientFinalityUpdate
+	OptimisticUpdate            *ethpb.LightClientOptimisticUpdate
 }
 
 // ForkChoicer mocks the same method in the chain service
@@ -470,3 +472,13 @@ func (s *ChainService) ReceiveAttesterSlashing(context.Context, *ethpb.AttesterS
 func (s *ChainService) IsFinalized(_ context.Context, blockRoot [32]byte) bool {
 	return s.FinalizedRoots[blockRoot]
 }
+
+// LightClientFinalityUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate {
+	return s.FinalityUpdate
+}
+
+// LightClientOptimisticUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate {
+	return s.OptimisticUpdate
+}
@@ -27,4 +27,18 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	relayHealthy = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "builder_relay_healthy",
+			Help: "Whether the last status check of a builder relay succeeded (1) or failed (0)",
+		},
+		[]string{"relay"},
+	)
+	relayGetHeaderCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_get_header_total",
+			Help: "The number of header requests to a builder relay, by result",
+		},
+		[]string{"relay", "result"},
+	)
 )
@@ -1,6 +1,8 @@
 package builder
 
 import (
+	"strings"
+
 	"github.com/prysmaticlabs/prysm/v3/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
@@ -12,17 +14,20 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var clients []builder.BuilderClient
+	for _, endpoint := range strings.Split(c.String(flags.MevRelayEndpoint.Name), ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
+		clients = append(clients, client)
 	}
 	opts := []Option{
-		WithBuilderClient(client),
+		WithBuilderClients(clients...),
 	}
 	return opts, nil
 }
@@ -30,7 +35,15 @@ func FlagOptions(c *cli.Context) ([]Option, error) {
 // WithBuilderClient sets the builder client for the beacon chain builder service.
 func WithBuilderClient(client builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = []builder.BuilderClient{client}
+		return nil
+	}
+}
+
+// WithBuilderClients sets the builder clients of all relays the beacon chain builder service fans out to.
+func WithBuilderClients(clients ...builder.BuilderClient) Option {
+	return func(s *Service) error {
+		s.cfg.builderClients = clients
 		return nil
 	}
 }
@@ -1,17 +1,26 @@
 package builder
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"math/big"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
-	v1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -20,54 +29,98 @@ import (
 // ErrNoBuilder is used when builder endpoint is not configured.
 var ErrNoBuilder = errors.New("builder endpoint not configured")
 
+// BidChecker checks a bid against the requirements of the proposer, returning an error if the bid can not be used.
+type BidChecker func(bid builder.SignedBid) error
+
 // BlockBuilder defines the interface for interacting with the block builder
 type BlockBuilder interface {
-	SubmitBlindedBlock(ctx context.Context, block *ethpb.SignedBlindedBeaconBlockBellatrix) (*v1.ExecutionPayload, error)
-	GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte) (*ethpb.SignedBuilderBid, error)
+	SubmitBlindedBlock(ctx context.Context, block interfaces.SignedBeaconBlock) (interfaces.ExecutionData, error)
+	GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte, check BidChecker) (builder.SignedBid, error)
 	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
+	SetValidatorRelays(ctx context.Context, idx types.ValidatorIndex, endpoints []string) error
 	Configured() bool
 }
 
+// getHeaderTimeout is the deadline, per slot, for relays to respond to a header request. Relays that have not
+// responded by then are left out of the bid selection.
+const getHeaderTimeout = 950 * time.Millisecond
+
+// headerSourceRetention is the number of slots the service remembers which relay supplied a header for.
+const headerSourceRetention = types.Slot(32)
+
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients []builder.BuilderClient
+	beaconDB       db.HeadAccessDatabase
+	headFetcher    blockchain.HeadFetcher
+}
+
+// relay is a builder relay the service fans requests out to, along with its last known health.
+type relay struct {
+	client  builder.BuilderClient
+	healthy bool
+}
+
+// headerSource records the relay that supplied the header with a given block hash.
+type headerSource struct {
+	relay *relay
+	slot  types.Slot
+}
+
+// relayChoice is the relays a validator chose, along with the endpoints it chose them with.
+type relayChoice struct {
+	endpoints []string
+	relays    []*relay
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
-	cfg    *config
-	c      builder.BuilderClient
-	ctx    context.Context
-	cancel context.CancelFunc
+	cfg           *config
+	relays        []*relay
+	headerSources map[[32]byte]headerSource
+	relayChoices  map[types.ValidatorIndex]*relayChoice
+	lock          sync.RWMutex
+	ctx           context.Context
+	cancel        context.CancelFunc
 }
 
 // NewService instantiates a new service.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:           ctx,
+		cancel:        cancel,
+		cfg:           &config{},
+		headerSources: make(map[[32]byte]headerSource),
+		relayChoices:  make(map[types.ValidatorIndex]*relayChoice),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		r := &relay{client: c}
+		s.relays = append(s.relays, r)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
-		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+		s.updateRelayHealth(ctx, r)
+		if r.healthy {
+			log.WithField("endpoint", c.NodeURL()).Info("Builder has been configured")
+		}
+	}
+	if len(s.relays) > 0 && s.cfg.beaconDB != nil {
+		if err := s.loadRelayChoices(ctx); err != nil {
+			return nil, err
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -81,8 +134,10 @@ func (*Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
-func (s *Service) SubmitBlindedBlock(ctx context.Context, b *ethpb.SignedBlindedBeaconBlockBellatrix) (*v1.ExecutionPayload, error) {
+// SubmitBlindedBlock submits a blinded block to the relay that supplied its execution payload header. When that
+// relay is not known, such as after a restart, the block is submitted to all relays and the first payload returned
+// is used, as only the relay that supplied the header can return it.
+func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.SignedBeaconBlock) (interfaces.ExecutionData, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
 	start := time.Now()
@@ -90,11 +145,45 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b *ethpb.SignedBlinded
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+	relays, err := s.relaysForBlock(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(relays) == 1 {
+		return relays[0].client.SubmitBlindedBlock(ctx, b)
+	}
+
+	type relayPayload struct {
+		relay   *relay
+		payload interfaces.ExecutionData
+		err     error
+	}
+	payloads := make(chan relayPayload, len(relays))
+	for _, r := range relays {
+		go func(r *relay) {
+			payload, err := r.client.SubmitBlindedBlock(ctx, b)
+			payloads <- relayPayload{relay: r, payload: payload, err: err}
+		}(r)
+	}
+	var lastErr error
+	for range relays {
+		rp := <-payloads
+		if rp.err != nil {
+			lastErr = rp.err
+			log.WithError(rp.err).WithField("endpoint", rp.relay.client.NodeURL()).Debug("Relay did not return the payload of the blinded block")
+			continue
+		}
+		return rp.payload, nil
+	}
+	return nil, errors.Wrap(lastErr, "no relay returned the payload of the blinded block")
 }
 
-// GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
-func (s *Service) GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte) (*ethpb.SignedBuilderBid, error) {
+// GetHeader requests a header for a given slot and parent hash from the healthy relays of the proposer in parallel
+// and returns the highest valid bid which passes the given check, if any. Relays which do not respond within the
+// slot deadline are ignored.
+func (s *Service) GetHeader(
+	ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte, check BidChecker,
+) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
 	start := time.Now()
@@ -102,21 +191,105 @@ func (s *Service) GetHeader(ctx context.Context, slot types.Slot, parentHash [32
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
-	return s.c.GetHeader(ctx, slot, parentHash, pubKey)
+	relays := s.activeRelays(s.relaysByPubKey(pubKey))
+	if len(relays) == 0 {
+		return nil, ErrNoBuilder
+	}
+	ctx, cancel := context.WithTimeout(ctx, getHeaderTimeout)
+	defer cancel()
+
+	type relayBid struct {
+		relay     *relay
+		bid       builder.SignedBid
+		err       error
+		value     *big.Int
+		blockHash [32]byte
+	}
+	bids := make(chan relayBid, len(relays))
+	for _, r := range relays {
+		go func(r *relay) {
+			bid, err := r.client.GetHeader(ctx, slot, parentHash, pubKey)
+			bids <- relayBid{relay: r, bid: bid, err: err}
+		}(r)
+	}
+
+	var (
+		valid   []relayBid
+		lastErr error
+	)
+	responses := 0
+collect:
+	for responses < len(relays) {
+		var rb relayBid
+		select {
+		case rb = <-bids:
+			responses++
+		case <-ctx.Done():
+			log.WithField("missing", len(relays)-responses).Warn("Relays did not respond with a header before the deadline")
+			break collect
+		}
+		endpoint := rb.relay.client.NodeURL()
+		if rb.err != nil {
+			lastErr = rb.err
+			relayGetHeaderCount.WithLabelValues(endpoint, "error").Inc()
+			log.WithError(rb.err).WithField("endpoint", endpoint).Warn("Could not get header from relay")
+			continue
+		}
+		value, blockHash, err := validateBid(rb.bid, parentHash)
+		if err != nil {
+			lastErr = err
+			relayGetHeaderCount.WithLabelValues(endpoint, "invalid").Inc()
+			log.WithError(err).WithField("endpoint", endpoint).Warn("Relay returned an invalid bid")
+			continue
+		}
+		relayGetHeaderCount.WithLabelValues(endpoint, "valid").Inc()
+		rb.value, rb.blockHash = value, blockHash
+		valid = append(valid, rb)
+	}
+
+	// The highest bid the proposer can use is selected.
+	sort.SliceStable(valid, func(i, j int) bool {
+		return valid[i].value.Cmp(valid[j].value) > 0
+	})
+	for _, rb := range valid {
+		endpoint := rb.relay.client.NodeURL()
+		if check != nil {
+			if err := check(rb.bid); err != nil {
+				lastErr = err
+				log.WithError(err).WithFields(log.Fields{
+					"endpoint": endpoint,
+					"value":    rb.value.String(),
+				}).Warn("Relay bid does not meet the proposer requirements")
+				continue
+			}
+		}
+		s.recordHeaderSource(rb.blockHash, rb.relay, slot)
+		log.WithFields(log.Fields{
+			"endpoint":  endpoint,
+			"value":     rb.value.String(),
+			"blockHash": fmt.Sprintf("%#x", rb.blockHash),
+			"relays":    len(relays),
+		}).Debug("Selected highest relay bid")
+		return rb.bid, nil
+	}
+	if lastErr != nil {
+		return nil, errors.Wrap(lastErr, "could not get a valid bid from any relay")
+	}
+	return nil, errors.New("no relay returned a bid")
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil
 	}
 
 	return nil
 }
 
-// RegisterValidator registers a validator with the builder relay network.
-// It also saves the registration object to the DB.
+// RegisterValidator registers validators with their relays, which are all the relays of the builder relay
+// network unless they chose some with SetValidatorRelays. It also saves the registration objects to the DB.
 func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
 	defer span.End()
@@ -127,7 +300,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 
 	idxs := make([]types.ValidatorIndex, 0)
 	msgs := make([]*ethpb.ValidatorRegistrationV1, 0)
-	valid := make([]*ethpb.SignedValidatorRegistrationV1, 0)
+	byRelay := make(map[*relay][]*ethpb.SignedValidatorRegistrationV1)
 	for i := 0; i < len(reg); i++ {
 		r := reg[i]
 		nx, exists := s.cfg.headFetcher.HeadPublicKeyToValidatorIndex(bytesutil.ToBytes48(r.Message.Pubkey))
@@ -137,20 +310,232 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 			log.Warnf("Skipping validator registration for pubkey=%#x - not in current validator set.", r.Message.Pubkey)
 			continue
 		}
+		relays := s.relaysByIndex(nx)
+		if len(relays) == 0 {
+			log.Warnf("Skipping validator registration for pubkey=%#x - none of its relays is configured.", r.Message.Pubkey)
+			continue
+		}
 		idxs = append(idxs, nx)
 		msgs = append(msgs, r.Message)
-		valid = append(valid, r)
+		for _, rl := range relays {
+			byRelay[rl] = append(byRelay[rl], r)
+		}
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := s.registerWithRelays(ctx, byRelay); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
 	return s.cfg.beaconDB.SaveRegistrationsByValidatorIDs(ctx, idxs, msgs)
 }
 
+// registerWithRelays sends the registrations of each relay to it, to all relays in parallel. It only fails if
+// no relay accepted its registrations.
+func (s *Service) registerWithRelays(ctx context.Context, reg map[*relay][]*ethpb.SignedValidatorRegistrationV1) error {
+	errs := make([]error, len(s.relays))
+	var wg sync.WaitGroup
+	for i, r := range s.relays {
+		if len(reg[r]) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, r *relay) {
+			defer wg.Done()
+			errs[i] = r.client.RegisterValidator(ctx, reg[r])
+		}(i, r)
+	}
+	wg.Wait()
+
+	var lastErr error
+	called, registered := 0, 0
+	for i, err := range errs {
+		// Relays without registrations to send were not called.
+		if len(reg[s.relays[i]]) == 0 {
+			continue
+		}
+		called++
+		if err != nil {
+			lastErr = err
+			log.WithError(err).WithField("endpoint", s.relays[i].client.NodeURL()).Error("Could not register validator(s) with relay")
+			continue
+		}
+		registered++
+	}
+	if called > 0 && registered == 0 {
+		return lastErr
+	}
+	return nil
+}
+
 // Configured returns true if the user has configured a builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
+}
+
+// SetValidatorRelays sets the relays a validator registers with and gets headers from to the relays with the
+// given endpoints. Endpoints the beacon node has no relay for are ignored, and the validator uses all relays
+// again if no endpoint is given. The choice is saved so that it survives restarts.
+func (s *Service) SetValidatorRelays(ctx context.Context, idx types.ValidatorIndex, endpoints []string) error {
+	s.lock.Lock()
+	changed := s.setRelayChoice(idx, endpoints)
+	s.lock.Unlock()
+	if !changed || s.cfg.beaconDB == nil {
+		return nil
+	}
+	return s.cfg.beaconDB.SaveValidatorRelays(ctx, idx, endpoints)
+}
+
+// loadRelayChoices restores the relays validators chose before the beacon node restarted, so that their
+// headers are not requested from relays they did not choose.
+func (s *Service) loadRelayChoices(ctx context.Context) error {
+	choices, err := s.cfg.beaconDB.ValidatorRelays(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not load validator relays")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for idx, endpoints := range choices {
+		s.setRelayChoice(idx, endpoints)
+	}
+	return nil
+}
+
+// setRelayChoice sets the relays chosen by a validator and returns whether they changed. The caller must hold
+// the lock.
+func (s *Service) setRelayChoice(idx types.ValidatorIndex, endpoints []string) bool {
+	prev, ok := s.relayChoices[idx]
+	if len(endpoints) == 0 {
+		delete(s.relayChoices, idx)
+		return ok
+	}
+	if ok && reflect.DeepEqual(prev.endpoints, endpoints) {
+		return false
+	}
+	relays := make([]*relay, 0, len(endpoints))
+	for _, r := range s.relays {
+		for _, e := range endpoints {
+			if sameEndpoint(r.client.NodeURL(), e) {
+				relays = append(relays, r)
+				break
+			}
+		}
+	}
+	if len(relays) < len(endpoints) {
+		log.WithFields(log.Fields{
+			"validatorIndex": idx,
+			"relays":         endpoints,
+			"configured":     len(relays),
+		}).Warn("Validator chose relays the beacon node is not configured with")
+	}
+	s.relayChoices[idx] = &relayChoice{endpoints: endpoints, relays: relays}
+	return true
+}
+
+// relaysByIndex returns the relays chosen by the validator with the given index, or all relays if it chose none.
+func (s *Service) relaysByIndex(idx types.ValidatorIndex) []*relay {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	choice, ok := s.relayChoices[idx]
+	if !ok {
+		return s.relays
+	}
+	return choice.relays
+}
+
+// relaysByPubKey returns the relays chosen by the validator with the given public key, or all relays if it
+// chose none.
+func (s *Service) relaysByPubKey(pubKey [48]byte) []*relay {
+	s.lock.RLock()
+	chosen := len(s.relayChoices) > 0
+	s.lock.RUnlock()
+	if !chosen || s.cfg.headFetcher == nil {
+		return s.relays
+	}
+	idx, ok := s.cfg.headFetcher.HeadPublicKeyToValidatorIndex(pubKey)
+	if !ok {
+		return s.relays
+	}
+	return s.relaysByIndex(idx)
+}
+
+// activeRelays returns the given relays that passed their last status check. If none did, all the given relays
+// are returned so that a failing status endpoint alone does not disable the builder.
+func (s *Service) activeRelays(relays []*relay) []*relay {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	active := make([]*relay, 0, len(relays))
+	for _, r := range relays {
+		if r.healthy {
+			active = append(active, r)
+		}
+	}
+	if len(active) == 0 {
+		return relays
+	}
+	return active
+}
+
+// sameEndpoint returns true if the relay URLs are the same, ignoring trailing slashes.
+func sameEndpoint(a, b string) bool {
+	return strings.TrimRight(a, "/") == strings.TrimRight(b, "/")
+}
+
+// recordHeaderSource remembers the relay that supplied the header with block hash `h` and prunes
+// entries that are too old to be submitted.
+func (s *Service) recordHeaderSource(h [32]byte, r *relay, slot types.Slot) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for k, v := range s.headerSources {
+		if v.slot+headerSourceRetention < slot {
+			delete(s.headerSources, k)
+		}
+	}
+	s.headerSources[h] = headerSource{relay: r, slot: slot}
+}
+
+// relaysForBlock returns the relay that supplied the execution payload header of a blinded block, or all relays
+// if it is not known.
+func (s *Service) relaysForBlock(b interfaces.SignedBeaconBlock) ([]*relay, error) {
+	if len(s.relays) == 0 {
+		return nil, ErrNoBuilder
+	}
+	if len(s.relays) == 1 {
+		return s.relays, nil
+	}
+	if err := consensusblocks.BeaconBlockIsNil(b); err != nil {
+		return nil, err
+	}
+	h, err := b.Block().Body().Execution()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get execution header")
+	}
+	blockHash := bytesutil.ToBytes32(h.BlockHash())
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	src, ok := s.headerSources[blockHash]
+	if !ok {
+		log.WithField("blockHash", fmt.Sprintf("%#x", blockHash)).Debug("Relay which supplied the header is not known, submitting the blinded block to all relays")
+		return s.relays, nil
+	}
+	return []*relay{src.relay}, nil
+}
+
+// updateRelayHealth calls the status endpoint of a relay and records the result.
+func (s *Service) updateRelayHealth(ctx context.Context, r *relay) {
+	err := r.client.Status(ctx)
+	endpoint := r.client.NodeURL()
+	s.lock.Lock()
+	wasHealthy := r.healthy
+	r.healthy = err == nil
+	s.lock.Unlock()
+	if err != nil {
+		relayHealthy.WithLabelValues(endpoint).Set(0)
+		log.WithError(err).WithField("endpoint", endpoint).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+		return
+	}
+	relayHealthy.WithLabelValues(endpoint).Set(1)
+	if !wasHealthy {
+		log.WithField("endpoint", endpoint).Debug("Relay is healthy")
+	}
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -159,13 +544,42 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
-				}
+			for _, r := range s.relays {
+				s.updateRelayHealth(ctx, r)
 			}
 		case <-ctx.Done():
 			return
 		}
 	}
 }
+
+// validateBid checks that a relay bid is well formed, builds on the requested parent and is signed by the builder.
+// It returns the value of the bid in wei and the block hash of its header.
+func validateBid(signedBid builder.SignedBid, parentHash [32]byte) (*big.Int, [32]byte, error) {
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, [32]byte{}, errors.New("nil bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not get bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not get bid header")
+	}
+	if !bytes.Equal(header.ParentHash(), parentHash[:]) {
+		return nil, [32]byte{}, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	value := bytesutil.LittleEndianBytesToBigInt(bid.Value())
+	if value.Sign() == 0 {
+		return nil, [32]byte{}, errors.New("bid with 0 value")
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil /* fork version */, nil /* genesis val root */)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	if err := signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d); err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "invalid bid signature")
+	}
+	return value, bytesutil.ToBytes32(header.BlockHash()), nil
+}
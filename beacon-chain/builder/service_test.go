@@ -1,16 +1,32 @@
 package builder
 
 import (
+	"bytes"
 	"context"
+	"flag"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/client/builder"
 	buildertesting "github.com/prysmaticlabs/prysm/v3/api/client/builder/testing"
 	blockchainTesting "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	dbtesting "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/cmd/beacon-chain/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	"github.com/urfave/cli/v2"
 )
 
 func Test_NewServiceWithBuilder(t *testing.T) {
@@ -37,3 +53,312 @@ func Test_RegisterValidator(t *testing.T) {
 	require.NoError(t, s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], FeeRecipient: feeRecipient[:]}}}))
 	assert.Equal(t, true, builder.RegisteredVals[pubkey])
 }
+
+type testRelay struct {
+	url         string
+	bid         builder.SignedBid
+	err         error
+	statusErr   error
+	registerErr error
+	submitErr   error
+	delay       time.Duration
+	submitted   int
+	registered  int
+}
+
+func (r *testRelay) NodeURL() string {
+	return r.url
+}
+
+func (r *testRelay) GetHeader(ctx context.Context, _ types.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+	select {
+	case <-time.After(r.delay):
+		return r.bid, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *testRelay) RegisterValidator(_ context.Context, _ []*eth.SignedValidatorRegistrationV1) error {
+	if r.registerErr != nil {
+		return r.registerErr
+	}
+	r.registered++
+	return nil
+}
+
+func (r *testRelay) SubmitBlindedBlock(_ context.Context, _ interfaces.SignedBeaconBlock) (interfaces.ExecutionData, error) {
+	r.submitted++
+	return nil, r.submitErr
+}
+
+func (r *testRelay) Status(_ context.Context) error {
+	return r.statusErr
+}
+
+func testSignedBid(t *testing.T, parentHash [32]byte, blockHash byte, value uint64) builder.SignedBid {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	bid := &eth.BuilderBid{
+		Header: &v1.ExecutionPayloadHeader{
+			ParentHash:       parentHash[:],
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        bytesutil.PadTo([]byte{blockHash}, fieldparams.RootLength),
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+		},
+		Value:  bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(value), 32),
+		Pubkey: sk.PublicKey().Marshal(),
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, d)
+	require.NoError(t, err)
+	signed, err := builder.WrappedSignedBuilderBid(&eth.SignedBuilderBid{Message: bid, Signature: sk.Sign(sr[:]).Marshal()})
+	require.NoError(t, err)
+	return signed
+}
+
+func testBlindedBlock(t *testing.T, blockHash byte) interfaces.SignedBeaconBlock {
+	b := util.NewBlindedBeaconBlockBellatrix()
+	b.Block.Body.ExecutionPayloadHeader.BlockHash = bytesutil.PadTo([]byte{blockHash}, fieldparams.RootLength)
+	wb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return wb
+}
+
+func Test_GetHeader_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'a'}
+	low := &testRelay{url: "low", bid: testSignedBid(t, parentHash, 1, 100)}
+	high := &testRelay{url: "high", bid: testSignedBid(t, parentHash, 2, 300)}
+	mid := &testRelay{url: "mid", bid: testSignedBid(t, parentHash, 3, 200)}
+	wrongParent := &testRelay{url: "wrong-parent", bid: testSignedBid(t, [32]byte{'b'}, 4, 1000)}
+	failing := &testRelay{url: "failing", err: errors.New("bad relay")}
+	slow := &testRelay{url: "slow", bid: testSignedBid(t, parentHash, 5, 2000), delay: 2 * getHeaderTimeout}
+	s, err := NewService(ctx, WithBuilderClients(low, high, mid, wrongParent, failing, slow))
+	require.NoError(t, err)
+
+	bid, err := s.GetHeader(ctx, 1, parentHash, [48]byte{}, nil)
+	require.NoError(t, err)
+	m, err := bid.Message()
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(300), 32), m.Value())
+
+	// The blinded block is only submitted to the relay which supplied its header.
+	_, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, 2))
+	require.NoError(t, err)
+	assert.Equal(t, 1, high.submitted)
+	assert.Equal(t, 0, low.submitted+mid.submitted+wrongParent.submitted+failing.submitted+slow.submitted)
+
+}
+
+func Test_SubmitBlindedBlock_UnknownHeaderSource(t *testing.T) {
+	ctx := context.Background()
+	a := &testRelay{url: "a", submitErr: errors.New("unknown payload")}
+	b := &testRelay{url: "b", submitErr: errors.New("unknown payload")}
+	s, err := NewService(ctx, WithBuilderClients(a, b))
+	require.NoError(t, err)
+
+	// The blinded block is submitted to all relays, none of which supplied its header.
+	_, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, 1))
+	require.ErrorContains(t, "no relay returned the payload of the blinded block", err)
+	assert.Equal(t, 1, a.submitted)
+	assert.Equal(t, 1, b.submitted)
+
+	b.submitErr = nil
+	_, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, 1))
+	require.NoError(t, err)
+}
+
+func Test_GetHeader_BidChecker(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'a'}
+	low := &testRelay{url: "low", bid: testSignedBid(t, parentHash, 1, 100)}
+	high := &testRelay{url: "high", bid: testSignedBid(t, parentHash, 2, 300)}
+	mid := &testRelay{url: "mid", bid: testSignedBid(t, parentHash, 3, 200)}
+	s, err := NewService(ctx, WithBuilderClients(low, high, mid))
+	require.NoError(t, err)
+
+	// The highest bid which passes the check of the proposer is selected.
+	rejected := bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(300), 32)
+	check := func(bid builder.SignedBid) error {
+		m, err := bid.Message()
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(m.Value(), rejected) {
+			return errors.New("bad gas limit")
+		}
+		return nil
+	}
+	bid, err := s.GetHeader(ctx, 1, parentHash, [48]byte{}, check)
+	require.NoError(t, err)
+	m, err := bid.Message()
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(200), 32), m.Value())
+	_, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, 3))
+	require.NoError(t, err)
+	assert.Equal(t, 1, mid.submitted)
+
+	_, err = s.GetHeader(ctx, 1, parentHash, [48]byte{}, func(builder.SignedBid) error {
+		return errors.New("bad gas limit")
+	})
+	require.ErrorContains(t, "bad gas limit", err)
+}
+
+func Test_GetHeader_NoValidBid(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx, WithBuilderClients(
+		&testRelay{url: "failing", err: errors.New("bad relay")},
+		&testRelay{url: "wrong-parent", bid: testSignedBid(t, [32]byte{'b'}, 1, 100)},
+	))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{'a'}, [48]byte{}, nil)
+	require.ErrorContains(t, "could not get a valid bid from any relay", err)
+}
+
+func Test_RegisterValidator_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	db := dbtesting.SetupDB(t)
+	headFetcher := &blockchainTesting.ChainService{}
+	ok := &testRelay{url: "ok"}
+	failing := &testRelay{url: "failing", registerErr: errors.New("bad relay")}
+	s, err := NewService(ctx, WithDatabase(db), WithHeadFetcher(headFetcher), WithBuilderClients(ok, failing))
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48([]byte("pubkey"))
+	var feeRecipient [20]byte
+	reg := []*eth.SignedValidatorRegistrationV1{{Message: &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], FeeRecipient: feeRecipient[:]}}}
+	require.NoError(t, s.RegisterValidator(ctx, reg))
+	assert.Equal(t, 1, ok.registered)
+
+	// Relays the validator does not use do not count as having accepted its registration.
+	require.NoError(t, s.SetValidatorRelays(ctx, 0, []string{"failing"}))
+	require.ErrorContains(t, "could not register validator(s)", s.RegisterValidator(ctx, reg))
+	assert.Equal(t, 1, ok.registered)
+
+	require.NoError(t, s.SetValidatorRelays(ctx, 0, nil))
+	ok.registerErr = errors.New("also bad")
+	require.ErrorContains(t, "could not register validator(s)", s.RegisterValidator(ctx, reg))
+}
+
+func Test_ValidatorRelays(t *testing.T) {
+	ctx := context.Background()
+	db := dbtesting.SetupDB(t)
+	// The head fetcher maps every public key to validator index 0.
+	headFetcher := &blockchainTesting.ChainService{}
+	parentHash := [32]byte{'a'}
+	a := &testRelay{url: "https://relay-a.example.com", bid: testSignedBid(t, parentHash, 1, 100)}
+	b := &testRelay{url: "https://relay-b.example.com", bid: testSignedBid(t, parentHash, 2, 300)}
+	s, err := NewService(ctx, WithDatabase(db), WithHeadFetcher(headFetcher), WithBuilderClients(a, b))
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48([]byte("pubkey"))
+	var feeRecipient [20]byte
+	reg := []*eth.SignedValidatorRegistrationV1{{Message: &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], FeeRecipient: feeRecipient[:]}}}
+
+	require.NoError(t, s.SetValidatorRelays(ctx, 0, []string{"https://relay-a.example.com/", "https://unknown.example.com"}))
+	require.NoError(t, s.RegisterValidator(ctx, reg))
+	assert.Equal(t, 1, a.registered)
+	assert.Equal(t, 0, b.registered)
+	bid, err := s.GetHeader(ctx, 1, parentHash, pubkey, nil)
+	require.NoError(t, err)
+	m, err := bid.Message()
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(100), 32), m.Value())
+
+	// Validators which only chose relays the beacon node does not have do not use the builder.
+	require.NoError(t, s.SetValidatorRelays(ctx, 0, []string{"https://unknown.example.com"}))
+	require.NoError(t, s.RegisterValidator(ctx, reg))
+	assert.Equal(t, 1, a.registered+b.registered)
+	_, err = s.GetHeader(ctx, 1, parentHash, pubkey, nil)
+	require.ErrorIs(t, err, ErrNoBuilder)
+
+	// Validators use all relays again when they no longer choose any.
+	require.NoError(t, s.SetValidatorRelays(ctx, 0, nil))
+	require.NoError(t, s.RegisterValidator(ctx, reg))
+	assert.Equal(t, 2, a.registered)
+	assert.Equal(t, 1, b.registered)
+	bid, err = s.GetHeader(ctx, 1, parentHash, pubkey, nil)
+	require.NoError(t, err)
+	m, err = bid.Message()
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(300), 32), m.Value())
+}
+
+func Test_ValidatorRelays_Restart(t *testing.T) {
+	ctx := context.Background()
+	db := dbtesting.SetupDB(t)
+	// The head fetcher maps every public key to validator index 0.
+	headFetcher := &blockchainTesting.ChainService{}
+	parentHash := [32]byte{'a'}
+	a := &testRelay{url: "https://relay-a.example.com", bid: testSignedBid(t, parentHash, 1, 100)}
+	b := &testRelay{url: "https://relay-b.example.com", bid: testSignedBid(t, parentHash, 2, 300)}
+	s, err := NewService(ctx, WithDatabase(db), WithHeadFetcher(headFetcher), WithBuilderClients(a, b))
+	require.NoError(t, err)
+	require.NoError(t, s.SetValidatorRelays(ctx, 0, []string{"https://relay-a.example.com"}))
+
+	// The relays chosen before a restart are used until the validator chooses again.
+	s, err = NewService(ctx, WithDatabase(db), WithHeadFetcher(headFetcher), WithBuilderClients(a, b))
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48([]byte("pubkey"))
+	bid, err := s.GetHeader(ctx, 1, parentHash, pubkey, nil)
+	require.NoError(t, err)
+	m, err := bid.Message()
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(100), 32), m.Value())
+
+	// Choosing no relays is saved as well.
+	require.NoError(t, s.SetValidatorRelays(ctx, 0, nil))
+	s, err = NewService(ctx, WithDatabase(db), WithHeadFetcher(headFetcher), WithBuilderClients(a, b))
+	require.NoError(t, err)
+	bid, err = s.GetHeader(ctx, 1, parentHash, pubkey, nil)
+	require.NoError(t, err)
+	m, err = bid.Message()
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(300), 32), m.Value())
+}
+
+func Test_RelayHealth(t *testing.T) {
+	ctx := context.Background()
+	healthy := &testRelay{url: "healthy"}
+	unhealthy := &testRelay{url: "unhealthy", statusErr: errors.New("down")}
+	s, err := NewService(ctx, WithBuilderClients(healthy, unhealthy))
+	require.NoError(t, err)
+	active := s.activeRelays(s.relays)
+	require.Equal(t, 1, len(active))
+	assert.Equal(t, "healthy", active[0].client.NodeURL())
+
+	// When no relay is healthy, all relays are used.
+	healthy.statusErr = errors.New("down")
+	for _, r := range s.relays {
+		s.updateRelayHealth(ctx, r)
+	}
+	assert.Equal(t, 2, len(s.activeRelays(s.relays)))
+
+	unhealthy.statusErr = nil
+	for _, r := range s.relays {
+		s.updateRelayHealth(ctx, r)
+	}
+	active = s.activeRelays(s.relays)
+	require.Equal(t, 1, len(active))
+	assert.Equal(t, "unhealthy", active[0].client.NodeURL())
+}
+
+func Test_FlagOptions_MultipleRelays(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.MevRelayEndpoint.Name, "http://relay-a.example.com, http://relay-b.example.com,", "")
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	opts, err := FlagOptions(cliCtx)
+	require.NoError(t, err)
+	s, err := NewService(context.Background(), opts...)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(s.relays))
+	assert.Equal(t, "http://relay-a.example.com", s.relays[0].client.NodeURL())
+	assert.Equal(t, "http://relay-b.example.com", s.relays[1].client.NodeURL())
+}
@@ -3,19 +3,28 @@ package testing
 import (
 	"context"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/client/builder"
+	buildersvc "github.com/prysmaticlabs/prysm/v3/beacon-chain/builder"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	v1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
 )
 
 // MockBuilderService to mock builder.
 type MockBuilderService struct {
 	HasConfigured         bool
 	Payload               *v1.ExecutionPayload
+	PayloadCapella        *v1.ExecutionPayloadCapella
 	ErrSubmitBlindedBlock error
 	Bid                   *ethpb.SignedBuilderBid
+	BidCapella            *ethpb.SignedBuilderBidCapella
 	ErrGetHeader          error
 	ErrRegisterValidator  error
+	ValidatorRelays       map[types.ValidatorIndex][]string
 }
 
 // Configured for mocking.
@@ -24,16 +33,64 @@ func (s *MockBuilderService) Configured() bool {
 }
 
 // SubmitBlindedBlock for mocking.
-func (s *MockBuilderService) SubmitBlindedBlock(context.Context, *ethpb.SignedBlindedBeaconBlockBellatrix) (*v1.ExecutionPayload, error) {
-	return s.Payload, s.ErrSubmitBlindedBlock
+func (s *MockBuilderService) SubmitBlindedBlock(_ context.Context, b interfaces.SignedBeaconBlock) (interfaces.ExecutionData, error) {
+	if s.ErrSubmitBlindedBlock != nil {
+		return nil, s.ErrSubmitBlindedBlock
+	}
+	switch b.Version() {
+	case version.Bellatrix:
+		w, err := blocks.WrappedExecutionPayload(s.Payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not wrap payload")
+		}
+		return w, nil
+	case version.Capella:
+		w, err := blocks.WrappedExecutionPayloadCapella(s.PayloadCapella)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not wrap capella payload")
+		}
+		return w, nil
+	default:
+		return nil, errors.New("unsupported block version")
+	}
 }
 
-// GetHeader for mocking.
-func (s *MockBuilderService) GetHeader(context.Context, types.Slot, [32]byte, [48]byte) (*ethpb.SignedBuilderBid, error) {
-	return s.Bid, s.ErrGetHeader
+// GetHeader for mocking. The bid is checked with the given bid checker, if any.
+func (s *MockBuilderService) GetHeader(_ context.Context, _ types.Slot, _ [32]byte, _ [48]byte, check buildersvc.BidChecker) (builder.SignedBid, error) {
+	if s.ErrGetHeader != nil {
+		return nil, s.ErrGetHeader
+	}
+	var bid builder.SignedBid
+	var err error
+	switch {
+	case s.BidCapella != nil:
+		bid, err = builder.WrappedSignedBuilderBidCapella(s.BidCapella)
+	case s.Bid != nil:
+		bid, err = builder.WrappedSignedBuilderBid(s.Bid)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if check != nil {
+		if err := check(bid); err != nil {
+			return nil, err
+		}
+	}
+	return bid, nil
 }
 
 // RegisterValidator for mocking.
 func (s *MockBuilderService) RegisterValidator(context.Context, []*ethpb.SignedValidatorRegistrationV1) error {
 	return s.ErrRegisterValidator
 }
+
+// SetValidatorRelays for mocking.
+func (s *MockBuilderService) SetValidatorRelays(_ context.Context, idx types.ValidatorIndex, endpoints []string) error {
+	if s.ValidatorRelays == nil {
+		s.ValidatorRelays = make(map[types.ValidatorIndex][]string)
+	}
+	s.ValidatorRelays[idx] = endpoints
+	return nil
+}
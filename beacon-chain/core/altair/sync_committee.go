@@ -191,6 +191,12 @@ func IsSyncCommitteeAggregator(sig []byte) (bool, error) {
 
 // ValidateSyncMessageTime validates sync message to ensure that the provided slot is valid.
 func ValidateSyncMessageTime(slot types.Slot, genesisTime time.Time, clockDisparity time.Duration) error {
+	return ValidateSyncMessageTimeAt(slot, genesisTime, clockDisparity, time.Now())
+}
+
+// ValidateSyncMessageTimeAt validates sync message to ensure that the provided slot is valid
+// relative to the provided current time, rather than the local clock.
+func ValidateSyncMessageTimeAt(slot types.Slot, genesisTime time.Time, clockDisparity time.Duration, currentTime time.Time) error {
 	if err := slots.ValidateClock(slot, uint64(genesisTime.Unix())); err != nil {
 		return err
 	}
@@ -198,14 +204,14 @@ func ValidateSyncMessageTime(slot types.Slot, genesisTime time.Time, clockDispar
 	if err != nil {
 		return err
 	}
-	currentSlot := slots.Since(genesisTime)
+	currentSlot := slots.SlotAt(uint64(genesisTime.Unix()), currentTime)
 	slotStartTime, err := slots.ToTime(uint64(genesisTime.Unix()), currentSlot)
 	if err != nil {
 		return err
 	}
 
 	lowestSlotBound := slotStartTime.Add(-clockDisparity)
-	currentLowerBound := time.Now().Add(-clockDisparity)
+	currentLowerBound := currentTime.Add(-clockDisparity)
 	// In the event the Slot's start time, is before the
 	// current allowable bound, we set the slot's start
 	// time as the bound.
@@ -214,7 +220,7 @@ func ValidateSyncMessageTime(slot types.Slot, genesisTime time.Time, clockDispar
 	}
 
 	lowerBound := lowestSlotBound
-	upperBound := time.Now().Add(clockDisparity)
+	upperBound := currentTime.Add(clockDisparity)
 	// Verify sync message slot is within the time range.
 	if messageTime.Before(lowerBound) || messageTime.After(upperBound) {
 		return fmt.Errorf(
@@ -19,6 +19,9 @@ const (
 
 	// SyncCommitteeContributionReceived is sent after a sync committee contribution object has been received.
 	SyncCommitteeContributionReceived
+
+	// SlashingDetected is sent after the slasher detected a slashable offense and verified its evidence.
+	SlashingDetected
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -44,3 +47,10 @@ type SyncCommitteeContributionReceivedData struct {
 	// Contribution is the sync committee contribution object.
 	Contribution *ethpb.SignedContributionAndProof
 }
+
+// SlashingDetectedData is the data sent with SlashingDetected events.
+type SlashingDetectedData struct {
+	// Slashing is the detected slashing, along with the slashed validators and whether it was pooled or
+	// is already included on chain.
+	Slashing *ethpb.SlashingEvent
+}
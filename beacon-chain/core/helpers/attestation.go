@@ -148,6 +148,12 @@ func ComputeSubnetFromCommitteeAndSlot(activeValCount uint64, comIdx types.Commi
 //
 // In the attestation must be within the range of 95 to 102 in the example above.
 func ValidateAttestationTime(attSlot types.Slot, genesisTime time.Time, clockDisparity time.Duration) error {
+	return ValidateAttestationTimeAt(attSlot, genesisTime, clockDisparity, prysmTime.Now())
+}
+
+// ValidateAttestationTimeAt validates that the incoming attestation is in the desired time range
+// relative to the provided current time, rather than the local clock.
+func ValidateAttestationTimeAt(attSlot types.Slot, genesisTime time.Time, clockDisparity time.Duration, currentTime time.Time) error {
 	if err := slots.ValidateClock(attSlot, uint64(genesisTime.Unix())); err != nil {
 		return err
 	}
@@ -155,13 +161,13 @@ func ValidateAttestationTime(attSlot types.Slot, genesisTime time.Time, clockDis
 	if err != nil {
 		return err
 	}
-	currentSlot := slots.Since(genesisTime)
+	currentSlot := slots.SlotAt(uint64(genesisTime.Unix()), currentTime)
 
 	// When receiving an attestation, it can be from the future.
 	// so the upper bounds is set to now + clockDisparity(SECONDS_PER_SLOT * 2).
 	// But when sending an attestation, it should not be in future slot.
 	// so the upper bounds is set to now + clockDisparity(MAXIMUM_GOSSIP_CLOCK_DISPARITY).
-	upperBounds := prysmTime.Now().Add(clockDisparity)
+	upperBounds := currentTime.Add(clockDisparity)
 
 	// An attestation cannot be older than the current slot - attestation propagation slot range
 	// with a minor tolerance for peer clock disparity.
@@ -0,0 +1,389 @@
+// Package light_client implements the derivation of the light client objects served by the beacon node,
+// as defined in the Altair light client sync protocol.
+package light_client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+const (
+	// SyncCommitteeBranchDepth is the depth of the Merkle branch of a sync committee in the beacon state.
+	SyncCommitteeBranchDepth = 5
+	// FinalityBranchDepth is the depth of the Merkle branch of the finalized root in the beacon state.
+	FinalityBranchDepth = 6
+	// MinSyncCommitteeParticipants is the minimum number of sync committee participants for an update to be valid.
+	MinSyncCommitteeParticipants = 1
+)
+
+// NewLightClientBootstrap creates the light client bootstrap of a block from the block and its post-state.
+//
+// Spec pseudocode definition:
+//
+//	def create_light_client_bootstrap(state: BeaconState,
+//	                                  block: SignedBeaconBlock) -> LightClientBootstrap:
+//	    assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
+//	    assert state.slot == state.latest_block_header.slot
+//	    header = state.latest_block_header.copy()
+//	    header.state_root = hash_tree_root(state)
+//	    assert hash_tree_root(header) == hash_tree_root(block.message)
+//
+//	    return LightClientBootstrap(
+//	        header=block_to_light_client_header(block),
+//	        current_sync_committee=state.current_sync_committee,
+//	        current_sync_committee_branch=compute_merkle_proof_for_state(state, CURRENT_SYNC_COMMITTEE_INDEX),
+//	    )
+func NewLightClientBootstrap(ctx context.Context, st state.BeaconState, blk interfaces.SignedBeaconBlock) (*ethpb.LightClientBootstrap, error) {
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		return nil, err
+	}
+	if st.Version() == version.Phase0 || blk.Version() == version.Phase0 {
+		return nil, errors.New("light client bootstrap is not supported for phase 0")
+	}
+	if st.Slot() != st.LatestBlockHeader().Slot {
+		return nil, fmt.Errorf("state slot %d is not equal to the latest block header slot %d", st.Slot(), st.LatestBlockHeader().Slot)
+	}
+	header, err := blk.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block header")
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state root")
+	}
+	if !bytes.Equal(header.Header.StateRoot, stateRoot[:]) {
+		return nil, fmt.Errorf("block state root %#x is not equal to the state root %#x", header.Header.StateRoot, stateRoot)
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee")
+	}
+	branch, err := st.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee proof")
+	}
+	return &ethpb.LightClientBootstrap{
+		Header:                     header.Header,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: branch,
+	}, nil
+}
+
+// NewLightClientUpdate creates the light client update signed by the sync aggregate of the given block.
+// The attested block is the parent of the block, and the attested state is its post-state. The finalized block is
+// the block of the attested state's finalized checkpoint, and may be nil when it is not available.
+//
+// Spec pseudocode definition:
+//
+//	def create_light_client_update(state: BeaconState,
+//	                               block: SignedBeaconBlock,
+//	                               attested_state: BeaconState,
+//	                               attested_block: SignedBeaconBlock,
+//	                               finalized_block: Optional[SignedBeaconBlock]) -> LightClientUpdate:
+//	    assert compute_epoch_at_slot(attested_state.slot) >= ALTAIR_FORK_EPOCH
+//	    assert sum(block.message.body.sync_aggregate.sync_committee_bits) >= MIN_SYNC_COMMITTEE_PARTICIPANTS
+//	    ...
+//	    update_signature_period = compute_sync_committee_period_at_slot(block.message.slot)
+//	    ...
+//	    update_attested_period = compute_sync_committee_period_at_slot(attested_block.message.slot)
+//
+//	    update = LightClientUpdate()
+//	    update.attested_header = block_to_light_client_header(attested_block)
+//
+//	    # `next_sync_committee` is only useful if the message is signed by the current sync committee
+//	    if update_attested_period == update_signature_period:
+//	        update.next_sync_committee = attested_state.next_sync_committee
+//	        update.next_sync_committee_branch = compute_merkle_proof_for_state(attested_state, NEXT_SYNC_COMMITTEE_INDEX)
+//
+//	    # Indicate finality whenever possible
+//	    if finalized_block is not None:
+//	        if finalized_block.message.slot != GENESIS_SLOT:
+//	            update.finalized_header = block_to_light_client_header(finalized_block)
+//	            assert hash_tree_root(update.finalized_header.beacon) == attested_state.finalized_checkpoint.root
+//	        else:
+//	            assert attested_state.finalized_checkpoint.root == Bytes32()
+//	        update.finality_branch = compute_merkle_proof_for_state(attested_state, FINALIZED_ROOT_INDEX)
+//
+//	    update.sync_aggregate = block.message.body.sync_aggregate
+//	    update.signature_slot = block.message.slot
+//
+//	    return update
+func NewLightClientUpdate(
+	ctx context.Context,
+	blk interfaces.SignedBeaconBlock,
+	attestedState state.BeaconState,
+	attestedBlock interfaces.SignedBeaconBlock,
+	finalizedBlock interfaces.SignedBeaconBlock,
+) (*ethpb.LightClientUpdate, error) {
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		return nil, err
+	}
+	if err := blocks.BeaconBlockIsNil(attestedBlock); err != nil {
+		return nil, errors.Wrap(err, "invalid attested block")
+	}
+	if attestedState.Version() == version.Phase0 || attestedBlock.Version() == version.Phase0 {
+		return nil, errors.New("light client updates are not supported for phase 0")
+	}
+	syncAggregate, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync aggregate")
+	}
+	if syncAggregate.SyncCommitteeBits.Count() < MinSyncCommitteeParticipants {
+		return nil, fmt.Errorf("sync aggregate has %d participants, less than the minimum of %d", syncAggregate.SyncCommitteeBits.Count(), MinSyncCommitteeParticipants)
+	}
+	if attestedState.Slot() != attestedBlock.Block().Slot() {
+		return nil, fmt.Errorf("attested state slot %d is not equal to the attested block slot %d", attestedState.Slot(), attestedBlock.Block().Slot())
+	}
+	attestedRoot, err := attestedBlock.Block().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested block root")
+	}
+	parentRoot := blk.Block().ParentRoot()
+	if attestedRoot != parentRoot {
+		return nil, fmt.Errorf("attested block root %#x is not equal to the block parent root %#x", attestedRoot, parentRoot)
+	}
+	attestedHeader, err := attestedBlock.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested block header")
+	}
+
+	update := &ethpb.LightClientUpdate{
+		AttestedHeader:          attestedHeader.Header,
+		NextSyncCommittee:       emptySyncCommittee(),
+		NextSyncCommitteeBranch: emptyBranch(SyncCommitteeBranchDepth),
+		FinalizedHeader:         emptyHeader(),
+		FinalityBranch:          emptyBranch(FinalityBranchDepth),
+		SyncAggregate:           syncAggregate,
+		SignatureSlot:           blk.Block().Slot(),
+	}
+
+	signaturePeriod := slots.SyncCommitteePeriod(slots.ToEpoch(blk.Block().Slot()))
+	attestedPeriod := slots.SyncCommitteePeriod(slots.ToEpoch(attestedBlock.Block().Slot()))
+	// The next sync committee is only useful if the update is signed by the current sync committee.
+	if attestedPeriod == signaturePeriod {
+		committee, err := attestedState.NextSyncCommittee()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get next sync committee")
+		}
+		branch, err := attestedState.NextSyncCommitteeProof(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get next sync committee proof")
+		}
+		update.NextSyncCommittee = committee
+		update.NextSyncCommitteeBranch = branch
+	}
+
+	if finalizedBlock != nil && !finalizedBlock.IsNil() {
+		finalizedCheckpoint := attestedState.FinalizedCheckpoint()
+		if finalizedBlock.Block().Slot() != params.BeaconConfig().GenesisSlot {
+			finalizedHeader, err := finalizedBlock.Header()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get finalized block header")
+			}
+			finalizedRoot, err := finalizedBlock.Block().HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get finalized block root")
+			}
+			if !bytes.Equal(finalizedRoot[:], finalizedCheckpoint.Root) {
+				return nil, fmt.Errorf("finalized block root %#x is not equal to the finalized checkpoint root %#x", finalizedRoot, finalizedCheckpoint.Root)
+			}
+			update.FinalizedHeader = finalizedHeader.Header
+		} else if !bytes.Equal(finalizedCheckpoint.Root, params.BeaconConfig().ZeroHash[:]) {
+			return nil, fmt.Errorf("finalized checkpoint root %#x of a genesis finalized block is not empty", finalizedCheckpoint.Root)
+		}
+		branch, err := attestedState.FinalizedRootProof(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get finalized root proof")
+		}
+		update.FinalityBranch = branch
+	}
+	return update, nil
+}
+
+// NewLightClientFinalityUpdate creates a light client finality update from a light client update.
+func NewLightClientFinalityUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientFinalityUpdate {
+	return &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+}
+
+// NewLightClientOptimisticUpdate creates a light client optimistic update from a light client update.
+func NewLightClientOptimisticUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientOptimisticUpdate {
+	return &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+}
+
+// IsSyncCommitteeUpdate returns true if the update contains the next sync committee.
+func IsSyncCommitteeUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.NextSyncCommitteeBranch)
+}
+
+// IsFinalityUpdate returns true if the update contains a finalized header.
+func IsFinalityUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.FinalityBranch)
+}
+
+// IsBetterUpdate returns true if the new update is better than the old one, in which case it should replace
+// the old one as the best update of its sync committee period.
+//
+// Spec pseudocode definition:
+//
+//	def is_better_update(new_update: LightClientUpdate, old_update: LightClientUpdate) -> bool:
+//	    # Compare supermajority (> 2/3) sync committee participation
+//	    max_active_participants = len(new_update.sync_aggregate.sync_committee_bits)
+//	    new_num_active_participants = sum(new_update.sync_aggregate.sync_committee_bits)
+//	    old_num_active_participants = sum(old_update.sync_aggregate.sync_committee_bits)
+//	    new_has_supermajority = new_num_active_participants * 3 >= max_active_participants * 2
+//	    old_has_supermajority = old_num_active_participants * 3 >= max_active_participants * 2
+//	    if new_has_supermajority != old_has_supermajority:
+//	        return new_has_supermajority
+//	    if not new_has_supermajority and new_num_active_participants != old_num_active_participants:
+//	        return new_num_active_participants > old_num_active_participants
+//
+//	    # Compare presence of relevant sync committee
+//	    new_has_relevant_sync_committee = is_sync_committee_update(new_update) and (
+//	        compute_sync_committee_period_at_slot(new_update.attested_header.beacon.slot)
+//	        == compute_sync_committee_period_at_slot(new_update.signature_slot)
+//	    )
+//	    old_has_relevant_sync_committee = is_sync_committee_update(old_update) and (
+//	        compute_sync_committee_period_at_slot(old_update.attested_header.beacon.slot)
+//	        == compute_sync_committee_period_at_slot(old_update.signature_slot)
+//	    )
+//	    if new_has_relevant_sync_committee != old_has_relevant_sync_committee:
+//	        return new_has_relevant_sync_committee
+//
+//	    # Compare indication of any finality
+//	    new_has_finality = is_finality_update(new_update)
+//	    old_has_finality = is_finality_update(old_update)
+//	    if new_has_finality != old_has_finality:
+//	        return new_has_finality
+//
+//	    # Compare sync committee finality
+//	    if new_has_finality:
+//	        new_has_sync_committee_finality = (
+//	            compute_sync_committee_period_at_slot(new_update.finalized_header.beacon.slot)
+//	            == compute_sync_committee_period_at_slot(new_update.attested_header.beacon.slot)
+//	        )
+//	        old_has_sync_committee_finality = (
+//	            compute_sync_committee_period_at_slot(old_update.finalized_header.beacon.slot)
+//	            == compute_sync_committee_period_at_slot(old_update.attested_header.beacon.slot)
+//	        )
+//	        if new_has_sync_committee_finality != old_has_sync_committee_finality:
+//	            return new_has_sync_committee_finality
+//
+//	    # Tiebreaker 1: Sync committee participation beyond supermajority
+//	    if new_num_active_participants != old_num_active_participants:
+//	        return new_num_active_participants > old_num_active_participants
+//
+//	    # Tiebreaker 2: Prefer older data (fewer changes to best)
+//	    if new_update.attested_header.beacon.slot != old_update.attested_header.beacon.slot:
+//	        return new_update.attested_header.beacon.slot < old_update.attested_header.beacon.slot
+//	    return new_update.signature_slot < old_update.signature_slot
+func IsBetterUpdate(newUpdate, oldUpdate *ethpb.LightClientUpdate) bool {
+	maxActiveParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Len()
+	newNumActiveParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldNumActiveParticipants := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newHasSupermajority := newNumActiveParticipants*3 >= maxActiveParticipants*2
+	oldHasSupermajority := oldNumActiveParticipants*3 >= maxActiveParticipants*2
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	newHasRelevantSyncCommittee := IsSyncCommitteeUpdate(newUpdate) &&
+		slotPeriod(newUpdate.AttestedHeader.Slot) == slotPeriod(newUpdate.SignatureSlot)
+	oldHasRelevantSyncCommittee := IsSyncCommitteeUpdate(oldUpdate) &&
+		slotPeriod(oldUpdate.AttestedHeader.Slot) == slotPeriod(oldUpdate.SignatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	newHasFinality := IsFinalityUpdate(newUpdate)
+	oldHasFinality := IsFinalityUpdate(oldUpdate)
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	if newHasFinality {
+		newHasSyncCommitteeFinality := slotPeriod(newUpdate.FinalizedHeader.Slot) == slotPeriod(newUpdate.AttestedHeader.Slot)
+		oldHasSyncCommitteeFinality := slotPeriod(oldUpdate.FinalizedHeader.Slot) == slotPeriod(oldUpdate.AttestedHeader.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	if newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+// UpdatePeriod returns the sync committee period an update belongs to, which is the period of its attested header.
+func UpdatePeriod(update *ethpb.LightClientUpdate) uint64 {
+	return slotPeriod(update.AttestedHeader.Slot)
+}
+
+func slotPeriod(slot types.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+func isEmptyBranch(branch [][]byte) bool {
+	for _, node := range branch {
+		if !bytes.Equal(node, params.BeaconConfig().ZeroHash[:]) {
+			return false
+		}
+	}
+	return true
+}
+
+func emptyBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
+
+func emptyHeader() *ethpb.BeaconBlockHeader {
+	return &ethpb.BeaconBlockHeader{
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+}
+
+func emptySyncCommittee() *ethpb.SyncCommittee {
+	pubKeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubKeys {
+		pubKeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpb.SyncCommittee{
+		Pubkeys:         pubKeys,
+		AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+	}
+}
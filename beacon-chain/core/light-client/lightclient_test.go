@@ -0,0 +1,323 @@
+package light_client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	lightclient "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/v3/beacon-chain/state/state-native"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/container/trie"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestNewLightClientBootstrap(t *testing.T) {
+	ctx := context.Background()
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	stRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	b := util.NewBeaconBlockAltair()
+	b.Block.StateRoot = stRoot[:]
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	bootstrap, err := lightclient.NewLightClientBootstrap(ctx, st, blk)
+	require.NoError(t, err)
+	blkRoot, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := bootstrap.Header.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blkRoot, headerRoot)
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, committee, bootstrap.CurrentSyncCommittee)
+	committeeRoot, err := committee.HashTreeRoot()
+	require.NoError(t, err)
+	gIndex, err := st.(*statenative.BeaconState).CurrentSyncCommitteeGeneralizedIndex()
+	require.NoError(t, err)
+	valid := trie.VerifyMerkleProof(stRoot[:], committeeRoot[:], gIndex, bootstrap.CurrentSyncCommitteeBranch)
+	assert.Equal(t, true, valid)
+	_, err = bootstrap.MarshalSSZ()
+	require.NoError(t, err)
+}
+
+func TestNewLightClientBootstrap_Errors(t *testing.T) {
+	ctx := context.Background()
+	t.Run("phase 0", func(t *testing.T) {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+		require.NoError(t, err)
+		_, err = lightclient.NewLightClientBootstrap(ctx, st, blk)
+		require.ErrorContains(t, "not supported for phase 0", err)
+	})
+	t.Run("state root mismatch", func(t *testing.T) {
+		st, err := util.NewBeaconStateAltair()
+		require.NoError(t, err)
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockAltair())
+		require.NoError(t, err)
+		_, err = lightclient.NewLightClientBootstrap(ctx, st, blk)
+		require.ErrorContains(t, "is not equal to the state root", err)
+	})
+	t.Run("state slot mismatch", func(t *testing.T) {
+		st, err := util.NewBeaconStateAltair()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(1))
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockAltair())
+		require.NoError(t, err)
+		_, err = lightclient.NewLightClientBootstrap(ctx, st, blk)
+		require.ErrorContains(t, "is not equal to the latest block header slot", err)
+	})
+}
+
+type updateTestSetup struct {
+	block         interfaces.SignedBeaconBlock
+	attestedState state.BeaconState
+	attestedBlock interfaces.SignedBeaconBlock
+}
+
+func newUpdateTestSetup(t *testing.T, attestedSlot, signatureSlot types.Slot, participants uint64) *updateTestSetup {
+	ctx := context.Background()
+	attestedState, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, attestedState.SetSlot(attestedSlot))
+	stRoot, err := attestedState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	ab := util.NewBeaconBlockAltair()
+	ab.Block.Slot = attestedSlot
+	ab.Block.StateRoot = stRoot[:]
+	attestedBlock, err := blocks.NewSignedBeaconBlock(ab)
+	require.NoError(t, err)
+	attestedRoot, err := attestedBlock.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = signatureSlot
+	b.Block.ParentRoot = attestedRoot[:]
+	for i := uint64(0); i < participants; i++ {
+		b.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+	}
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return &updateTestSetup{block: blk, attestedState: attestedState, attestedBlock: attestedBlock}
+}
+
+func TestNewLightClientUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("without finality", func(t *testing.T) {
+		s := newUpdateTestSetup(t, 1, 2, 10)
+		update, err := lightclient.NewLightClientUpdate(ctx, s.block, s.attestedState, s.attestedBlock, nil)
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(1), update.AttestedHeader.Slot)
+		assert.Equal(t, types.Slot(2), update.SignatureSlot)
+		assert.Equal(t, uint64(10), update.SyncAggregate.SyncCommitteeBits.Count())
+		assert.Equal(t, true, lightclient.IsSyncCommitteeUpdate(update))
+		assert.Equal(t, false, lightclient.IsFinalityUpdate(update))
+		stRoot, err := s.attestedState.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		committeeRoot, err := update.NextSyncCommittee.HashTreeRoot()
+		require.NoError(t, err)
+		gIndex, err := s.attestedState.(*statenative.BeaconState).NextSyncCommitteeGeneralizedIndex()
+		require.NoError(t, err)
+		valid := trie.VerifyMerkleProof(stRoot[:], committeeRoot[:], gIndex, update.NextSyncCommitteeBranch)
+		assert.Equal(t, true, valid)
+		_, err = update.MarshalSSZ()
+		require.NoError(t, err)
+	})
+	t.Run("genesis finalized block", func(t *testing.T) {
+		s := newUpdateTestSetup(t, 1, 2, 10)
+		finalizedBlock, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockAltair())
+		require.NoError(t, err)
+		update, err := lightclient.NewLightClientUpdate(ctx, s.block, s.attestedState, s.attestedBlock, finalizedBlock)
+		require.NoError(t, err)
+		assert.Equal(t, true, lightclient.IsFinalityUpdate(update))
+		assert.Equal(t, types.Slot(0), update.FinalizedHeader.Slot)
+	})
+	t.Run("finalized block", func(t *testing.T) {
+		fb := util.NewBeaconBlockAltair()
+		fb.Block.Slot = 32
+		finalizedBlock, err := blocks.NewSignedBeaconBlock(fb)
+		require.NoError(t, err)
+		finalizedRoot, err := finalizedBlock.Block().HashTreeRoot()
+		require.NoError(t, err)
+
+		attestedState, err := util.NewBeaconStateAltair()
+		require.NoError(t, err)
+		require.NoError(t, attestedState.SetSlot(100))
+		require.NoError(t, attestedState.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+		stRoot, err := attestedState.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		ab := util.NewBeaconBlockAltair()
+		ab.Block.Slot = 100
+		ab.Block.StateRoot = stRoot[:]
+		attestedBlock, err := blocks.NewSignedBeaconBlock(ab)
+		require.NoError(t, err)
+		attestedRoot, err := attestedBlock.Block().HashTreeRoot()
+		require.NoError(t, err)
+		b := util.NewBeaconBlockAltair()
+		b.Block.Slot = 101
+		b.Block.ParentRoot = attestedRoot[:]
+		b.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(0, true)
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+
+		update, err := lightclient.NewLightClientUpdate(ctx, blk, attestedState, attestedBlock, finalizedBlock)
+		require.NoError(t, err)
+		headerRoot, err := update.FinalizedHeader.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, finalizedRoot, headerRoot)
+		valid := trie.VerifyMerkleProof(stRoot[:], finalizedRoot[:], statenative.FinalizedRootGeneralizedIndex(), update.FinalityBranch)
+		assert.Equal(t, true, valid)
+
+		finality := lightclient.NewLightClientFinalityUpdate(update)
+		assert.DeepEqual(t, update.FinalizedHeader, finality.FinalizedHeader)
+		assert.DeepEqual(t, update.FinalityBranch, finality.FinalityBranch)
+		optimistic := lightclient.NewLightClientOptimisticUpdate(update)
+		assert.DeepEqual(t, update.AttestedHeader, optimistic.AttestedHeader)
+		assert.Equal(t, update.SignatureSlot, optimistic.SignatureSlot)
+	})
+	t.Run("signed by the next sync committee", func(t *testing.T) {
+		lastSlot := types.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod)*uint64(params.BeaconConfig().SlotsPerEpoch) - 1)
+		s := newUpdateTestSetup(t, lastSlot, lastSlot+1, 10)
+		update, err := lightclient.NewLightClientUpdate(ctx, s.block, s.attestedState, s.attestedBlock, nil)
+		require.NoError(t, err)
+		assert.Equal(t, false, lightclient.IsSyncCommitteeUpdate(update))
+	})
+}
+
+func TestNewLightClientUpdate_Errors(t *testing.T) {
+	ctx := context.Background()
+	t.Run("no participants", func(t *testing.T) {
+		s := newUpdateTestSetup(t, 1, 2, 0)
+		_, err := lightclient.NewLightClientUpdate(ctx, s.block, s.attestedState, s.attestedBlock, nil)
+		require.ErrorContains(t, "less than the minimum", err)
+	})
+	t.Run("parent root mismatch", func(t *testing.T) {
+		s := newUpdateTestSetup(t, 1, 2, 10)
+		other := newUpdateTestSetup(t, 1, 2, 10)
+		other.attestedBlock.Block().SetStateRoot(make([]byte, fieldparams.RootLength))
+		_, err := lightclient.NewLightClientUpdate(ctx, s.block, s.attestedState, other.attestedBlock, nil)
+		require.ErrorContains(t, "is not equal to the block parent root", err)
+	})
+	t.Run("finalized root mismatch", func(t *testing.T) {
+		s := newUpdateTestSetup(t, 1, 2, 10)
+		fb := util.NewBeaconBlockAltair()
+		fb.Block.Slot = 1
+		finalizedBlock, err := blocks.NewSignedBeaconBlock(fb)
+		require.NoError(t, err)
+		_, err = lightclient.NewLightClientUpdate(ctx, s.block, s.attestedState, s.attestedBlock, finalizedBlock)
+		require.ErrorContains(t, "is not equal to the finalized checkpoint root", err)
+	})
+}
+
+func TestIsBetterUpdate(t *testing.T) {
+	newUpdate := func(participants uint64, attestedSlot, signatureSlot, finalizedSlot types.Slot, syncCommittee, finality bool) *ethpb.LightClientUpdate {
+		bits := bitfield.NewBitvector512()
+		for i := uint64(0); i < participants; i++ {
+			bits.SetBitAt(i, true)
+		}
+		branch := func(set bool, depth int) [][]byte {
+			b := make([][]byte, depth)
+			for i := range b {
+				b[i] = make([]byte, fieldparams.RootLength)
+				if set {
+					b[i][0] = 1
+				}
+			}
+			return b
+		}
+		return &ethpb.LightClientUpdate{
+			AttestedHeader:          &ethpb.BeaconBlockHeader{Slot: attestedSlot},
+			NextSyncCommitteeBranch: branch(syncCommittee, lightclient.SyncCommitteeBranchDepth),
+			FinalizedHeader:         &ethpb.BeaconBlockHeader{Slot: finalizedSlot},
+			FinalityBranch:          branch(finality, lightclient.FinalityBranchDepth),
+			SyncAggregate:           &ethpb.SyncAggregate{SyncCommitteeBits: bits},
+			SignatureSlot:           signatureSlot,
+		}
+	}
+	periodSlots := types.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * uint64(params.BeaconConfig().SlotsPerEpoch))
+
+	tests := []struct {
+		name      string
+		newUpdate *ethpb.LightClientUpdate
+		oldUpdate *ethpb.LightClientUpdate
+		want      bool
+	}{
+		{
+			name:      "supermajority wins",
+			newUpdate: newUpdate(342, 10, 11, 0, false, false),
+			oldUpdate: newUpdate(300, 10, 11, 0, true, true),
+			want:      true,
+		},
+		{
+			name:      "more participants without supermajority",
+			newUpdate: newUpdate(200, 10, 11, 0, false, false),
+			oldUpdate: newUpdate(100, 10, 11, 0, true, true),
+			want:      true,
+		},
+		{
+			name:      "relevant sync committee",
+			newUpdate: newUpdate(400, 10, 11, 0, true, false),
+			oldUpdate: newUpdate(400, 10, 11, 0, false, true),
+			want:      true,
+		},
+		{
+			name:      "sync committee from another period is not relevant",
+			newUpdate: newUpdate(400, periodSlots-1, periodSlots, 0, true, false),
+			oldUpdate: newUpdate(400, 10, 11, 0, false, true),
+			want:      false,
+		},
+		{
+			name:      "finality",
+			newUpdate: newUpdate(400, 10, 11, 0, true, true),
+			oldUpdate: newUpdate(500, 10, 11, 0, true, false),
+			want:      true,
+		},
+		{
+			name:      "sync committee finality",
+			newUpdate: newUpdate(400, periodSlots+10, periodSlots+11, periodSlots, true, true),
+			oldUpdate: newUpdate(500, periodSlots+10, periodSlots+11, 0, true, true),
+			want:      true,
+		},
+		{
+			name:      "more participants beyond supermajority",
+			newUpdate: newUpdate(500, 10, 11, 0, true, true),
+			oldUpdate: newUpdate(400, 10, 11, 0, true, true),
+			want:      true,
+		},
+		{
+			name:      "older attested header",
+			newUpdate: newUpdate(400, 9, 11, 0, true, true),
+			oldUpdate: newUpdate(400, 10, 11, 0, true, true),
+			want:      true,
+		},
+		{
+			name:      "older signature slot",
+			newUpdate: newUpdate(400, 10, 11, 0, true, true),
+			oldUpdate: newUpdate(400, 10, 12, 0, true, true),
+			want:      true,
+		},
+		{
+			name:      "identical",
+			newUpdate: newUpdate(400, 10, 11, 0, true, true),
+			oldUpdate: newUpdate(400, 10, 11, 0, true, true),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, lightclient.IsBetterUpdate(tt.newUpdate, tt.oldUpdate))
+		})
+	}
+}
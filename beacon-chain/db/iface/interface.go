@@ -6,9 +6,12 @@ package iface
 import (
 	"context"
 	"io"
+	"net"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
 	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
@@ -54,9 +57,19 @@ type ReadOnlyDatabase interface {
 	// Fee recipients operations.
 	FeeRecipientByValidatorID(ctx context.Context, id types.ValidatorIndex) (common.Address, error)
 	RegistrationByValidatorID(ctx context.Context, id types.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
+	ValidatorRelays(ctx context.Context) (map[types.ValidatorIndex][]string, error)
+	// Light client operations.
+	LightClientUpdate(ctx context.Context, period uint64) (*ethpb.LightClientUpdate, error)
+	LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) ([]*ethpb.LightClientUpdate, error)
+	// Attestation pool operations.
+	AttestationPool(ctx context.Context) ([]*ethpb.Attestation, []*ethpb.Attestation, error)
+	// Peer reputation operations.
+	PeerReputations(ctx context.Context) (map[peer.ID]*peerdata.Reputation, error)
+	SubnetBans(ctx context.Context) ([]*peerdata.SubnetBan, error)
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	PrunedSlot(ctx context.Context) (types.Slot, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -67,10 +80,13 @@ type NoHeadAccessDatabase interface {
 	DeleteBlock(ctx context.Context, root [32]byte) error
 	SaveBlock(ctx context.Context, block interfaces.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock) error
+	BackfillFinalizedIndex(ctx context.Context, blocks []interfaces.SignedBeaconBlock, finalizedChildRoot [32]byte) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	// State related methods.
 	SaveState(ctx context.Context, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []state.ReadOnlyBeaconState, blockRoots [][32]byte) error
+	SaveStateDiff(ctx context.Context, state state.BeaconState, blockRoot [32]byte) error
+	PruneHistory(ctx context.Context, cutoff types.Slot, limit uint64) (types.Slot, error)
 	DeleteState(ctx context.Context, blockRoot [32]byte) error
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
 	SaveStateSummary(ctx context.Context, summary *ethpb.StateSummary) error
@@ -88,6 +104,15 @@ type NoHeadAccessDatabase interface {
 	// Fee recipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, addrs []common.Address) error
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
+	SaveValidatorRelays(ctx context.Context, id types.ValidatorIndex, endpoints []string) error
+	// Light client operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpb.LightClientUpdate) error
+	// Attestation pool operations.
+	SaveAttestationPool(ctx context.Context, poolAtts, forkchoiceAtts []*ethpb.Attestation) error
+	// Peer reputation operations.
+	SavePeerReputations(ctx context.Context, reputations map[peer.ID]*peerdata.Reputation) error
+	SaveSubnetBan(ctx context.Context, ban *peerdata.SubnetBan) error
+	DeleteSubnetBan(ctx context.Context, subnet *net.IPNet) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error
 }
@@ -154,6 +179,10 @@ type SlasherDatabase interface {
 		ctx context.Context,
 		indices []types.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	BackfillCheckpoint(ctx context.Context) (types.Slot, error)
+	SaveBackfillCheckpoint(ctx context.Context, slot types.Slot) error
+	SpanParameters(ctx context.Context) (*slashertypes.SpanParameters, error)
+	SaveSpanParameters(ctx context.Context, p *slashertypes.SpanParameters) error
 	DatabasePath() string
 	ClearDB() error
 }
@@ -0,0 +1,76 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// The persisted attestation pool keys are prefixed by the kind of attestation.
+const (
+	poolAttestationPrefix byte = iota
+	forkchoiceAttestationPrefix
+)
+
+// SaveAttestationPool replaces the persisted attestation pool with the given pool attestations,
+// aggregated or not, and the attestations meant for fork choice.
+func (s *Store) SaveAttestationPool(ctx context.Context, poolAtts, forkchoiceAtts []*ethpb.Attestation) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveAttestationPool")
+	defer span.End()
+
+	type entry struct {
+		key, value []byte
+	}
+	entries := make([]entry, 0, len(poolAtts)+len(forkchoiceAtts))
+	for prefix, atts := range [][]*ethpb.Attestation{poolAttestationPrefix: poolAtts, forkchoiceAttestationPrefix: forkchoiceAtts} {
+		for i, att := range atts {
+			enc, err := encode(ctx, att)
+			if err != nil {
+				return err
+			}
+			key := append([]byte{byte(prefix)}, bytesutil.Uint64ToBytesBigEndian(uint64(i))...)
+			entries = append(entries, entry{key: key, value: enc})
+		}
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(attestationPoolBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(attestationPoolBucket)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err := bkt.Put(e.key, e.value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// AttestationPool retrieves the persisted pool attestations and fork choice attestations.
+func (s *Store) AttestationPool(ctx context.Context) ([]*ethpb.Attestation, []*ethpb.Attestation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttestationPool")
+	defer span.End()
+
+	var poolAtts, forkchoiceAtts []*ethpb.Attestation
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(attestationPoolBucket).ForEach(func(k, v []byte) error {
+			att := &ethpb.Attestation{}
+			if err := decode(ctx, v, att); err != nil {
+				return err
+			}
+			if len(k) > 0 && k[0] == forkchoiceAttestationPrefix {
+				forkchoiceAtts = append(forkchoiceAtts, att)
+			} else {
+				poolAtts = append(poolAtts, att)
+			}
+			return nil
+		})
+	})
+	return poolAtts, forkchoiceAtts, err
+}
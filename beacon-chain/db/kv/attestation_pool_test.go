@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestStore_AttestationPool_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	poolAtts, forkchoiceAtts, err := db.AttestationPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(poolAtts))
+	assert.Equal(t, 0, len(forkchoiceAtts))
+
+	att := func(slot types.Slot, bits byte) *ethpb.Attestation {
+		return util.HydrateAttestation(&ethpb.Attestation{
+			Data:            &ethpb.AttestationData{Slot: slot},
+			AggregationBits: bitfield.Bitlist{bits},
+		})
+	}
+	wantPool := []*ethpb.Attestation{att(1, 0b1101), att(2, 0b1001)}
+	wantForkchoice := []*ethpb.Attestation{att(1, 0b1111)}
+	require.NoError(t, db.SaveAttestationPool(ctx, wantPool, wantForkchoice))
+	poolAtts, forkchoiceAtts, err = db.AttestationPool(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, wantPool, poolAtts)
+	assert.DeepSSZEqual(t, wantForkchoice, forkchoiceAtts)
+
+	// Saving the pool again replaces the previous snapshot.
+	wantPool = []*ethpb.Attestation{att(3, 0b1011)}
+	require.NoError(t, db.SaveAttestationPool(ctx, wantPool, nil))
+	poolAtts, forkchoiceAtts, err = db.AttestationPool(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, wantPool, poolAtts)
+	assert.Equal(t, 0, len(forkchoiceAtts))
+}
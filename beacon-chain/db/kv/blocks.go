@@ -3,6 +3,7 @@ package kv
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -560,6 +561,44 @@ func (s *Store) SaveRegistrationsByValidatorIDs(ctx context.Context, ids []types
 	})
 }
 
+// ValidatorRelays returns the builder relay endpoints chosen by each validator that chose some.
+func (s *Store) ValidatorRelays(ctx context.Context) (map[types.ValidatorIndex][]string, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ValidatorRelays")
+	defer span.End()
+	relays := make(map[types.ValidatorIndex][]string)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(builderRelaysBucket).ForEach(func(k, v []byte) error {
+			var endpoints []string
+			if err := json.Unmarshal(v, &endpoints); err != nil {
+				return errors.Wrapf(err, "could not decode relays of validator id %d", bytesutil.BytesToUint64BigEndian(k))
+			}
+			relays[types.ValidatorIndex(bytesutil.BytesToUint64BigEndian(k))] = endpoints
+			return nil
+		})
+	})
+	return relays, err
+}
+
+// SaveValidatorRelays saves the builder relay endpoints chosen by a validator. The saved endpoints are
+// deleted if none are given.
+func (s *Store) SaveValidatorRelays(ctx context.Context, id types.ValidatorIndex, endpoints []string) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorRelays")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(builderRelaysBucket)
+		key := bytesutil.Uint64ToBytesBigEndian(uint64(id))
+		if len(endpoints) == 0 {
+			return bkt.Delete(key)
+		}
+		enc, err := json.Marshal(endpoints)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(key, enc)
+	})
+}
+
 // blockRootsByFilter retrieves the block roots given the filter criteria.
 func blockRootsByFilter(ctx context.Context, tx *bolt.Tx, f *filters.QueryFilter) ([][]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.blockRootsByFilter")
@@ -961,3 +961,27 @@ func TestStore_RegistrationsByValidatorID(t *testing.T) {
 	want := errors.Wrap(ErrNotFoundFeeRecipient, "validator id 3")
 	require.Equal(t, want.Error(), err.Error())
 }
+
+func TestStore_ValidatorRelays(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	relays, err := db.ValidatorRelays(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(relays))
+
+	require.NoError(t, db.SaveValidatorRelays(ctx, 1, []string{"https://relay-a.example.com", "https://relay-b.example.com"}))
+	require.NoError(t, db.SaveValidatorRelays(ctx, 2, []string{"https://relay-b.example.com"}))
+	relays, err = db.ValidatorRelays(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, map[types.ValidatorIndex][]string{
+		1: {"https://relay-a.example.com", "https://relay-b.example.com"},
+		2: {"https://relay-b.example.com"},
+	}, relays)
+
+	// Saving no relays deletes the saved ones.
+	require.NoError(t, db.SaveValidatorRelays(ctx, 1, nil))
+	require.NoError(t, db.SaveValidatorRelays(ctx, 2, []string{"https://relay-a.example.com"}))
+	relays, err = db.ValidatorRelays(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, map[types.ValidatorIndex][]string{2: {"https://relay-a.example.com"}}, relays)
+}
@@ -72,6 +72,8 @@ func isSSZStorageFormat(obj interface{}) bool {
 		return true
 	case *ethpb.ValidatorRegistrationV1:
 		return true
+	case *ethpb.LightClientUpdate:
+		return true
 	default:
 		return false
 	}
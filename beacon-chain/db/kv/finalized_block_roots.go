@@ -4,18 +4,22 @@ import (
 	"bytes"
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
 var previousFinalizedCheckpointKey = []byte("previous-finalized-checkpoint")
 
+var errNotConnectedToFinalized = errors.New("blocks are not connected to the finalized chain")
+
 // Blocks from the recent finalized epoch are not part of the finalized and canonical chain in this
 // index. These containers will be removed on the next update of finalized checkpoint. Note that
 // these block roots may be considered canonical in the "head view" of the beacon chain, but not so
@@ -57,9 +61,31 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 		}
 	}
 
+	deindexStart, err := slots.EpochStart(previousFinalizedCheckpoint.Epoch)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	deindexEnd, err := slots.EpochStart(checkpoint.Epoch + 2)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	// Blocks backfilled below the origin checkpoint are never re-indexed by walking up the ancestry chain,
+	// which stops at the origin checkpoint, so they must not be de-indexed.
+	if initCheckpointRoot != nil {
+		initCheckpointBlock, err := s.Block(ctx, bytesutil.ToBytes32(initCheckpointRoot))
+		if err != nil {
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		if initCheckpointBlock != nil && !initCheckpointBlock.IsNil() && initCheckpointBlock.Block().Slot() > deindexStart {
+			deindexStart = initCheckpointBlock.Block().Slot()
+		}
+	}
 	blockRoots, err := s.BlockRoots(ctx, filters.NewFilter().
-		SetStartEpoch(previousFinalizedCheckpoint.Epoch).
-		SetEndEpoch(checkpoint.Epoch+1),
+		SetStartSlot(deindexStart).
+		SetEndSlot(deindexEnd-1),
 	)
 	if err != nil {
 		tracing.AnnotateError(span, err)
@@ -219,3 +245,69 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (in
 	tracing.AnnotateError(span, err)
 	return blk, err
 }
+
+// BackfillFinalizedIndex adds blocks backfilled below the origin checkpoint to the finalized block roots
+// index, so that they are considered final and canonical. The blocks must be sorted by slot and form a
+// chain, whose last block is the parent of finalizedChildRoot, a block already present in the index.
+func (s *Store) BackfillFinalizedIndex(ctx context.Context, blks []interfaces.SignedBeaconBlock, finalizedChildRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillFinalizedIndex")
+	defer span.End()
+
+	if len(blks) == 0 {
+		return nil
+	}
+	roots := make([][32]byte, len(blks))
+	for i, blk := range blks {
+		if err := blocks.BeaconBlockIsNil(blk); err != nil {
+			return err
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+	}
+	encs := make([][]byte, len(blks))
+	for i, blk := range blks {
+		child := finalizedChildRoot
+		if i+1 < len(blks) {
+			if blks[i+1].Block().ParentRoot() != roots[i] {
+				return errors.Wrapf(errNotConnectedToFinalized, "parent of block %#x is not %#x", roots[i+1], roots[i])
+			}
+			child = roots[i+1]
+		}
+		parent := blk.Block().ParentRoot()
+		enc, err := encode(ctx, &ethpb.FinalizedBlockRootContainer{
+			ParentRoot: parent[:],
+			ChildRoot:  child[:],
+		})
+		if err != nil {
+			return err
+		}
+		encs[i] = enc
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		childEnc := bkt.Get(finalizedChildRoot[:])
+		if childEnc == nil || bytes.Equal(childEnc, containerFinalizedButNotCanonical) {
+			return errors.Wrapf(errNotConnectedToFinalized, "block %#x is not in the finalized index", finalizedChildRoot)
+		}
+		child := &ethpb.FinalizedBlockRootContainer{}
+		if err := decode(ctx, childEnc, child); err != nil {
+			return err
+		}
+		last := roots[len(roots)-1]
+		if !bytes.Equal(child.ParentRoot, last[:]) {
+			return errors.Wrapf(errNotConnectedToFinalized, "parent of block %#x is not %#x", finalizedChildRoot, last)
+		}
+		for i, root := range roots {
+			if err := bkt.Put(root[:], encs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
@@ -193,6 +193,51 @@ func TestStore_IsFinalizedChildBlock(t *testing.T) {
 	})
 }
 
+func TestStore_BackfillFinalizedIndex(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisBlockRoot)
+
+	// The origin checkpoint is in the middle of epoch 2, the blocks before it are backfilled.
+	originIdx := slotsPerEpoch*2 + slotsPerEpoch/2
+	require.NoError(t, db.SaveBlocks(ctx, blks[originIdx:]))
+	originRoot := bytesutil.ToBytes32(sszRootOrDie(t, blks[originIdx]))
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: originRoot[:]}))
+
+	backfilled := blks[:originIdx]
+	require.NoError(t, db.SaveBlocks(ctx, backfilled))
+	for i := range backfilled {
+		assert.Equal(t, false, db.IsFinalizedBlock(ctx, bytesutil.ToBytes32(sszRootOrDie(t, backfilled[i]))))
+	}
+
+	// Blocks which are not connected to the finalized chain are rejected.
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, backfilled[:slotsPerEpoch], originRoot), errNotConnectedToFinalized)
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, []interfaces.SignedBeaconBlock{backfilled[0], backfilled[2]}, originRoot), errNotConnectedToFinalized)
+
+	require.NoError(t, db.BackfillFinalizedIndex(ctx, backfilled[slotsPerEpoch:], originRoot))
+	require.NoError(t, db.BackfillFinalizedIndex(ctx, backfilled[:slotsPerEpoch], bytesutil.ToBytes32(sszRootOrDie(t, backfilled[slotsPerEpoch]))))
+
+	// The backfilled blocks stay in the index as the chain finalizes, including those in the epoch of the origin.
+	lastRoot := bytesutil.ToBytes32(sszRootOrDie(t, blks[len(blks)-1]))
+	require.NoError(t, db.SaveState(ctx, st, lastRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: lastRoot[:]}))
+	for i := range backfilled {
+		root := bytesutil.ToBytes32(sszRootOrDie(t, backfilled[i]))
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+		child, err := db.FinalizedChildBlock(ctx, root)
+		require.NoError(t, err)
+		require.NotNil(t, child)
+		assert.DeepEqual(t, sszRootOrDie(t, blks[i+1]), sszRootOrDie(t, child))
+	}
+}
+
 func sszRootOrDie(t *testing.T, block interfaces.SignedBeaconBlock) []byte {
 	root, err := block.Block().HashTreeRoot()
 	require.NoError(t, err)
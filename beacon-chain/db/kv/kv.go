@@ -121,6 +121,7 @@ var Buckets = [][]byte{
 	blockParentRootIndicesBucket,
 	finalizedBlockRootsIndexBucket,
 	blockRootValidatorHashesBucket,
+	stateDiffRootIndexBucket,
 	// State management service bucket.
 	newStateServiceCompatibleBucket,
 	// Migrations
@@ -128,6 +129,12 @@ var Buckets = [][]byte{
 
 	feeRecipientBucket,
 	registrationBucket,
+	builderRelaysBucket,
+	lightClientUpdateBucket,
+	stateDiffBucket,
+	attestationPoolBucket,
+	peerReputationBucket,
+	peerSubnetBansBucket,
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
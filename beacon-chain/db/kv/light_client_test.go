@@ -0,0 +1,96 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func testLightClientUpdate(signatureSlot types.Slot) *ethpb.LightClientUpdate {
+	header := util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{})
+	pubKeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubKeys {
+		pubKeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	branch := func(depth int) [][]byte {
+		b := make([][]byte, depth)
+		for i := range b {
+			b[i] = make([]byte, fieldparams.RootLength)
+		}
+		return b
+	}
+	return &ethpb.LightClientUpdate{
+		AttestedHeader: header,
+		NextSyncCommittee: &ethpb.SyncCommittee{
+			Pubkeys:         pubKeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: branch(5),
+		FinalizedHeader:         header,
+		FinalityBranch:          branch(6),
+		SyncAggregate: &ethpb.SyncAggregate{
+			SyncCommitteeBits:      bitfield.NewBitvector512(),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		SignatureSlot: signatureSlot,
+	}
+}
+
+func TestStore_LightClientUpdate(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	update, err := db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.LightClientUpdate)(nil), update)
+
+	require.ErrorContains(t, "cannot save nil", db.SaveLightClientUpdate(ctx, 1, nil))
+
+	want := testLightClientUpdate(10)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, update)
+
+	// Saving an update for the same period overwrites the previous one.
+	want = testLightClientUpdate(11)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, update)
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	for _, period := range []uint64{1, 2, 4, 300} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, testLightClientUpdate(types.Slot(period))))
+	}
+
+	updates, err := db.LightClientUpdates(ctx, 2, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(updates))
+	assert.Equal(t, types.Slot(2), updates[0].SignatureSlot)
+	assert.Equal(t, types.Slot(4), updates[1].SignatureSlot)
+
+	updates, err = db.LightClientUpdates(ctx, 0, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(updates))
+	assert.Equal(t, types.Slot(300), updates[3].SignatureSlot)
+
+	updates, err = db.LightClientUpdates(ctx, 5, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(updates))
+
+	_, err = db.LightClientUpdates(ctx, 2, 1)
+	require.ErrorContains(t, "start period cannot be greater", err)
+}
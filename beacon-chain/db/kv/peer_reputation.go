@@ -0,0 +1,202 @@
+package kv
+
+import (
+	"context"
+	"math"
+	"net"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SavePeerReputations replaces the saved peer reputations with the given ones.
+func (s *Store) SavePeerReputations(ctx context.Context, reputations map[peer.ID]*peerdata.Reputation) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SavePeerReputations")
+	defer span.End()
+
+	for pid, reputation := range reputations {
+		if reputation == nil {
+			return errors.Errorf("cannot save nil reputation of peer %s", pid)
+		}
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(peerReputationBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(peerReputationBucket)
+		if err != nil {
+			return err
+		}
+		for pid, reputation := range reputations {
+			if err := bkt.Put([]byte(pid), encodePeerReputation(reputation)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PeerReputations retrieves the reputation of every peer saved in the database.
+func (s *Store) PeerReputations(ctx context.Context) (map[peer.ID]*peerdata.Reputation, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PeerReputations")
+	defer span.End()
+
+	reputations := make(map[peer.ID]*peerdata.Reputation)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(peerReputationBucket).ForEach(func(k, v []byte) error {
+			reputation, err := decodePeerReputation(v)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode reputation of peer %s", peer.ID(k))
+			}
+			reputations[peer.ID(bytesutil.SafeCopyBytes(k))] = reputation
+			return nil
+		})
+	})
+	return reputations, err
+}
+
+// SaveSubnetBan saves the ban of an IP subnet, replacing any previously saved for it.
+func (s *Store) SaveSubnetBan(ctx context.Context, ban *peerdata.SubnetBan) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveSubnetBan")
+	defer span.End()
+
+	if ban == nil || ban.Subnet == nil || ban.Ban == nil {
+		return errors.New("cannot save nil subnet ban")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(peerSubnetBansBucket).Put([]byte(ban.Subnet.String()), encodeBan(make([]byte, 0), ban.Ban))
+	})
+}
+
+// SubnetBans retrieves every IP subnet ban saved in the database.
+func (s *Store) SubnetBans(ctx context.Context) ([]*peerdata.SubnetBan, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SubnetBans")
+	defer span.End()
+
+	bans := make([]*peerdata.SubnetBan, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(peerSubnetBansBucket).ForEach(func(k, v []byte) error {
+			_, subnet, err := net.ParseCIDR(string(k))
+			if err != nil {
+				return errors.Wrapf(err, "could not parse banned subnet %s", string(k))
+			}
+			ban, rest, err := decodeBan(v)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode ban of subnet %s", subnet)
+			}
+			if len(rest) != 0 {
+				return errors.Errorf("%d unexpected trailing bytes in ban of subnet %s", len(rest), subnet)
+			}
+			bans = append(bans, &peerdata.SubnetBan{Subnet: subnet, Ban: ban})
+			return nil
+		})
+	})
+	return bans, err
+}
+
+// DeleteSubnetBan deletes the saved ban of an IP subnet.
+func (s *Store) DeleteSubnetBan(ctx context.Context, subnet *net.IPNet) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteSubnetBan")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(peerSubnetBansBucket).Delete([]byte(subnet.String()))
+	})
+}
+
+// A reputation is encoded as its bad responses count, a flag telling whether a ban follows,
+// the ban if any, the number of score records and the score records.
+func encodePeerReputation(reputation *peerdata.Reputation) []byte {
+	enc := bytesutil.Uint64ToBytesBigEndian(uint64(reputation.BadResponses))
+	if reputation.Ban != nil {
+		enc = append(enc, 1)
+		enc = encodeBan(enc, reputation.Ban)
+	} else {
+		enc = append(enc, 0)
+	}
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(uint64(len(reputation.ScoreHistory)))...)
+	for _, record := range reputation.ScoreHistory {
+		enc = append(enc, encodeTime(record.Time)...)
+		enc = append(enc, bytesutil.Uint64ToBytesBigEndian(math.Float64bits(record.Score))...)
+	}
+	return enc
+}
+
+func decodePeerReputation(enc []byte) (*peerdata.Reputation, error) {
+	if len(enc) < 9 {
+		return nil, errors.Errorf("reputation of length %d is too short", len(enc))
+	}
+	reputation := &peerdata.Reputation{
+		BadResponses: int(bytesutil.BytesToUint64BigEndian(enc[:8])),
+	}
+	hasBan, rest := enc[8], enc[9:]
+	if hasBan == 1 {
+		var err error
+		reputation.Ban, rest, err = decodeBan(rest)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(rest) < 8 {
+		return nil, errors.New("missing score history length")
+	}
+	n := bytesutil.BytesToUint64BigEndian(rest[:8])
+	rest = rest[8:]
+	if len(rest)%16 != 0 || uint64(len(rest)/16) != n {
+		return nil, errors.Errorf("got %d bytes for %d score records", len(rest), n)
+	}
+	reputation.ScoreHistory = make([]*peerdata.ScoreRecord, n)
+	for i := range reputation.ScoreHistory {
+		reputation.ScoreHistory[i] = &peerdata.ScoreRecord{
+			Time:  decodeTime(rest[:8]),
+			Score: math.Float64frombits(bytesutil.BytesToUint64BigEndian(rest[8:16])),
+		}
+		rest = rest[16:]
+	}
+	return reputation, nil
+}
+
+// A ban is encoded as its creation time, its expiry, the length of its reason and its reason.
+func encodeBan(enc []byte, ban *peerdata.Ban) []byte {
+	enc = append(enc, encodeTime(ban.Created)...)
+	enc = append(enc, encodeTime(ban.Expiry)...)
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(uint64(len(ban.Reason)))...)
+	return append(enc, ban.Reason...)
+}
+
+func decodeBan(enc []byte) (*peerdata.Ban, []byte, error) {
+	if len(enc) < 24 {
+		return nil, nil, errors.Errorf("ban of length %d is too short", len(enc))
+	}
+	reasonLength := bytesutil.BytesToUint64BigEndian(enc[16:24])
+	if uint64(len(enc)-24) < reasonLength {
+		return nil, nil, errors.Errorf("got %d bytes for a reason of length %d", len(enc)-24, reasonLength)
+	}
+	ban := &peerdata.Ban{
+		Created: decodeTime(enc[:8]),
+		Expiry:  decodeTime(enc[8:16]),
+		Reason:  string(enc[24 : 24+reasonLength]),
+	}
+	return ban, enc[24+reasonLength:], nil
+}
+
+// Times are encoded as unix nanoseconds, with the zero time encoded as 0.
+func encodeTime(t time.Time) []byte {
+	if t.IsZero() {
+		return bytesutil.Uint64ToBytesBigEndian(0)
+	}
+	return bytesutil.Uint64ToBytesBigEndian(uint64(t.UnixNano()))
+}
+
+func decodeTime(enc []byte) time.Time {
+	n := bytesutil.BytesToUint64BigEndian(enc)
+	if n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(n))
+}
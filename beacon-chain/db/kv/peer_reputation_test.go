@@ -0,0 +1,97 @@
+package kv
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_PeerReputations(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	reputations, err := db.PeerReputations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(reputations))
+
+	want := map[peer.ID]*peerdata.Reputation{
+		"banned": {
+			BadResponses: 6,
+			Ban: &peerdata.Ban{
+				Reason:  "sent invalid blocks",
+				Created: time.Unix(0, 1000),
+				Expiry:  time.Unix(0, 2000),
+			},
+			ScoreHistory: []*peerdata.ScoreRecord{},
+		},
+		"scored": {
+			ScoreHistory: []*peerdata.ScoreRecord{
+				{Time: time.Unix(0, 1000), Score: 0.5},
+				{Time: time.Unix(0, 2000), Score: -10.25},
+			},
+		},
+		"permanently-banned": {
+			Ban:          &peerdata.Ban{Created: time.Unix(0, 1000)},
+			ScoreHistory: []*peerdata.ScoreRecord{},
+		},
+	}
+	require.NoError(t, db.SavePeerReputations(ctx, want))
+	reputations, err = db.PeerReputations(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, reputations)
+
+	// Saving reputations replaces all the previously saved ones.
+	want = map[peer.ID]*peerdata.Reputation{
+		"scored": {BadResponses: 1, ScoreHistory: []*peerdata.ScoreRecord{}},
+	}
+	require.NoError(t, db.SavePeerReputations(ctx, want))
+	reputations, err = db.PeerReputations(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, reputations)
+
+	require.ErrorContains(t, "cannot save nil", db.SavePeerReputations(ctx, map[peer.ID]*peerdata.Reputation{"nil": nil}))
+}
+
+func TestStore_SubnetBans(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	_, first, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	_, second, err := net.ParseCIDR("2001:db8::/32")
+	require.NoError(t, err)
+	want := []*peerdata.SubnetBan{
+		{Subnet: first, Ban: &peerdata.Ban{Reason: "sybil", Created: time.Unix(0, 1000)}},
+		{Subnet: second, Ban: &peerdata.Ban{Created: time.Unix(0, 1000), Expiry: time.Unix(0, 2000)}},
+	}
+	for _, ban := range want {
+		require.NoError(t, db.SaveSubnetBan(ctx, ban))
+	}
+	bans, err := db.SubnetBans(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, bans)
+
+	require.NoError(t, db.DeleteSubnetBan(ctx, first))
+	bans, err = db.SubnetBans(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want[1:], bans)
+
+	require.ErrorContains(t, "cannot save nil", db.SaveSubnetBan(ctx, &peerdata.SubnetBan{Subnet: first}))
+}
+
+func TestDecodePeerReputation_Malformed(t *testing.T) {
+	enc := encodePeerReputation(&peerdata.Reputation{
+		Ban:          &peerdata.Ban{Reason: "spam"},
+		ScoreHistory: []*peerdata.ScoreRecord{{Time: time.Unix(0, 1000), Score: 1}},
+	})
+	for i := 0; i < len(enc); i++ {
+		_, err := decodePeerReputation(enc[:i])
+		assert.NotNil(t, err, "Truncated reputation of length %d decoded", i)
+	}
+}
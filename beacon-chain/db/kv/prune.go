@@ -0,0 +1,299 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PrunedSlot returns the slot below which the history was deleted by PruneHistory.
+func (s *Store) PrunedSlot(ctx context.Context) (types.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PrunedSlot")
+	defer span.End()
+	var slot types.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(prunedSlotKey)
+		if enc != nil {
+			slot = bytesutil.BytesToSlotBigEndian(enc)
+		}
+		return nil
+	})
+	return slot, err
+}
+
+// PruneHistory deletes the blocks, blinded or not, states, state summaries, archived point and
+// finalized block root index entries of the slots from PrunedSlot up to the cutoff. The genesis,
+// origin checkpoint, backfill and justified and finalized checkpoint blocks and states are kept.
+// At most limit slots are pruned per call so the database lock is not held for too long, with
+// 0 meaning no limit. It returns the slot below which the history is now pruned. The cutoff should
+// be the slot of a block whose state is archived, as the history above it is replayed from that state.
+func (s *Store) PruneHistory(ctx context.Context, cutoff types.Slot, limit uint64) (types.Slot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistory")
+	defer span.End()
+
+	start, err := s.PrunedSlot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if start >= cutoff {
+		return start, nil
+	}
+	end := cutoff
+	if limit > 0 && uint64(cutoff-start) > limit {
+		end = start + types.Slot(limit)
+	}
+
+	var prunedRoots [][32]byte
+	var validatorHashes [][]byte
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		protected, err := protectedRoots(ctx, tx)
+		if err != nil {
+			return err
+		}
+		roots := make(map[[32]byte]bool)
+		for _, bkt := range []*bolt.Bucket{tx.Bucket(blockSlotIndicesBucket), tx.Bucket(stateSlotIndicesBucket)} {
+			if err := pruneSlotIndex(bkt, start, end, protected, roots); err != nil {
+				return err
+			}
+		}
+		for root := range roots {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			hashes, err := deleteRootHistory(tx, root)
+			if err != nil {
+				return err
+			}
+			validatorHashes = append(validatorHashes, hashes...)
+			prunedRoots = append(prunedRoots, root)
+		}
+		if end == cutoff {
+			// Telling whether a validator entry is still referenced means going through the validator
+			// entry keys of every remaining state, so it is only done once per pass, at its last batch.
+			deleted, err := deleteUnreferencedValidatorEntries(tx)
+			if err != nil {
+				return err
+			}
+			validatorHashes = append(validatorHashes, deleted...)
+			if err := pruneStateDiffs(tx, cutoff); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(chainMetadataBucket).Put(prunedSlotKey, bytesutil.SlotToBytesBigEndian(end))
+	})
+	if err != nil {
+		return start, err
+	}
+
+	for _, root := range prunedRoots {
+		s.blockCache.Del(string(root[:]))
+		s.stateSummaryCache.delete(root)
+	}
+	for _, h := range validatorHashes {
+		s.validatorEntryCache.Del(h)
+		validatorEntryCacheDelete.Inc()
+	}
+	return end, nil
+}
+
+// protectedRoots returns the block roots whose blocks and states are never pruned.
+func protectedRoots(ctx context.Context, tx *bolt.Tx) (map[[32]byte]bool, error) {
+	protected := make(map[[32]byte]bool)
+	blocks := tx.Bucket(blocksBucket)
+	for _, key := range [][]byte{genesisBlockRootKey, originCheckpointBlockRootKey, backfillBlockRootKey} {
+		if root := blocks.Get(key); root != nil {
+			protected[bytesutil.ToBytes32(root)] = true
+		}
+	}
+	checkpoints := tx.Bucket(checkpointBucket)
+	for _, key := range [][]byte{justifiedCheckpointKey, finalizedCheckpointKey} {
+		enc := checkpoints.Get(key)
+		if enc == nil {
+			continue
+		}
+		cp := &ethpb.Checkpoint{}
+		if err := decode(ctx, enc, cp); err != nil {
+			return nil, err
+		}
+		protected[bytesutil.ToBytes32(cp.Root)] = true
+	}
+	return protected, nil
+}
+
+// pruneSlotIndex collects the roots indexed by the slots in [start, end) of a slot index bucket
+// and removes them from the index, keeping only the protected roots.
+func pruneSlotIndex(bkt *bolt.Bucket, start, end types.Slot, protected, roots map[[32]byte]bool) error {
+	type update struct {
+		key, value []byte
+	}
+	var updates []update
+	c := bkt.Cursor()
+	endKey := bytesutil.SlotToBytesBigEndian(end)
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(start)); k != nil && bytes.Compare(k, endKey) < 0; k, v = c.Next() {
+		var kept []byte
+		for i := 0; i+32 <= len(v); i += 32 {
+			root := bytesutil.ToBytes32(v[i : i+32])
+			if protected[root] {
+				kept = append(kept, root[:]...)
+				continue
+			}
+			roots[root] = true
+		}
+		updates = append(updates, update{key: bytesutil.SafeCopyBytes(k), value: kept})
+	}
+	// Modifying a bucket while iterating over it with a cursor is not safe, so updates are applied afterwards.
+	for _, u := range updates {
+		if len(u.value) == 0 {
+			if err := bkt.Delete(u.key); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := bkt.Put(u.key, u.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteRootHistory deletes everything stored for a block root and returns the validator entry
+// keys of its state, which must be evicted from the validator entry cache. The entries themselves
+// are deleted by deleteUnreferencedValidatorEntries once no other state references them.
+func deleteRootHistory(tx *bolt.Tx, root [32]byte) ([][]byte, error) {
+	for _, b := range [][]byte{
+		blocksBucket,
+		blockParentRootIndicesBucket,
+		stateBucket,
+		stateSummaryBucket,
+		finalizedBlockRootsIndexBucket,
+	} {
+		if err := tx.Bucket(b).Delete(root[:]); err != nil {
+			return nil, err
+		}
+	}
+
+	idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+	compressed := idxBkt.Get(root[:])
+	if compressed == nil {
+		return nil, nil
+	}
+	validatorHashes, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to uncompress validator keys")
+	}
+	if len(validatorHashes)%hashLength != 0 {
+		return nil, errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
+	}
+	hashes := make([][]byte, 0, len(validatorHashes)/hashLength)
+	for i := 0; i < len(validatorHashes); i += hashLength {
+		hashes = append(hashes, validatorHashes[i:i+hashLength])
+	}
+	return hashes, idxBkt.Delete(root[:])
+}
+
+// deleteUnreferencedValidatorEntries deletes the validator entries which are not referenced by any
+// of the remaining states, and returns their keys.
+func deleteUnreferencedValidatorEntries(tx *bolt.Tx) ([][]byte, error) {
+	referenced := make(map[string]bool)
+	if err := tx.Bucket(blockRootValidatorHashesBucket).ForEach(func(_, v []byte) error {
+		validatorHashes, err := snappy.Decode(nil, v)
+		if err != nil {
+			return errors.Wrap(err, "failed to uncompress validator keys")
+		}
+		for i := 0; i+hashLength <= len(validatorHashes); i += hashLength {
+			referenced[string(validatorHashes[i:i+hashLength])] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	valBkt := tx.Bucket(stateValidatorsBucket)
+	var unreferenced [][]byte
+	if err := valBkt.ForEach(func(k, _ []byte) error {
+		if !referenced[string(k)] {
+			unreferenced = append(unreferenced, bytesutil.SafeCopyBytes(k))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	// Modifying a bucket while iterating over it is not safe, so the entries are deleted afterwards.
+	for _, k := range unreferenced {
+		if err := valBkt.Delete(k); err != nil {
+			return nil, err
+		}
+	}
+	return unreferenced, nil
+}
+
+// pruneStateDiffs deletes the state diffs below the cutoff, except for the ones that the
+// state diffs at or above the cutoff are built upon, along with their block root indices.
+func pruneStateDiffs(tx *bolt.Tx, cutoff types.Slot) error {
+	bkt := tx.Bucket(stateDiffBucket)
+	cutoffKey := bytesutil.SlotToBytesBigEndian(cutoff)
+	bases := make(map[types.Slot]types.Slot)
+	needed := make(map[types.Slot]bool)
+	var retained []types.Slot
+	if err := bkt.ForEach(func(k, v []byte) error {
+		if len(v) < stateDiffHeaderSize {
+			return errCorruptStateDiff
+		}
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if v[0] == stateDiffLayer {
+			bases[slot] = bytesutil.BytesToSlotBigEndian(v[1:stateDiffHeaderSize])
+		}
+		if bytes.Compare(k, cutoffKey) >= 0 {
+			retained = append(retained, slot)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, slot := range retained {
+		base, ok := bases[slot]
+		for ok && base < cutoff && !needed[base] {
+			needed[base] = true
+			base, ok = bases[base]
+		}
+	}
+
+	deleted := make(map[types.Slot]bool)
+	c := bkt.Cursor()
+	for k, _ := c.First(); k != nil && bytes.Compare(k, cutoffKey) < 0; k, _ = c.Next() {
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if !needed[slot] {
+			deleted[slot] = true
+		}
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+	for slot := range deleted {
+		if err := bkt.Delete(bytesutil.SlotToBytesBigEndian(slot)); err != nil {
+			return err
+		}
+	}
+	idxBkt := tx.Bucket(stateDiffRootIndexBucket)
+	var staleRoots [][]byte
+	if err := idxBkt.ForEach(func(k, v []byte) error {
+		if deleted[bytesutil.BytesToSlotBigEndian(v)] {
+			staleRoots = append(staleRoots, bytesutil.SafeCopyBytes(k))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, root := range staleRoots {
+		if err := idxBkt.Delete(root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
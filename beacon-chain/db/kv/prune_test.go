@@ -0,0 +1,155 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/config/features"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_PruneHistory(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis := util.NewBeaconBlock()
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, db, genesis)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, gRoot))
+
+	blks := makeBlocks(t, 0, 64, gRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: b.Block().Slot(), Root: roots[i][:]}))
+		if b.Block().Slot()%8 == 0 {
+			st, err := util.NewBeaconState()
+			require.NoError(t, err)
+			require.NoError(t, st.SetSlot(b.Block().Slot()))
+			require.NoError(t, db.SaveState(ctx, st, roots[i]))
+		}
+	}
+	// Block i is at slot i+1, so the finalized block is at slot 40.
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[39][:]}))
+
+	pruned, err := db.PruneHistory(ctx, 32, 20)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), pruned)
+	pruned, err = db.PruneHistory(ctx, 32, 20)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(32), pruned)
+	pruned, err = db.PruneHistory(ctx, 32, 20)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(32), pruned)
+	prunedSlot, err := db.PrunedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(32), prunedSlot)
+
+	for i, b := range blks {
+		retained := b.Block().Slot() >= 32
+		assert.Equal(t, retained, db.HasBlock(ctx, roots[i]), "slot %d", b.Block().Slot())
+		assert.Equal(t, retained, db.HasStateSummary(ctx, roots[i]), "slot %d", b.Block().Slot())
+		if b.Block().Slot()%8 == 0 {
+			assert.Equal(t, retained, db.HasState(ctx, roots[i]), "slot %d", b.Block().Slot())
+		}
+		if !retained {
+			assert.Equal(t, false, db.IsFinalizedBlock(ctx, roots[i]), "slot %d", b.Block().Slot())
+		}
+	}
+	assert.Equal(t, true, db.HasBlock(ctx, gRoot))
+	assert.Equal(t, true, db.HasState(ctx, gRoot))
+	_, highest, err := db.HighestRootsBelowSlot(ctx, 31)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{gRoot}, highest)
+}
+
+func TestStore_PruneHistory_DeletesUnreferencedValidatorEntries(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableHistoricalSpaceRepresentation: true})
+	defer resetCfg()
+	db := setupDB(t)
+	ctx := context.Background()
+
+	blks := makeBlocks(t, 0, 16, [32]byte{})
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	var err error
+	for i, b := range blks {
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	// The pruned state shares its first validator with the retained state.
+	shared := validators(1)[0]
+	pruned, retained := validators(2), validators(2)
+	pruned[0], retained[0] = shared, shared
+	for _, s := range []struct {
+		root [32]byte
+		slot types.Slot
+		vals []*ethpb.Validator
+	}{{roots[3], 4, pruned}, {roots[11], 12, retained}} {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(s.slot))
+		require.NoError(t, st.SetValidators(s.vals))
+		require.NoError(t, db.SaveState(ctx, st, s.root))
+	}
+
+	hasEntry := func(v *ethpb.Validator) bool {
+		key, err := v.HashTreeRoot()
+		require.NoError(t, err)
+		found := false
+		require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+			found = tx.Bucket(stateValidatorsBucket).Get(key[:]) != nil
+			return nil
+		}))
+		return found
+	}
+	// The entries are only swept by the last batch of a pass.
+	_, err = db.PruneHistory(ctx, 8, 5)
+	require.NoError(t, err)
+	assert.Equal(t, true, hasEntry(pruned[1]))
+	_, err = db.PruneHistory(ctx, 8, 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, true, hasEntry(shared))
+	assert.Equal(t, false, hasEntry(pruned[1]))
+	assert.Equal(t, true, hasEntry(retained[1]))
+	got, err := db.State(ctx, roots[11])
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, retained, got.Validators())
+}
+
+func TestStore_PruneHistory_KeepsStateDiffBases(t *testing.T) {
+	setupStateDiffExponents(t, []uint64{5, 6})
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	for slot := types.Slot(0); slot <= 128; slot += 32 {
+		advanceStateForDiff(t, st, slot)
+		require.NoError(t, db.SaveStateDiff(ctx, st, bytesutil.ToBytes32(bytesutil.SlotToBytesBigEndian(slot))))
+	}
+
+	_, err := db.PruneHistory(ctx, 96, 0)
+	require.NoError(t, err)
+
+	slots, err := db.StateDiffSlots(ctx)
+	require.NoError(t, err)
+	// The state at slot 96 is a diff against the snapshot at slot 64.
+	assert.DeepEqual(t, []types.Slot{64, 96, 128}, slots)
+	assert.Equal(t, false, db.HasState(ctx, bytesutil.ToBytes32(bytesutil.SlotToBytesBigEndian(32))))
+	got, err := db.State(ctx, bytesutil.ToBytes32(bytesutil.SlotToBytesBigEndian(96)))
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(96), got.Slot())
+}
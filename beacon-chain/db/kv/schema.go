@@ -20,6 +20,12 @@ var (
 	stateValidatorsBucket   = []byte("state-validators")
 	feeRecipientBucket      = []byte("fee-recipient")
 	registrationBucket      = []byte("registration")
+	builderRelaysBucket     = []byte("builder-relays")
+	lightClientUpdateBucket = []byte("light-client-updates")
+	stateDiffBucket         = []byte("state-diffs")
+	attestationPoolBucket   = []byte("attestation-pool")
+	peerReputationBucket    = []byte("peer-reputation")
+	peerSubnetBansBucket    = []byte("peer-subnet-bans")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -37,6 +43,7 @@ var (
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
 	blockRootValidatorHashesBucket      = []byte("block-root-validator-hashes")
+	stateDiffRootIndexBucket            = []byte("state-diff-root-indices")
 
 	// Specific item keys.
 	headBlockRootKey           = []byte("head-root")
@@ -46,6 +53,7 @@ var (
 	finalizedCheckpointKey     = []byte("finalized-checkpoint")
 	powchainDataKey            = []byte("powchain-data")
 	lastValidatedCheckpointKey = []byte("last-validated-checkpoint")
+	prunedSlotKey              = []byte("pruned-slot")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
@@ -35,7 +35,8 @@ func (s *Store) State(ctx context.Context, blockRoot [32]byte) (state.BeaconStat
 	}
 
 	if len(enc) == 0 {
-		return nil, nil
+		// Fall back to the states archived as diffs.
+		return s.stateFromDiffs(ctx, blockRoot)
 	}
 	// get the validator entries of the state
 	valEntries, valErr := s.validatorEntries(ctx, blockRoot)
@@ -357,7 +358,7 @@ func (s *Store) HasState(ctx context.Context, blockRoot [32]byte) bool {
 	err := s.db.View(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(stateBucket)
 		stBytes := bkt.Get(blockRoot[:])
-		if len(stBytes) > 0 {
+		if len(stBytes) > 0 || tx.Bucket(stateDiffRootIndexBucket).Get(blockRoot[:]) != nil {
 			hasState = true
 		}
 		return nil
@@ -806,6 +807,7 @@ func createStateIndicesFromStateSlot(ctx context.Context, slot types.Slot) map[s
 //
 // 3.) state with current finalized root
 // 4.) unfinalized States
+// 5.) state of the last block before a slot archived as a state diff after skipped slots
 func (s *Store) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB. CleanUpDirtyStates")
 	defer span.End()
@@ -832,8 +834,9 @@ func (s *Store) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint ty
 			mod := slot % slotsPerArchivedPoint
 			nonFinalized := slot > finalizedSlot
 
-			// The following conditions cover 1, 2, 3 and 4 above.
-			if mod != 0 && mod <= slotsPerArchivedPoint-slotsPerArchivedPoint/3 && !finalizedChkpt && !nonFinalized {
+			// The following conditions cover 1, 2, 3, 4 and 5 above.
+			if mod != 0 && mod <= slotsPerArchivedPoint-slotsPerArchivedPoint/3 && !finalizedChkpt && !nonFinalized &&
+				!isStateDiffBase(tx, slot) {
 				deletedRoots = append(deletedRoots, bytesutil.ToBytes32(v))
 			}
 			return nil
@@ -0,0 +1,693 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Historical states can be archived as a hierarchy of diffs instead of full snapshots.
+// Given the exponents e_0 < e_1 < ... < e_n of params.BeaconConfig().StateDiffExponents,
+// the state at a slot which is a multiple of 2^e_n is stored in full. The state at any
+// other multiple of 2^e_0 is stored as a diff against the state at the slot rounded down
+// to the next coarser interval, so rebuilding a state never takes more than n diffs.
+//
+// Each entry of the state diff bucket is keyed by slot and holds a kind byte, the slot of
+// the base state and the snappy compressed diff. A snapshot is a diff against an empty state.
+
+const (
+	stateDiffSnapshot byte = iota
+	stateDiffLayer
+)
+
+const stateDiffHeaderSize = 1 + 8
+
+var (
+	errInvalidStateDiffSlot = errors.New("slot is not on a state diff boundary")
+	errMissingStateDiffBase = errors.New("state diff base is missing")
+	errCorruptStateDiff     = errors.New("corrupt state diff")
+)
+
+// SaveStateDiff archives a state in the hierarchical state diff storage. The state slot
+// must be a multiple of the finest state diff interval. The block root is indexed to the
+// slot, so the state can later be retrieved with State. A zero block root archives the
+// state by slot only, as for a state advanced through skipped slots past its block, which
+// must not be returned in place of the state of the block itself.
+func (s *Store) SaveStateDiff(ctx context.Context, st state.BeaconState, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+	if st == nil || st.IsNil() {
+		return errors.New("nil state")
+	}
+	slot := st.Slot()
+	baseSlot, snapshot, err := stateDiffBaseSlot(slot, params.BeaconConfig().StateDiffExponents)
+	if err != nil {
+		return err
+	}
+	key := bytesutil.SlotToBytesBigEndian(slot)
+
+	var exists bool
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		exists = tx.Bucket(stateDiffBucket).Get(key) != nil
+		return nil
+	}); err != nil {
+		return err
+	}
+	indexRoot := blockRoot != params.BeaconConfig().ZeroHash
+	if exists {
+		if !indexRoot {
+			return nil
+		}
+		return s.saveStateDiffRoot(blockRoot, slot)
+	}
+
+	var base state.BeaconState
+	if !snapshot {
+		base, baseSlot, err = s.stateDiffBase(ctx, slot, baseSlot)
+		if err != nil {
+			return err
+		}
+	}
+	kind := stateDiffLayer
+	if base == nil {
+		kind = stateDiffSnapshot
+		baseSlot = 0
+	}
+	diff, err := encodeStateDiff(ctx, base, st)
+	if err != nil {
+		return err
+	}
+	enc := make([]byte, 0, stateDiffHeaderSize+len(diff))
+	enc = append(enc, kind)
+	enc = append(enc, bytesutil.SlotToBytesBigEndian(baseSlot)...)
+	enc = append(enc, diff...)
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(stateDiffBucket).Put(key, enc); err != nil {
+			return err
+		}
+		if !indexRoot {
+			return nil
+		}
+		return putStateDiffRoot(tx, blockRoot, slot)
+	})
+}
+
+// StateDiffSlots returns the slots of all the states stored in the state diff storage.
+func (s *Store) StateDiffSlots(ctx context.Context) ([]types.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.StateDiffSlots")
+	defer span.End()
+	var slots []types.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateDiffBucket).ForEach(func(k, _ []byte) error {
+			slots = append(slots, bytesutil.BytesToSlotBigEndian(k))
+			return nil
+		})
+	})
+	return slots, err
+}
+
+// stateFromDiffs rebuilds the state indexed by the block root from the state diff storage.
+// It returns nil if no such state was archived.
+func (s *Store) stateFromDiffs(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	var slot types.Slot
+	found := false
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(stateDiffRootIndexBucket).Get(blockRoot[:])
+		if enc != nil {
+			found = true
+			slot = bytesutil.BytesToSlotBigEndian(enc)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+	return s.stateDiffAt(ctx, slot)
+}
+
+// stateDiffAt rebuilds the state archived at the given slot by recursively applying
+// its diff to the state of its base slot. It returns nil if the slot was not archived.
+func (s *Store) stateDiffAt(ctx context.Context, slot types.Slot) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateDiffAt")
+	defer span.End()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	var enc []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		// Bolt values are only valid for the lifetime of the transaction.
+		enc = bytesutil.SafeCopyBytes(tx.Bucket(stateDiffBucket).Get(bytesutil.SlotToBytesBigEndian(slot)))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if enc == nil {
+		return nil, nil
+	}
+	if len(enc) < stateDiffHeaderSize {
+		return nil, errCorruptStateDiff
+	}
+	var base state.BeaconState
+	if enc[0] == stateDiffLayer {
+		baseSlot := bytesutil.BytesToSlotBigEndian(enc[1:stateDiffHeaderSize])
+		var err error
+		base, err = s.stateDiffAt(ctx, baseSlot)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			return nil, errors.Wrapf(errMissingStateDiffBase, "no state diff at slot %d", baseSlot)
+		}
+	}
+	return s.applyStateDiff(ctx, base, enc[stateDiffHeaderSize:])
+}
+
+// stateDiffBase returns the state to diff the state at slot against, along with its slot.
+// This is the state at the hierarchical base slot when it is archived. Otherwise, which happens
+// when archiving starts past genesis, it falls back to the closest snapshot below slot so the diff
+// chain stays short. A nil state is returned if there is no snapshot to diff against.
+func (s *Store) stateDiffBase(ctx context.Context, slot, baseSlot types.Slot) (state.BeaconState, types.Slot, error) {
+	found := false
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		if bkt.Get(bytesutil.SlotToBytesBigEndian(baseSlot)) != nil {
+			found = true
+			return nil
+		}
+		c := bkt.Cursor()
+		k, v := c.Seek(bytesutil.SlotToBytesBigEndian(slot))
+		if k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
+		}
+		for ; k != nil; k, v = c.Prev() {
+			if len(v) > 0 && v[0] == stateDiffSnapshot {
+				found = true
+				baseSlot = bytesutil.BytesToSlotBigEndian(k)
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+	if !found {
+		return nil, 0, nil
+	}
+	base, err := s.stateDiffAt(ctx, baseSlot)
+	if err != nil {
+		return nil, 0, err
+	}
+	return base, baseSlot, nil
+}
+
+func (s *Store) saveStateDiffRoot(blockRoot [32]byte, slot types.Slot) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putStateDiffRoot(tx, blockRoot, slot)
+	})
+}
+
+// isStateDiffBase returns true if the state saved at slot is the state of the last block before a slot
+// archived in the state diff storage. The archived state was advanced through skipped slots past the
+// block, so it is not indexed by the block root, and the state of the block must be kept to replay from.
+func isStateDiffBase(tx *bolt.Tx, slot types.Slot) bool {
+	start := bytesutil.SlotToBytesBigEndian(slot + 1)
+	diffSlot, _ := tx.Bucket(stateDiffBucket).Cursor().Seek(start)
+	if diffSlot == nil {
+		return false
+	}
+	blockSlot, _ := tx.Bucket(blockSlotIndicesBucket).Cursor().Seek(start)
+	return blockSlot == nil || bytes.Compare(diffSlot, blockSlot) < 0
+}
+
+// putStateDiffRoot indexes the block root to the slot. A block root followed by skipped
+// slots may cover several archived slots, in which case the earliest one is kept.
+func putStateDiffRoot(tx *bolt.Tx, blockRoot [32]byte, slot types.Slot) error {
+	bkt := tx.Bucket(stateDiffRootIndexBucket)
+	if bkt.Get(blockRoot[:]) != nil {
+		return nil
+	}
+	return bkt.Put(blockRoot[:], bytesutil.SlotToBytesBigEndian(slot))
+}
+
+// stateDiffBaseSlot returns the slot of the state that the state at slot is diffed against,
+// or true if the state at slot is stored as a full snapshot.
+func stateDiffBaseSlot(slot types.Slot, exponents []uint64) (types.Slot, bool, error) {
+	if len(exponents) == 0 {
+		return 0, false, errors.New("no state diff exponents configured")
+	}
+	if uint64(slot)%(uint64(1)<<exponents[0]) != 0 {
+		return 0, false, errors.Wrapf(errInvalidStateDiffSlot, "slot %d", slot)
+	}
+	layer := 0
+	for i, e := range exponents {
+		if uint64(slot)%(uint64(1)<<e) == 0 {
+			layer = i
+		}
+	}
+	if layer == len(exponents)-1 {
+		return 0, true, nil
+	}
+	return roundDownSlot(slot, exponents[layer+1]), false, nil
+}
+
+func roundDownSlot(slot types.Slot, exponent uint64) types.Slot {
+	return slot - slot%types.Slot(uint64(1)<<exponent)
+}
+
+// encodeStateDiff encodes the difference from base to st. The validators, balances, inactivity
+// scores, participation and the randao mixes, block roots and state roots vectors are encoded
+// field by field, while the rest of the state is stored as a regular state encoding with those
+// fields emptied. A nil base encodes st in full.
+func encodeStateDiff(ctx context.Context, base, st state.BeaconState) ([]byte, error) {
+	rest := st.Copy()
+	if err := clearStateDiffFields(rest); err != nil {
+		return nil, err
+	}
+	restEnc, err := marshalState(ctx, rest)
+	if err != nil {
+		return nil, err
+	}
+	buf := binary.AppendUvarint(nil, uint64(len(restEnc)))
+	buf = append(buf, restEnc...)
+
+	var baseVals []*ethpb.Validator
+	var baseBals, baseInactivity []uint64
+	var baseRandao, baseBlockRoots, baseStateRoots [][]byte
+	if base != nil {
+		baseVals = base.Validators()
+		baseBals = base.Balances()
+		baseRandao = base.RandaoMixes()
+		baseBlockRoots = base.BlockRoots()
+		baseStateRoots = base.StateRoots()
+		if base.Version() >= version.Altair {
+			if baseInactivity, err = base.InactivityScores(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if buf, err = appendValidatorsDiff(buf, baseVals, st.Validators()); err != nil {
+		return nil, err
+	}
+	buf = appendUint64sDiff(buf, baseBals, st.Balances())
+	if st.Version() >= version.Altair {
+		inactivity, err := st.InactivityScores()
+		if err != nil {
+			return nil, err
+		}
+		prev, err := st.PreviousEpochParticipation()
+		if err != nil {
+			return nil, err
+		}
+		curr, err := st.CurrentEpochParticipation()
+		if err != nil {
+			return nil, err
+		}
+		buf = appendUint64sDiff(buf, baseInactivity, inactivity)
+		buf = appendBytes(buf, prev)
+		buf = appendBytes(buf, curr)
+	}
+	buf = appendRootsDiff(buf, baseRandao, st.RandaoMixes())
+	buf = appendRootsDiff(buf, baseBlockRoots, st.BlockRoots())
+	buf = appendRootsDiff(buf, baseStateRoots, st.StateRoots())
+	return snappy.Encode(nil, buf), nil
+}
+
+// applyStateDiff decodes a diff produced by encodeStateDiff and applies it to base.
+func (s *Store) applyStateDiff(ctx context.Context, base state.BeaconState, enc []byte) (state.BeaconState, error) {
+	dec, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	r := &stateDiffReader{buf: dec}
+	restEnc, err := r.lengthPrefixed()
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.unmarshalState(ctx, restEnc, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseVals []*ethpb.Validator
+	var baseBals, baseInactivity []uint64
+	var baseRandao, baseBlockRoots, baseStateRoots [][]byte
+	if base != nil {
+		baseVals = base.Validators()
+		baseBals = base.Balances()
+		baseRandao = base.RandaoMixes()
+		baseBlockRoots = base.BlockRoots()
+		baseStateRoots = base.StateRoots()
+		if base.Version() >= version.Altair {
+			if baseInactivity, err = base.InactivityScores(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	vals, err := r.validatorsDiff(baseVals)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.SetValidators(vals); err != nil {
+		return nil, err
+	}
+	bals, err := r.uint64sDiff(baseBals)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.SetBalances(bals); err != nil {
+		return nil, err
+	}
+	if st.Version() >= version.Altair {
+		inactivity, err := r.uint64sDiff(baseInactivity)
+		if err != nil {
+			return nil, err
+		}
+		if err := st.SetInactivityScores(inactivity); err != nil {
+			return nil, err
+		}
+		prev, err := r.lengthPrefixed()
+		if err != nil {
+			return nil, err
+		}
+		if err := st.SetPreviousParticipationBits(bytesutil.SafeCopyBytes(prev)); err != nil {
+			return nil, err
+		}
+		curr, err := r.lengthPrefixed()
+		if err != nil {
+			return nil, err
+		}
+		if err := st.SetCurrentParticipationBits(bytesutil.SafeCopyBytes(curr)); err != nil {
+			return nil, err
+		}
+	}
+	randao, err := r.rootsDiff(baseRandao)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.SetRandaoMixes(randao); err != nil {
+		return nil, err
+	}
+	blockRoots, err := r.rootsDiff(baseBlockRoots)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.SetBlockRoots(blockRoots); err != nil {
+		return nil, err
+	}
+	stateRoots, err := r.rootsDiff(baseStateRoots)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.SetStateRoots(stateRoots); err != nil {
+		return nil, err
+	}
+	if len(r.buf) != 0 {
+		return nil, errors.Wrap(errCorruptStateDiff, "unexpected trailing bytes")
+	}
+	return st, nil
+}
+
+// clearStateDiffFields empties the fields of st which are encoded separately in a state diff.
+// Vectors are zeroed out rather than emptied so the state can still be SSZ encoded.
+func clearStateDiffFields(st state.BeaconState) error {
+	if err := st.SetValidators([]*ethpb.Validator{}); err != nil {
+		return err
+	}
+	if err := st.SetBalances([]uint64{}); err != nil {
+		return err
+	}
+	if st.Version() >= version.Altair {
+		if err := st.SetInactivityScores([]uint64{}); err != nil {
+			return err
+		}
+		if err := st.SetPreviousParticipationBits([]byte{}); err != nil {
+			return err
+		}
+		if err := st.SetCurrentParticipationBits([]byte{}); err != nil {
+			return err
+		}
+	}
+	if err := st.SetRandaoMixes(zeroRoots(st.RandaoMixesLength())); err != nil {
+		return err
+	}
+	if err := st.SetBlockRoots(zeroRoots(len(st.BlockRoots()))); err != nil {
+		return err
+	}
+	return st.SetStateRoots(zeroRoots(len(st.StateRoots())))
+}
+
+func zeroRoots(n int) [][]byte {
+	roots := make([][]byte, n)
+	for i := range roots {
+		roots[i] = make([]byte, 32)
+	}
+	return roots
+}
+
+// appendValidatorsDiff appends the new validator count followed by the index and SSZ encoding
+// of every validator that differs from the base.
+func appendValidatorsDiff(buf []byte, base, vals []*ethpb.Validator) ([]byte, error) {
+	var changed []int
+	for i, v := range vals {
+		if i >= len(base) || !validatorsEqual(base[i], v) {
+			changed = append(changed, i)
+		}
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(vals)))
+	buf = binary.AppendUvarint(buf, uint64(len(changed)))
+	for _, i := range changed {
+		enc, err := vals[i].MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		buf = binary.AppendUvarint(buf, uint64(i))
+		buf = appendBytes(buf, enc)
+	}
+	return buf, nil
+}
+
+func validatorsEqual(a, b *ethpb.Validator) bool {
+	return a.Slashed == b.Slashed &&
+		a.EffectiveBalance == b.EffectiveBalance &&
+		a.ActivationEligibilityEpoch == b.ActivationEligibilityEpoch &&
+		a.ActivationEpoch == b.ActivationEpoch &&
+		a.ExitEpoch == b.ExitEpoch &&
+		a.WithdrawableEpoch == b.WithdrawableEpoch &&
+		bytes.Equal(a.PublicKey, b.PublicKey) &&
+		bytes.Equal(a.WithdrawalCredentials, b.WithdrawalCredentials)
+}
+
+// appendUint64sDiff appends the new length followed by the zigzag encoded delta of every
+// value from its base value, which keeps slowly changing balances down to a byte or two each.
+func appendUint64sDiff(buf []byte, base, vals []uint64) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(vals)))
+	for i, v := range vals {
+		var b uint64
+		if i < len(base) {
+			b = base[i]
+		}
+		buf = binary.AppendVarint(buf, int64(v-b))
+	}
+	return buf
+}
+
+// appendRootsDiff appends the vector length followed by the index and value of every
+// root that differs from the base.
+func appendRootsDiff(buf []byte, base, roots [][]byte) []byte {
+	var changed []int
+	for i, r := range roots {
+		if i >= len(base) {
+			if !bytes.Equal(r, params.BeaconConfig().ZeroHash[:]) {
+				changed = append(changed, i)
+			}
+			continue
+		}
+		if !bytes.Equal(base[i], r) {
+			changed = append(changed, i)
+		}
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(roots)))
+	buf = binary.AppendUvarint(buf, uint64(len(changed)))
+	for _, i := range changed {
+		buf = binary.AppendUvarint(buf, uint64(i))
+		buf = append(buf, roots[i]...)
+	}
+	return buf
+}
+
+func appendBytes(buf, b []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+// stateDiffReader consumes the fields of a decoded state diff in order.
+type stateDiffReader struct {
+	buf []byte
+}
+
+func (r *stateDiffReader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		return 0, errors.Wrap(errCorruptStateDiff, "invalid uvarint")
+	}
+	r.buf = r.buf[n:]
+	return v, nil
+}
+
+func (r *stateDiffReader) varint() (int64, error) {
+	v, n := binary.Varint(r.buf)
+	if n <= 0 {
+		return 0, errors.Wrap(errCorruptStateDiff, "invalid varint")
+	}
+	r.buf = r.buf[n:]
+	return v, nil
+}
+
+func (r *stateDiffReader) next(n uint64) ([]byte, error) {
+	if n > uint64(len(r.buf)) {
+		return nil, errors.Wrap(errCorruptStateDiff, "unexpected end of diff")
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b, nil
+}
+
+func (r *stateDiffReader) lengthPrefixed() ([]byte, error) {
+	n, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	return r.next(n)
+}
+
+// count reads a length and checks it against the remaining bytes, given that
+// every element takes at least minSize bytes, to guard against huge allocations.
+func (r *stateDiffReader) count(minSize uint64) (uint64, error) {
+	n, err := r.uvarint()
+	if err != nil {
+		return 0, err
+	}
+	if minSize > 0 && n > uint64(len(r.buf))/minSize {
+		return 0, errors.Wrap(errCorruptStateDiff, "length exceeds diff size")
+	}
+	return n, nil
+}
+
+func (r *stateDiffReader) validatorsDiff(base []*ethpb.Validator) ([]*ethpb.Validator, error) {
+	total, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	changed, err := r.count(2)
+	if err != nil {
+		return nil, err
+	}
+	if total > uint64(len(base))+changed {
+		return nil, errors.Wrap(errCorruptStateDiff, "validator count exceeds diff size")
+	}
+	vals := make([]*ethpb.Validator, total)
+	copy(vals, base)
+	for j := uint64(0); j < changed; j++ {
+		i, err := r.uvarint()
+		if err != nil {
+			return nil, err
+		}
+		if i >= total {
+			return nil, errors.Wrap(errCorruptStateDiff, "validator index out of range")
+		}
+		enc, err := r.lengthPrefixed()
+		if err != nil {
+			return nil, err
+		}
+		v := &ethpb.Validator{}
+		if err := v.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		vals[i] = v
+	}
+	for _, v := range vals {
+		if v == nil {
+			return nil, errors.Wrap(errCorruptStateDiff, "missing validator")
+		}
+	}
+	return vals, nil
+}
+
+func (r *stateDiffReader) uint64sDiff(base []uint64) ([]uint64, error) {
+	total, err := r.count(1)
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]uint64, total)
+	for i := range vals {
+		d, err := r.varint()
+		if err != nil {
+			return nil, err
+		}
+		var b uint64
+		if i < len(base) {
+			b = base[i]
+		}
+		vals[i] = b + uint64(d)
+	}
+	return vals, nil
+}
+
+func (r *stateDiffReader) rootsDiff(base [][]byte) ([][]byte, error) {
+	total, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	changed, err := r.count(33)
+	if err != nil {
+		return nil, err
+	}
+	maxLen := uint64(params.BeaconConfig().EpochsPerHistoricalVector)
+	if l := uint64(params.BeaconConfig().SlotsPerHistoricalRoot); l > maxLen {
+		maxLen = l
+	}
+	if total > maxLen {
+		return nil, errors.Wrap(errCorruptStateDiff, "vector length out of range")
+	}
+	roots := make([][]byte, total)
+	for i := range roots {
+		if i < len(base) {
+			roots[i] = base[i]
+		} else {
+			roots[i] = make([]byte, 32)
+		}
+	}
+	for j := uint64(0); j < changed; j++ {
+		i, err := r.uvarint()
+		if err != nil {
+			return nil, err
+		}
+		if i >= total {
+			return nil, errors.Wrap(errCorruptStateDiff, "root index out of range")
+		}
+		root, err := r.next(32)
+		if err != nil {
+			return nil, err
+		}
+		roots[i] = bytesutil.SafeCopyBytes(root)
+	}
+	return roots, nil
+}
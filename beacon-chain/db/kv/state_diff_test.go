@@ -0,0 +1,232 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/features"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func setupStateDiffExponents(t *testing.T, exponents []uint64) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.StateDiffExponents = exponents
+	params.OverrideBeaconConfig(cfg)
+}
+
+// advanceStateForDiff mutates every field of the state which is encoded separately in a state diff.
+func advanceStateForDiff(t *testing.T, st state.BeaconState, slot types.Slot) {
+	require.NoError(t, st.SetSlot(slot))
+	bals := st.Balances()
+	for i := range bals {
+		bals[i] += uint64(i) * 1000
+	}
+	bals[0] -= 5000
+	require.NoError(t, st.SetBalances(bals))
+	vals := st.Validators()
+	vals[1].ExitEpoch = types.Epoch(slot)
+	vals = append(vals, &ethpb.Validator{
+		PublicKey:             bytesutil.PadTo([]byte{byte(slot)}, 48),
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+	})
+	require.NoError(t, st.SetValidators(vals))
+	require.NoError(t, st.AppendBalance(params.BeaconConfig().MaxEffectiveBalance))
+	require.NoError(t, st.AppendInactivityScore(uint64(slot)))
+	require.NoError(t, st.AppendPreviousParticipationBits(byte(slot)))
+	require.NoError(t, st.AppendCurrentParticipationBits(byte(slot)))
+	require.NoError(t, st.UpdateRandaoMixesAtIndex(uint64(slot)%uint64(params.BeaconConfig().EpochsPerHistoricalVector), bytesutil.PadTo([]byte{byte(slot), 1}, 32)))
+	require.NoError(t, st.UpdateBlockRootAtIndex(uint64(slot)%uint64(params.BeaconConfig().SlotsPerHistoricalRoot), bytesutil.ToBytes32([]byte{byte(slot), 2})))
+	require.NoError(t, st.UpdateStateRootAtIndex(uint64(slot)%uint64(params.BeaconConfig().SlotsPerHistoricalRoot), bytesutil.ToBytes32([]byte{byte(slot), 3})))
+}
+
+func stateDiffHeader(t *testing.T, db *Store, slot types.Slot) (byte, types.Slot) {
+	var kind byte
+	var baseSlot types.Slot
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(stateDiffBucket).Get(bytesutil.SlotToBytesBigEndian(slot))
+		require.Equal(t, true, len(enc) >= stateDiffHeaderSize)
+		kind = enc[0]
+		baseSlot = bytesutil.BytesToSlotBigEndian(enc[1:stateDiffHeaderSize])
+		return nil
+	}))
+	return kind, baseSlot
+}
+
+func TestStore_SaveStateDiff_CanSaveRetrieve(t *testing.T) {
+	setupStateDiffExponents(t, []uint64{5, 6, 7})
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	want := make(map[[32]byte]state.BeaconState)
+	for slot := types.Slot(0); slot <= 256; slot += 32 {
+		advanceStateForDiff(t, st, slot)
+		root := bytesutil.ToBytes32(bytesutil.SlotToBytesBigEndian(slot + 1))
+		require.NoError(t, db.SaveStateDiff(ctx, st, root))
+		want[root] = st.Copy()
+	}
+
+	for root, wantState := range want {
+		assert.Equal(t, true, db.HasState(ctx, root))
+		got, err := db.State(ctx, root)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, wantState.ToProtoUnsafe(), got.ToProtoUnsafe())
+	}
+
+	tests := []struct {
+		slot     types.Slot
+		kind     byte
+		baseSlot types.Slot
+	}{
+		{slot: 0, kind: stateDiffSnapshot},
+		{slot: 32, kind: stateDiffLayer, baseSlot: 0},
+		{slot: 64, kind: stateDiffLayer, baseSlot: 0},
+		{slot: 96, kind: stateDiffLayer, baseSlot: 64},
+		{slot: 128, kind: stateDiffSnapshot},
+		{slot: 224, kind: stateDiffLayer, baseSlot: 192},
+	}
+	for _, tt := range tests {
+		kind, baseSlot := stateDiffHeader(t, db, tt.slot)
+		assert.Equal(t, tt.kind, kind, "slot %d", tt.slot)
+		assert.Equal(t, tt.baseSlot, baseSlot, "slot %d", tt.slot)
+	}
+	slots, err := db.StateDiffSlots(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 9, len(slots))
+}
+
+func TestStore_SaveStateDiff_FallsBackToClosestSnapshot(t *testing.T) {
+	setupStateDiffExponents(t, []uint64{5, 6, 7})
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	// Archiving starts at slot 160, whose base slot 128 is not available.
+	for _, slot := range []types.Slot{160, 192, 224} {
+		advanceStateForDiff(t, st, slot)
+		require.NoError(t, db.SaveStateDiff(ctx, st, bytesutil.ToBytes32([]byte{byte(slot)})))
+	}
+
+	kind, _ := stateDiffHeader(t, db, 160)
+	assert.Equal(t, stateDiffSnapshot, kind)
+	kind, baseSlot := stateDiffHeader(t, db, 192)
+	assert.Equal(t, stateDiffLayer, kind)
+	assert.Equal(t, types.Slot(160), baseSlot)
+	kind, baseSlot = stateDiffHeader(t, db, 224)
+	assert.Equal(t, stateDiffLayer, kind)
+	assert.Equal(t, types.Slot(192), baseSlot)
+
+	got, err := db.State(ctx, bytesutil.ToBytes32([]byte{224}))
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, st.ToProtoUnsafe(), got.ToProtoUnsafe())
+}
+
+func TestStore_CleanUpDirtyStates_KeepsStateDiffBases(t *testing.T) {
+	setupStateDiffExponents(t, []uint64{5, 6})
+	ctx := context.Background()
+	db := setupDB(t)
+
+	genesisState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	genesisRoot := [32]byte{'a'}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, genesisState, genesisRoot))
+	roots := make(map[types.Slot][32]byte)
+	for _, slot := range []types.Slot{10, 20, 40} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, wsb))
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, db.SaveState(ctx, st, r))
+		roots[slot] = r
+	}
+	// Slot 32 is skipped, so the state of the block at slot 20 is advanced to it and archived by slot only.
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(32))
+	require.NoError(t, db.SaveStateDiff(ctx, st, params.BeaconConfig().ZeroHash))
+	assert.Equal(t, false, db.HasState(ctx, params.BeaconConfig().ZeroHash))
+
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: genesisRoot[:]}))
+	require.NoError(t, db.CleanUpDirtyStates(ctx, params.BeaconConfig().SlotsPerArchivedPoint))
+	assert.Equal(t, false, db.HasState(ctx, roots[10]))
+	assert.Equal(t, false, db.HasState(ctx, roots[40]))
+	got, err := db.State(ctx, roots[20])
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, types.Slot(20), got.Slot())
+}
+
+func TestStore_SaveStateDiff_Phase0WithValidatorEntries(t *testing.T) {
+	setupStateDiffExponents(t, []uint64{5, 6})
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableHistoricalSpaceRepresentation: true,
+	})
+	defer resetCfg()
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, _ := util.DeterministicGenesisState(t, 16)
+	require.NoError(t, db.SaveStateDiff(ctx, st, [32]byte{'a'}))
+	require.NoError(t, st.SetSlot(32))
+	require.NoError(t, st.UpdateBalancesAtIndex(3, 1))
+	require.NoError(t, db.SaveStateDiff(ctx, st, [32]byte{'b'}))
+	// Saving the same slot again only indexes the new root.
+	require.NoError(t, db.SaveStateDiff(ctx, st, [32]byte{'c'}))
+
+	for _, root := range [][32]byte{{'b'}, {'c'}} {
+		got, err := db.State(ctx, root)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, st.ToProtoUnsafe(), got.ToProtoUnsafe())
+	}
+}
+
+func TestStore_SaveStateDiff_InvalidSlot(t *testing.T) {
+	setupStateDiffExponents(t, []uint64{5, 6})
+	db := setupDB(t)
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(33))
+	require.ErrorIs(t, db.SaveStateDiff(context.Background(), st, [32]byte{}), errInvalidStateDiffSlot)
+}
+
+func TestStateDiffBaseSlot(t *testing.T) {
+	exponents := []uint64{5, 7, 10}
+	tests := []struct {
+		slot     types.Slot
+		baseSlot types.Slot
+		snapshot bool
+	}{
+		{slot: 0, snapshot: true},
+		{slot: 32, baseSlot: 0},
+		{slot: 96, baseSlot: 0},
+		{slot: 160, baseSlot: 128},
+		{slot: 1152, baseSlot: 1024},
+		{slot: 1280, baseSlot: 1024},
+		{slot: 2048, snapshot: true},
+	}
+	for _, tt := range tests {
+		baseSlot, snapshot, err := stateDiffBaseSlot(tt.slot, exponents)
+		require.NoError(t, err)
+		assert.Equal(t, tt.snapshot, snapshot, "slot %d", tt.slot)
+		assert.Equal(t, tt.baseSlot, baseSlot, "slot %d", tt.slot)
+	}
+}
@@ -44,4 +44,7 @@ func TestSaveOrigin(t *testing.T) {
 	broot, err := scb.Block().HashTreeRoot()
 	require.NoError(t, err)
 	require.Equal(t, true, db.IsFinalizedBlock(ctx, broot))
+	bfRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, broot, bfRoot)
 }
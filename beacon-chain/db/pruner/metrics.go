@@ -0,0 +1,30 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	prunedSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pruner_pruned_slot",
+		Help: "The slot below which the database history has been pruned.",
+	})
+	pruneTargetSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pruner_target_slot",
+		Help: "The slot below which the database history is to be pruned, given the retention window.",
+	})
+	prunedSlotsCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pruner_pruned_slots_total",
+		Help: "The number of slots whose history has been pruned from the database.",
+	})
+	pruneBatchDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "pruner_batch_duration_milliseconds",
+		Help:    "The time it takes to prune a batch of slots from the database.",
+		Buckets: []float64{10, 50, 100, 250, 500, 1000, 2500, 5000, 10000},
+	})
+	pruneFailuresCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pruner_failures_total",
+		Help: "The number of failed pruning runs.",
+	})
+)
@@ -0,0 +1,42 @@
+package pruner
+
+import (
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+)
+
+type Option func(s *Service) error
+
+// WithDatabase sets the database to prune.
+func WithDatabase(beaconDB db.NoHeadAccessDatabase) Option {
+	return func(s *Service) error {
+		s.cfg.beaconDB = beaconDB
+		return nil
+	}
+}
+
+// WithStateNotifier sets the notifier of the finalized checkpoints which trigger pruning.
+func WithStateNotifier(n statefeed.Notifier) Option {
+	return func(s *Service) error {
+		s.cfg.stateNotifier = n
+		return nil
+	}
+}
+
+// WithHeadFetcher sets the head fetcher used to compute the weak subjectivity period.
+func WithHeadFetcher(h blockchain.HeadFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.headFetcher = h
+		return nil
+	}
+}
+
+// WithRetentionEpochs sets the number of finalized epochs of history to keep.
+func WithRetentionEpochs(epochs types.Epoch) Option {
+	return func(s *Service) error {
+		s.cfg.retentionEpochs = epochs
+		return nil
+	}
+}
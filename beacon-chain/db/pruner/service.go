@@ -0,0 +1,257 @@
+// Package pruner defines a runtime service which deletes the history of the beacon
+// chain database that is older than a configured retention window, each time the
+// chain finalizes. The window never goes below the weak subjectivity period.
+package pruner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v3/config/features"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// batchSlots is the number of slots pruned per database transaction.
+const batchSlots = 1024
+
+// Status is the progress of the pruner.
+type Status struct {
+	// PrunedSlot is the slot below which the history has been deleted.
+	PrunedSlot types.Slot
+	// TargetSlot is the slot below which the history is to be deleted.
+	TargetSlot types.Slot
+}
+
+// StatusFetcher retrieves the progress of the pruner.
+type StatusFetcher interface {
+	PruneStatus() Status
+}
+
+type config struct {
+	beaconDB        db.NoHeadAccessDatabase
+	stateNotifier   statefeed.Notifier
+	headFetcher     blockchain.HeadFetcher
+	retentionEpochs types.Epoch
+}
+
+// Service prunes the database history after finalization.
+type Service struct {
+	cfg    *config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock           sync.RWMutex
+	status         Status
+	finalizedEpoch types.Epoch
+	trigger        chan struct{}
+}
+
+// New creates a pruner service.
+func New(ctx context.Context, opts ...Option) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:     &config{},
+		ctx:     ctx,
+		cancel:  cancel,
+		trigger: make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.cfg.beaconDB == nil || s.cfg.stateNotifier == nil || s.cfg.headFetcher == nil {
+		cancel()
+		return nil, errors.New("pruner requires a database, a state notifier and a head fetcher")
+	}
+	return s, nil
+}
+
+// Start the pruner service.
+func (s *Service) Start() {
+	prunedSlot, err := s.cfg.beaconDB.PrunedSlot(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get pruned slot")
+	}
+	s.lock.Lock()
+	s.status.PrunedSlot = prunedSlot
+	s.lock.Unlock()
+	prunedSlotGauge.Set(float64(prunedSlot))
+
+	go s.pruneLoop()
+	go s.run()
+}
+
+// Stop the pruner service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service.
+func (*Service) Status() error {
+	return nil
+}
+
+// PruneStatus returns the progress of the pruner.
+func (s *Service) PruneStatus() Status {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.status
+}
+
+// run listens to finalized checkpoints. Pruning happens in its own routine so
+// that a long running pruning never blocks the state feed.
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.FinalizedCheckpoint {
+				continue
+			}
+			data, ok := ev.Data.(*ethpbv1.EventFinalizedCheckpoint)
+			if !ok {
+				continue
+			}
+			s.lock.Lock()
+			s.finalizedEpoch = data.Epoch
+			s.lock.Unlock()
+			select {
+			case s.trigger <- struct{}{}:
+			default:
+			}
+		case <-s.ctx.Done():
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		}
+	}
+}
+
+func (s *Service) pruneLoop() {
+	for {
+		select {
+		case <-s.trigger:
+			s.lock.RLock()
+			finalized := s.finalizedEpoch
+			s.lock.RUnlock()
+			if err := s.prune(s.ctx, finalized); err != nil {
+				pruneFailuresCount.Inc()
+				log.WithError(err).Error("Could not prune database history")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// prune deletes the history older than the retention window before the finalized epoch,
+// in batches so the database is never locked for long.
+func (s *Service) prune(ctx context.Context, finalized types.Epoch) error {
+	retention, err := s.retentionEpochs(ctx)
+	if err != nil {
+		return err
+	}
+	if finalized <= retention {
+		return nil
+	}
+	windowStart, err := slots.EpochStart(finalized - retention)
+	if err != nil {
+		return err
+	}
+	target, err := s.archivedCutoff(ctx, windowStart)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	s.status.TargetSlot = target
+	s.lock.Unlock()
+	pruneTargetSlotGauge.Set(float64(target))
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		s.lock.RLock()
+		from := s.status.PrunedSlot
+		s.lock.RUnlock()
+		start := time.Now()
+		prunedSlot, err := s.cfg.beaconDB.PruneHistory(ctx, target, batchSlots)
+		if err != nil {
+			return err
+		}
+		pruneBatchDuration.Observe(float64(time.Since(start).Milliseconds()))
+		s.lock.Lock()
+		s.status.PrunedSlot = prunedSlot
+		s.lock.Unlock()
+		prunedSlotGauge.Set(float64(prunedSlot))
+		if prunedSlot > from {
+			prunedSlotsCount.Add(float64(prunedSlot - from))
+		}
+		if prunedSlot >= target {
+			log.WithFields(logrus.Fields{
+				"prunedSlot":      prunedSlot,
+				"retentionEpochs": retention,
+			}).Debug("Pruned database history")
+			return nil
+		}
+	}
+}
+
+// archivedCutoff rounds the slot down to the closest slot with an archived state, and then to the slot
+// of the block the archived state was built from, so the history kept above the cutoff can still be
+// replayed from that state.
+func (s *Service) archivedCutoff(ctx context.Context, slot types.Slot) (types.Slot, error) {
+	interval := params.BeaconConfig().SlotsPerArchivedPoint
+	if features.Get().EnableHistoricalStateDiffs {
+		interval = types.Slot(uint64(1) << params.BeaconConfig().StateDiffExponents[0])
+	}
+	archived := slot - slot%interval
+	if archived == 0 {
+		return 0, nil
+	}
+	blockSlot, roots, err := s.cfg.beaconDB.HighestRootsBelowSlot(ctx, archived+1)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not get the highest block at or below slot %d", archived)
+	}
+	if len(roots) == 0 {
+		return 0, nil
+	}
+	return blockSlot, nil
+}
+
+// retentionEpochs returns the configured retention window, raised to the weak subjectivity
+// period of the head state if it is shorter.
+func (s *Service) retentionEpochs(ctx context.Context) (types.Epoch, error) {
+	st, err := s.cfg.headFetcher.HeadState(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get head state")
+	}
+	if st == nil || st.IsNil() {
+		return 0, errors.New("nil head state")
+	}
+	wsPeriod, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, params.BeaconConfig())
+	if err != nil {
+		return 0, errors.Wrap(err, "could not compute weak subjectivity period")
+	}
+	if s.cfg.retentionEpochs < wsPeriod {
+		return wsPeriod, nil
+	}
+	return s.cfg.retentionEpochs, nil
+}
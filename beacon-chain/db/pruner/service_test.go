@@ -0,0 +1,179 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func setupSlotsPerArchivedPoint(t *testing.T, slotsPerArchivedPoint types.Slot) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SlotsPerArchivedPoint = slotsPerArchivedPoint
+	params.OverrideBeaconConfig(cfg)
+}
+
+// saveChain saves a chain of blocks up to slot n, excluding n and the skipped slots. It returns
+// the block roots by slot, with zero roots for the skipped slots.
+func saveChain(t *testing.T, beaconDB db.Database, n types.Slot, skipped ...types.Slot) [][32]byte {
+	ctx := context.Background()
+	genesis := util.NewBeaconBlock()
+	parent, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, parent))
+	roots := [][32]byte{parent}
+	skip := make(map[types.Slot]bool, len(skipped))
+	for _, slot := range skipped {
+		skip[slot] = true
+	}
+	for slot := types.Slot(1); slot < n; slot++ {
+		if skip[slot] {
+			roots = append(roots, [32]byte{})
+			continue
+		}
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		parent, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		util.SaveBlock(t, ctx, beaconDB, b)
+		roots = append(roots, parent)
+	}
+	return roots
+}
+
+func TestService_Prune(t *testing.T) {
+	setupSlotsPerArchivedPoint(t, 64)
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	roots := saveChain(t, beaconDB, 100)
+	st, _ := util.DeterministicGenesisState(t, 64)
+	wsPeriod, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, params.BeaconConfig())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		retention types.Epoch
+		finalized types.Epoch
+		target    types.Slot
+	}{
+		{
+			name:      "within retention window",
+			retention: wsPeriod + 10,
+			finalized: wsPeriod + 10,
+		},
+		{
+			name:      "cutoff below the first archived point",
+			retention: 1,
+			finalized: wsPeriod + 1,
+		},
+		{
+			name:      "retention below weak subjectivity period",
+			retention: 1,
+			finalized: wsPeriod + 2,
+			target:    2 * params.BeaconConfig().SlotsPerEpoch,
+		},
+		{
+			name:      "cutoff rounded down to an archived point",
+			retention: wsPeriod + 10,
+			finalized: wsPeriod + 13,
+			target:    2 * params.BeaconConfig().SlotsPerEpoch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(ctx,
+				WithDatabase(beaconDB),
+				WithStateNotifier(&mock.MockStateNotifier{}),
+				WithHeadFetcher(&mock.ChainService{State: st}),
+				WithRetentionEpochs(tt.retention),
+			)
+			require.NoError(t, err)
+			require.NoError(t, s.prune(ctx, tt.finalized))
+			status := s.PruneStatus()
+			assert.Equal(t, tt.target, status.TargetSlot)
+			assert.Equal(t, tt.target, status.PrunedSlot)
+		})
+	}
+	for slot, root := range roots {
+		assert.Equal(t, slot == 0 || types.Slot(slot) >= 2*params.BeaconConfig().SlotsPerEpoch, beaconDB.HasBlock(ctx, root), "slot %d", slot)
+	}
+}
+
+func TestService_Prune_SkippedArchivedPoint(t *testing.T) {
+	setupSlotsPerArchivedPoint(t, 64)
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	roots := saveChain(t, beaconDB, 100, 62, 64)
+	st, _ := util.DeterministicGenesisState(t, 64)
+	wsPeriod, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, params.BeaconConfig())
+	require.NoError(t, err)
+	s, err := New(ctx,
+		WithDatabase(beaconDB),
+		WithStateNotifier(&mock.MockStateNotifier{}),
+		WithHeadFetcher(&mock.ChainService{State: st}),
+		WithRetentionEpochs(1),
+	)
+	require.NoError(t, err)
+
+	// The state archived at slot 64 is built from the block at slot 63, which is kept.
+	require.NoError(t, s.prune(ctx, wsPeriod+2))
+	assert.Equal(t, types.Slot(63), s.PruneStatus().PrunedSlot)
+	assert.Equal(t, false, beaconDB.HasBlock(ctx, roots[61]))
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, roots[63]))
+}
+
+func TestService_PrunesOnFinalizedCheckpoint(t *testing.T) {
+	setupSlotsPerArchivedPoint(t, 32)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	beaconDB := dbtest.SetupDB(t)
+	saveChain(t, beaconDB, 40)
+	st, _ := util.DeterministicGenesisState(t, 64)
+	wsPeriod, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, params.BeaconConfig())
+	require.NoError(t, err)
+	notifier := &mock.MockStateNotifier{}
+	s, err := New(ctx,
+		WithDatabase(beaconDB),
+		WithStateNotifier(notifier),
+		WithHeadFetcher(&mock.ChainService{State: st}),
+		WithRetentionEpochs(1),
+	)
+	require.NoError(t, err)
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	ev := &feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &ethpbv1.EventFinalizedCheckpoint{Epoch: wsPeriod + 1},
+	}
+	// Wait for the service to subscribe.
+	for notifier.StateFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	want := params.BeaconConfig().SlotsPerEpoch
+	for i := 0; i < 100 && s.PruneStatus().PrunedSlot != want; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, want, s.PruneStatus().PrunedSlot)
+	prunedSlot, err := beaconDB.PrunedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, prunedSlot)
+}
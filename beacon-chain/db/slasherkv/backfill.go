@@ -0,0 +1,36 @@
+package slasherkv
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// BackfillCheckpoint returns the slot up to which the historical blocks were fed through
+// slashing detection by the backfill, or 0 if it never ran.
+func (s *Store) BackfillCheckpoint(ctx context.Context) (types.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BackfillCheckpoint")
+	defer span.End()
+	var slot types.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slasherMetadataBucket).Get(backfillCheckpointKey)
+		if enc != nil {
+			slot = bytesutil.BytesToSlotBigEndian(enc)
+		}
+		return nil
+	})
+	return slot, err
+}
+
+// SaveBackfillCheckpoint saves the slot up to which the historical blocks were fed through
+// slashing detection by the backfill, so an interrupted backfill can resume from there.
+func (s *Store) SaveBackfillCheckpoint(ctx context.Context, slot types.Slot) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillCheckpoint")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(slasherMetadataBucket).Put(backfillCheckpointKey, bytesutil.SlotToBytesBigEndian(slot))
+	})
+}
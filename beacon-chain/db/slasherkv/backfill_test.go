@@ -0,0 +1,24 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_BackfillCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	slot, err := beaconDB.BackfillCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), slot)
+
+	require.NoError(t, beaconDB.SaveBackfillCheckpoint(ctx, 1024))
+	slot, err = beaconDB.BackfillCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1024), slot)
+}
@@ -77,6 +77,7 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 			attestationDataRootsBucket,
 			proposalRecordsBucket,
 			slasherChunksBucket,
+			slasherMetadataBucket,
 		)
 	}); err != nil {
 		return nil, err
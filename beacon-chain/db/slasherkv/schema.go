@@ -13,4 +13,11 @@ var (
 	attestationDataRootsBucket = []byte("attestation-data-roots")
 	proposalRecordsBucket      = []byte("proposal-records")
 	slasherChunksBucket        = []byte("slasher-chunks")
+	slasherMetadataBucket      = []byte("slasher-metadata")
+	// Staging bucket of the spans re-chunked to new parameters, replacing slasherChunksBucket once complete.
+	slasherChunksMigrationBucket = []byte("slasher-chunks-migration")
+
+	// Specific item keys.
+	backfillCheckpointKey = []byte("backfill-checkpoint")
+	spanParametersKey     = []byte("span-parameters")
 )
@@ -0,0 +1,156 @@
+package slasherkv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// legacySpanParameters are the parameters spans were always chunked with before
+// the parameters started being recorded in the database.
+var legacySpanParameters = &slashertypes.SpanParameters{
+	ChunkSize:          16,
+	ValidatorChunkSize: 256,
+	HistoryLength:      4096,
+}
+
+// SpanParameters returns the parameters the min and max spans in the database are chunked
+// with, or nil if none were recorded and no span was saved yet.
+func (s *Store) SpanParameters(ctx context.Context) (*slashertypes.SpanParameters, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SpanParameters")
+	defer span.End()
+	var p *slashertypes.SpanParameters
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slasherMetadataBucket).Get(spanParametersKey)
+		if enc == nil {
+			if k, _ := tx.Bucket(slasherChunksBucket).Cursor().First(); k != nil {
+				p = legacySpanParameters
+			}
+			return nil
+		}
+		var err error
+		p, err = decodeSpanParameters(enc)
+		return err
+	})
+	return p, err
+}
+
+// SaveSpanParameters records the parameters the min and max spans in the database are chunked with.
+func (s *Store) SaveSpanParameters(ctx context.Context, p *slashertypes.SpanParameters) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveSpanParameters")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(slasherMetadataBucket).Put(spanParametersKey, encodeSpanParameters(p))
+	})
+}
+
+// SlasherChunkKeys returns the disk keys of all the chunks of a kind stored in the database.
+func (s *Store) SlasherChunkKeys(ctx context.Context, kind slashertypes.ChunkKind) ([][]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SlasherChunkKeys")
+	defer span.End()
+	prefix := ssz.MarshalUint8(make([]byte, 0), uint8(kind))
+	keys := make([][]byte, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(slasherChunksBucket).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && k[0] == prefix[0]; k, _ = c.Next() {
+			keys = append(keys, bytesutil.SafeCopyBytes(k[1:]))
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// SaveMigratedSlasherChunks saves chunks re-chunked to new parameters to a staging area,
+// leaving the chunks used for slashing detection untouched until the migration is committed.
+func (s *Store) SaveMigratedSlasherChunks(
+	ctx context.Context, kind slashertypes.ChunkKind, chunkKeys [][]byte, chunks [][]uint16,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveMigratedSlasherChunks")
+	defer span.End()
+	if len(chunkKeys) != len(chunks) {
+		return errors.Errorf("got %d chunk keys for %d chunks", len(chunkKeys), len(chunks))
+	}
+	encodedChunks := make([][]byte, len(chunks))
+	for i, chunk := range chunks {
+		enc, err := encodeSlasherChunk(chunk)
+		if err != nil {
+			return err
+		}
+		encodedChunks[i] = enc
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt, err := tx.CreateBucketIfNotExists(slasherChunksMigrationBucket)
+		if err != nil {
+			return err
+		}
+		for i, key := range chunkKeys {
+			encodedKey := append(ssz.MarshalUint8(make([]byte, 0), uint8(kind)), key...)
+			if err := bkt.Put(encodedKey, encodedChunks[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DiscardMigratedSlasherChunks deletes the chunks left in the staging area by an interrupted migration.
+func (s *Store) DiscardMigratedSlasherChunks(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DiscardMigratedSlasherChunks")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(slasherChunksMigrationBucket) == nil {
+			return nil
+		}
+		return tx.DeleteBucket(slasherChunksMigrationBucket)
+	})
+}
+
+// CommitMigratedSlasherChunks replaces all the chunks used for slashing detection with the
+// ones in the staging area, and records the parameters they are chunked with, atomically.
+func (s *Store) CommitMigratedSlasherChunks(ctx context.Context, p *slashertypes.SpanParameters) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.CommitMigratedSlasherChunks")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(slasherChunksBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(slasherChunksBucket)
+		if err != nil {
+			return err
+		}
+		if staging := tx.Bucket(slasherChunksMigrationBucket); staging != nil {
+			if err := staging.ForEach(func(k, v []byte) error {
+				return bkt.Put(bytesutil.SafeCopyBytes(k), bytesutil.SafeCopyBytes(v))
+			}); err != nil {
+				return err
+			}
+			if err := tx.DeleteBucket(slasherChunksMigrationBucket); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(slasherMetadataBucket).Put(spanParametersKey, encodeSpanParameters(p))
+	})
+}
+
+func encodeSpanParameters(p *slashertypes.SpanParameters) []byte {
+	enc := ssz.MarshalUint64(make([]byte, 0), p.ChunkSize)
+	enc = ssz.MarshalUint64(enc, p.ValidatorChunkSize)
+	return ssz.MarshalUint64(enc, uint64(p.HistoryLength))
+}
+
+func decodeSpanParameters(enc []byte) (*slashertypes.SpanParameters, error) {
+	if len(enc) != 24 {
+		return nil, errors.Errorf("cannot decode span parameters with length %d, expected 24", len(enc))
+	}
+	return &slashertypes.SpanParameters{
+		ChunkSize:          ssz.UnmarshallUint64(enc[:8]),
+		ValidatorChunkSize: ssz.UnmarshallUint64(enc[8:16]),
+		HistoryLength:      types.Epoch(ssz.UnmarshallUint64(enc[16:])),
+	}, nil
+}
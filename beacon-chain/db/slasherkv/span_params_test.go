@@ -0,0 +1,60 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_SpanParameters(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	p, err := beaconDB.SpanParameters(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*slashertypes.SpanParameters)(nil), p)
+
+	// Spans saved before the parameters were recorded were chunked with the legacy parameters.
+	require.NoError(t, beaconDB.SaveSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{{1}}, [][]uint16{{1, 2}}))
+	p, err = beaconDB.SpanParameters(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, legacySpanParameters, p)
+
+	want := &slashertypes.SpanParameters{ChunkSize: 4, ValidatorChunkSize: 512, HistoryLength: 8192}
+	require.NoError(t, beaconDB.SaveSpanParameters(ctx, want))
+	p, err = beaconDB.SpanParameters(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, p)
+}
+
+func TestStore_CommitMigratedSlasherChunks(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	require.NoError(t, beaconDB.SaveSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{{1}, {2}}, [][]uint16{{1}, {2}}))
+	require.NoError(t, beaconDB.SaveSlasherChunks(ctx, slashertypes.MaxSpan, [][]byte{{3}}, [][]uint16{{3}}))
+	keys, err := beaconDB.SlasherChunkKeys(ctx, slashertypes.MinSpan)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{{1}, {2}}, keys)
+
+	// Chunks of an interrupted migration are discarded.
+	require.NoError(t, beaconDB.SaveMigratedSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{{9}}, [][]uint16{{9}}))
+	require.NoError(t, beaconDB.DiscardMigratedSlasherChunks(ctx))
+	require.NoError(t, beaconDB.SaveMigratedSlasherChunks(ctx, slashertypes.MaxSpan, [][]byte{{4}}, [][]uint16{{4, 5}}))
+	p := &slashertypes.SpanParameters{ChunkSize: 4, ValidatorChunkSize: 512, HistoryLength: 8192}
+	require.NoError(t, beaconDB.CommitMigratedSlasherChunks(ctx, p))
+
+	keys, err = beaconDB.SlasherChunkKeys(ctx, slashertypes.MinSpan)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+	chunks, exists, err := beaconDB.LoadSlasherChunks(ctx, slashertypes.MaxSpan, [][]byte{{3}, {4}})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []bool{false, true}, exists)
+	assert.DeepEqual(t, []uint16{4, 5}, chunks[1])
+	stored, err := beaconDB.SpanParameters(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, p, stored)
+}
@@ -48,10 +48,33 @@ const (
 	ExecutionBlockByHashMethod = "eth_getBlockByHash"
 	// ExecutionBlockByNumberMethod request string for JSON-RPC.
 	ExecutionBlockByNumberMethod = "eth_getBlockByNumber"
+	// GetPayloadBodiesByHashV1 v1 request string for JSON-RPC.
+	GetPayloadBodiesByHashV1 = "engine_getPayloadBodiesByHashV1"
+	// GetPayloadBodiesByRangeV1 v1 request string for JSON-RPC.
+	GetPayloadBodiesByRangeV1 = "engine_getPayloadBodiesByRangeV1"
+	// ExchangeCapabilities request string for JSON-RPC.
+	ExchangeCapabilities = "engine_exchangeCapabilities"
 	// Defines the seconds before timing out engine endpoints with non-block execution semantics.
 	defaultEngineTimeout = time.Second
+	// Defines the maximum number of payload bodies requested from the execution engine in a single call,
+	// which every execution client is required to support.
+	payloadBodiesBatchSize = 32
 )
 
+// supportedEngineEndpoints is the list of engine API methods advertised to the execution client
+// when exchanging capabilities.
+var supportedEngineEndpoints = []string{
+	NewPayloadMethod,
+	NewPayloadMethodV2,
+	ForkchoiceUpdatedMethod,
+	ForkchoiceUpdatedMethodV2,
+	GetPayloadMethod,
+	GetPayloadMethodV2,
+	ExchangeTransitionConfigurationMethod,
+	GetPayloadBodiesByHashV1,
+	GetPayloadBodiesByRangeV1,
+}
+
 // ForkchoiceUpdatedResponse is the response kind received by the
 // engine_forkchoiceUpdatedV1 endpoint.
 type ForkchoiceUpdatedResponse struct {
@@ -78,7 +101,7 @@ type EngineCaller interface {
 	ForkchoiceUpdated(
 		ctx context.Context, state *pb.ForkchoiceState, attrs payloadattribute.Attributer,
 	) (*pb.PayloadIDBytes, []byte, error)
-	GetPayload(ctx context.Context, payloadId [8]byte, slot prysmType.Slot) (interfaces.ExecutionData, error)
+	GetPayload(ctx context.Context, payloadId [8]byte, slot prysmType.Slot) (interfaces.ExecutionData, *big.Int, error)
 	ExchangeTransitionConfiguration(
 		ctx context.Context, cfg *pb.TransitionConfiguration,
 	) error
@@ -197,8 +220,10 @@ func (s *Service) ForkchoiceUpdated(
 	}
 }
 
-// GetPayload calls the engine_getPayloadVX method via JSON-RPC.
-func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot prysmType.Slot) (interfaces.ExecutionData, error) {
+// GetPayload calls the engine_getPayloadVX method via JSON-RPC. Along with the payload it returns the
+// block value in wei reported by the execution client, which is nil for engine_getPayloadV1 as that
+// method does not report one.
+func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot prysmType.Slot) (interfaces.ExecutionData, *big.Int, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetPayload")
 	defer span.End()
 	start := time.Now()
@@ -211,20 +236,28 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot prysmT
 	defer cancel()
 
 	if slots.ToEpoch(slot) >= params.BeaconConfig().CapellaForkEpoch {
-		result := &pb.ExecutionPayloadCapella{}
+		result := &pb.ExecutionPayloadCapellaWithValue{}
 		err := s.rpcClient.CallContext(ctx, result, GetPayloadMethodV2, pb.PayloadIDBytes(payloadId))
 		if err != nil {
-			return nil, handleRPCError(err)
+			return nil, nil, handleRPCError(err)
 		}
-		return blocks.WrappedExecutionPayloadCapella(result)
+		payload, err := blocks.WrappedExecutionPayloadCapella(result.Payload)
+		if err != nil {
+			return nil, nil, err
+		}
+		return payload, result.Value, nil
 	}
 
 	result := &pb.ExecutionPayload{}
 	err := s.rpcClient.CallContext(ctx, result, GetPayloadMethod, pb.PayloadIDBytes(payloadId))
 	if err != nil {
-		return nil, handleRPCError(err)
+		return nil, nil, handleRPCError(err)
+	}
+	payload, err := blocks.WrappedExecutionPayload(result)
+	if err != nil {
+		return nil, nil, err
 	}
-	return blocks.WrappedExecutionPayload(result)
+	return payload, nil, nil
 }
 
 // ExchangeTransitionConfiguration calls the engine_exchangeTransitionConfigurationV1 method via JSON-RPC.
@@ -272,6 +305,97 @@ func (s *Service) ExchangeTransitionConfiguration(
 	return nil
 }
 
+// ExchangeCapabilities calls the engine_exchangeCapabilities method via JSON-RPC, advertising the engine API
+// methods supported by Prysm and caching the ones supported by the execution client.
+func (s *Service) ExchangeCapabilities(ctx context.Context) ([]string, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ExchangeCapabilities")
+	defer span.End()
+
+	d := time.Now().Add(defaultEngineTimeout)
+	ctx, cancel := context.WithDeadline(ctx, d)
+	defer cancel()
+	var result []string
+	if err := s.rpcClient.CallContext(ctx, &result, ExchangeCapabilities, supportedEngineEndpoints); err != nil {
+		err = handleRPCError(err)
+		// Execution clients predating capability negotiation don't support any of the optional methods,
+		// so there is no point in asking again.
+		if errors.Is(err, ErrMethodNotFound) {
+			s.setCapabilities([]string{})
+		}
+		return nil, err
+	}
+	s.setCapabilities(result)
+	return result, nil
+}
+
+// supportsCapability returns true if the execution client advertised support for the given engine API method.
+// Capabilities are exchanged with the execution client on first use.
+func (s *Service) supportsCapability(ctx context.Context, method string) bool {
+	s.capabilitiesLock.RLock()
+	capabilities := s.capabilities
+	s.capabilitiesLock.RUnlock()
+	if capabilities == nil {
+		if _, err := s.ExchangeCapabilities(ctx); err != nil {
+			log.WithError(err).Debug("Could not exchange capabilities with execution client")
+		}
+		s.capabilitiesLock.RLock()
+		capabilities = s.capabilities
+		s.capabilitiesLock.RUnlock()
+	}
+	_, ok := capabilities[method]
+	return ok
+}
+
+func (s *Service) setCapabilities(methods []string) {
+	capabilities := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		capabilities[m] = struct{}{}
+	}
+	s.capabilitiesLock.Lock()
+	defer s.capabilitiesLock.Unlock()
+	s.capabilities = capabilities
+}
+
+// resetCapabilities forgets the capabilities of the execution client, so that they are exchanged again
+// on next use.
+func (s *Service) resetCapabilities() {
+	s.capabilitiesLock.Lock()
+	defer s.capabilitiesLock.Unlock()
+	s.capabilities = nil
+}
+
+// GetPayloadBodiesByHash calls the engine_getPayloadBodiesByHashV1 method via JSON-RPC.
+// The body of a block unknown to the execution client is nil.
+func (s *Service) GetPayloadBodiesByHash(ctx context.Context, hashes []common.Hash) ([]*pb.ExecutionPayloadBodyV1, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetPayloadBodiesByHash")
+	defer span.End()
+
+	result := make([]*pb.ExecutionPayloadBodyV1, 0, len(hashes))
+	if err := s.rpcClient.CallContext(ctx, &result, GetPayloadBodiesByHashV1, hashes); err != nil {
+		return nil, handleRPCError(err)
+	}
+	if len(result) != len(hashes) {
+		return nil, fmt.Errorf("received %d payload bodies for %d requested hashes", len(result), len(hashes))
+	}
+	return result, nil
+}
+
+// GetPayloadBodiesByRange calls the engine_getPayloadBodiesByRangeV1 method via JSON-RPC.
+// Fewer bodies than requested are returned when the range goes past the latest known block.
+func (s *Service) GetPayloadBodiesByRange(ctx context.Context, start, count uint64) ([]*pb.ExecutionPayloadBodyV1, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetPayloadBodiesByRange")
+	defer span.End()
+
+	result := make([]*pb.ExecutionPayloadBodyV1, 0, count)
+	if err := s.rpcClient.CallContext(ctx, &result, GetPayloadBodiesByRangeV1, hexutil.Uint64(start), hexutil.Uint64(count)); err != nil {
+		return nil, handleRPCError(err)
+	}
+	if uint64(len(result)) > count {
+		return nil, fmt.Errorf("received %d payload bodies for %d requested blocks", len(result), count)
+	}
+	return result, nil
+}
+
 // GetTerminalBlockHash returns the valid terminal block hash based on total difficulty.
 //
 // Spec code:
@@ -525,27 +649,47 @@ func (s *Service) ReconstructFullBellatrixBlockBatch(
 			executionHashes = append(executionHashes, executionBlockHash)
 		}
 	}
-	execBlocks, err := s.ExecutionBlocksByHashes(ctx, executionHashes, true /* with txs*/)
+	// Reconstruct the payloads from the payload bodies served by the execution engine when it supports them,
+	// and fall back to fetching the full execution blocks by hash for the remaining ones.
+	validBlocks := make([]interfaces.SignedBeaconBlock, len(validExecPayloads))
+	for sliceIdx, realIdx := range validExecPayloads {
+		validBlocks[sliceIdx] = blindedBlocks[realIdx]
+	}
+	payloads, err := s.payloadsFromBodies(ctx, validBlocks)
+	if err != nil {
+		return nil, err
+	}
+	missingHashes := []common.Hash{}
+	missingPayloads := []int{}
+	for sliceIdx, p := range payloads {
+		if p == nil {
+			missingHashes = append(missingHashes, executionHashes[sliceIdx])
+			missingPayloads = append(missingPayloads, sliceIdx)
+		}
+	}
+	execBlocks, err := s.ExecutionBlocksByHashes(ctx, missingHashes, true /* with txs*/)
 	if err != nil {
-		return nil, fmt.Errorf("could not fetch execution blocks with txs by hash %#x: %v", executionHashes, err)
+		return nil, fmt.Errorf("could not fetch execution blocks with txs by hash %#x: %v", missingHashes, err)
 	}
-
-	// For each valid payload, we reconstruct the full block from it with the
-	// blinded block.
-	for sliceIdx, realIdx := range validExecPayloads {
-		b := execBlocks[sliceIdx]
+	for i, sliceIdx := range missingPayloads {
+		b := execBlocks[i]
 		if b == nil {
-			return nil, fmt.Errorf("received nil execution block for request by hash %#x", executionHashes[sliceIdx])
+			return nil, fmt.Errorf("received nil execution block for request by hash %#x", missingHashes[i])
 		}
-		header, err := blindedBlocks[realIdx].Block().Body().Execution()
+		header, err := validBlocks[sliceIdx].Block().Body().Execution()
 		if err != nil {
 			return nil, err
 		}
-		payload, err := fullPayloadFromExecutionBlock(header, b)
+		payloads[sliceIdx], err = fullPayloadFromExecutionBlock(header, b)
 		if err != nil {
 			return nil, err
 		}
-		fullBlock, err := blocks.BuildSignedBeaconBlockFromExecutionPayload(blindedBlocks[realIdx], payload.Proto())
+	}
+
+	// For each valid payload, we reconstruct the full block from it with the
+	// blinded block.
+	for sliceIdx, realIdx := range validExecPayloads {
+		fullBlock, err := blocks.BuildSignedBeaconBlockFromExecutionPayload(blindedBlocks[realIdx], payloads[sliceIdx].Proto())
 		if err != nil {
 			return nil, err
 		}
@@ -565,6 +709,172 @@ func (s *Service) ReconstructFullBellatrixBlockBatch(
 	return blindedBlocks, nil
 }
 
+// payloadsFromBodies reconstructs the execution payloads of the given post-merge blinded blocks from the payload
+// bodies served by the execution engine. Contiguous execution blocks are requested by range, and the others by hash.
+// Payloads which can't be reconstructed this way, because the execution engine doesn't support the payload bodies
+// methods, fails to serve them or doesn't know the block, are left nil.
+func (s *Service) payloadsFromBodies(
+	ctx context.Context, blindedBlocks []interfaces.SignedBeaconBlock,
+) ([]interfaces.ExecutionData, error) {
+	payloads := make([]interfaces.ExecutionData, len(blindedBlocks))
+	if len(blindedBlocks) == 0 {
+		return payloads, nil
+	}
+	headers := make([]interfaces.ExecutionData, len(blindedBlocks))
+	contiguous := true
+	for i, b := range blindedBlocks {
+		header, err := b.Block().Body().Execution()
+		if err != nil {
+			return nil, err
+		}
+		headers[i] = header
+		if header.BlockNumber() != headers[0].BlockNumber()+uint64(i) {
+			contiguous = false
+		}
+	}
+	fill := func(indices []int, bodies []*pb.ExecutionPayloadBodyV1) {
+		for i, body := range bodies {
+			if body == nil {
+				continue
+			}
+			idx := indices[i]
+			payload, err := fullPayloadFromPayloadBody(headers[idx], body, blindedBlocks[idx].Version())
+			if err != nil {
+				log.WithError(err).WithField("blockHash", fmt.Sprintf("%#x", headers[idx].BlockHash())).
+					Debug("Could not reconstruct execution payload from payload body")
+				continue
+			}
+			payloads[idx] = payload
+		}
+	}
+
+	// Bodies requested by range are those of the canonical chain of the execution engine, which may differ
+	// from ours. Such bodies are ignored by fullPayloadFromPayloadBody, and requested again by hash below.
+	if contiguous && s.supportsCapability(ctx, GetPayloadBodiesByRangeV1) {
+		for start := 0; start < len(headers); start += payloadBodiesBatchSize {
+			end := start + payloadBodiesBatchSize
+			if end > len(headers) {
+				end = len(headers)
+			}
+			bodies, err := s.GetPayloadBodiesByRange(ctx, headers[start].BlockNumber(), uint64(end-start))
+			if err != nil {
+				log.WithError(err).Debug("Could not get payload bodies by range, requesting them by hash")
+				break
+			}
+			indices := make([]int, end-start)
+			for i := range indices {
+				indices[i] = start + i
+			}
+			fill(indices, bodies)
+		}
+	}
+
+	missing := []int{}
+	for i, p := range payloads {
+		if p == nil {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) == 0 || !s.supportsCapability(ctx, GetPayloadBodiesByHashV1) {
+		return payloads, nil
+	}
+	for start := 0; start < len(missing); start += payloadBodiesBatchSize {
+		end := start + payloadBodiesBatchSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+		indices := missing[start:end]
+		hashes := make([]common.Hash, len(indices))
+		for i, idx := range indices {
+			hashes[i] = common.BytesToHash(headers[idx].BlockHash())
+		}
+		bodies, err := s.GetPayloadBodiesByHash(ctx, hashes)
+		if err != nil {
+			log.WithError(err).Debug("Could not get payload bodies by hash, requesting the execution blocks instead")
+			break
+		}
+		fill(indices, bodies)
+	}
+	return payloads, nil
+}
+
+// fullPayloadFromPayloadBody builds the execution payload of the given header from a payload body.
+// The hash tree root of the payload is checked against the one of the header, as payload bodies don't
+// include a block hash.
+func fullPayloadFromPayloadBody(
+	header interfaces.ExecutionData, body *pb.ExecutionPayloadBodyV1, bVersion int,
+) (interfaces.ExecutionData, error) {
+	if header.IsNil() || body == nil {
+		return nil, errors.New("execution payload header and body cannot be nil")
+	}
+	txs := make([][]byte, len(body.Transactions))
+	for i, tx := range body.Transactions {
+		txs[i] = tx
+	}
+
+	var payload interfaces.ExecutionData
+	var err error
+	if bVersion == version.Bellatrix {
+		payload, err = blocks.WrappedExecutionPayload(&pb.ExecutionPayload{
+			ParentHash:    header.ParentHash(),
+			FeeRecipient:  header.FeeRecipient(),
+			StateRoot:     header.StateRoot(),
+			ReceiptsRoot:  header.ReceiptsRoot(),
+			LogsBloom:     header.LogsBloom(),
+			PrevRandao:    header.PrevRandao(),
+			BlockNumber:   header.BlockNumber(),
+			GasLimit:      header.GasLimit(),
+			GasUsed:       header.GasUsed(),
+			Timestamp:     header.Timestamp(),
+			ExtraData:     header.ExtraData(),
+			BaseFeePerGas: header.BaseFeePerGas(),
+			BlockHash:     header.BlockHash(),
+			Transactions:  txs,
+		})
+	} else {
+		withdrawals := body.Withdrawals
+		if withdrawals == nil {
+			withdrawals = []*pb.Withdrawal{}
+		}
+		payload, err = blocks.WrappedExecutionPayloadCapella(&pb.ExecutionPayloadCapella{
+			ParentHash:    header.ParentHash(),
+			FeeRecipient:  header.FeeRecipient(),
+			StateRoot:     header.StateRoot(),
+			ReceiptsRoot:  header.ReceiptsRoot(),
+			LogsBloom:     header.LogsBloom(),
+			PrevRandao:    header.PrevRandao(),
+			BlockNumber:   header.BlockNumber(),
+			GasLimit:      header.GasLimit(),
+			GasUsed:       header.GasUsed(),
+			Timestamp:     header.Timestamp(),
+			ExtraData:     header.ExtraData(),
+			BaseFeePerGas: header.BaseFeePerGas(),
+			BlockHash:     header.BlockHash(),
+			Transactions:  txs,
+			Withdrawals:   withdrawals,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	payloadRoot, err := payload.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if payloadRoot != headerRoot {
+		return nil, fmt.Errorf(
+			"payload body does not match execution header with block hash %#x",
+			header.BlockHash(),
+		)
+	}
+	reconstructedFromPayloadBodiesCount.Inc()
+	return payload, nil
+}
+
 func fullPayloadFromExecutionBlock(
 	header interfaces.ExecutionData, block *pb.ExecutionBlock,
 ) (interfaces.ExecutionData, error) {
@@ -670,6 +980,9 @@ func handleRPCError(err error) error {
 	case -38003:
 		errInvalidPayloadAttributesCount.Inc()
 		return ErrInvalidPayloadAttributes
+	case -38004:
+		errRequestTooLargeCount.Inc()
+		return ErrRequestTooLarge
 	case -32000:
 		errServerErrorCount.Inc()
 		// Only -32000 status codes are data errors in the RPC specification.
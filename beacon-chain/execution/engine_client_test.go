@@ -72,21 +72,23 @@ func TestClient_IPC(t *testing.T) {
 		want, ok := fix["ExecutionPayload"].(*pb.ExecutionPayload)
 		require.Equal(t, true, ok)
 		payloadId := [8]byte{1}
-		resp, err := srv.GetPayload(ctx, payloadId, 1)
+		resp, value, err := srv.GetPayload(ctx, payloadId, 1)
 		require.NoError(t, err)
 		resPb, err := resp.PbBellatrix()
 		require.NoError(t, err)
 		require.DeepEqual(t, want, resPb)
+		require.Equal(t, true, value == nil)
 	})
 	t.Run(GetPayloadMethodV2, func(t *testing.T) {
-		want, ok := fix["ExecutionPayloadCapella"].(*pb.ExecutionPayloadCapella)
+		want, ok := fix["ExecutionPayloadCapellaWithValue"].(*pb.ExecutionPayloadCapellaWithValue)
 		require.Equal(t, true, ok)
 		payloadId := [8]byte{1}
-		resp, err := srv.GetPayload(ctx, payloadId, params.BeaconConfig().SlotsPerEpoch)
+		resp, value, err := srv.GetPayload(ctx, payloadId, params.BeaconConfig().SlotsPerEpoch)
 		require.NoError(t, err)
 		resPb, err := resp.PbCapella()
 		require.NoError(t, err)
-		require.DeepEqual(t, want, resPb)
+		require.DeepEqual(t, want.Payload, resPb)
+		require.DeepEqual(t, want.Value, value)
 	})
 	t.Run(ForkchoiceUpdatedMethod, func(t *testing.T) {
 		want, ok := fix["ForkchoiceUpdatedResponse"].(*ForkchoiceUpdatedResponse)
@@ -200,7 +202,7 @@ func TestClient_HTTP(t *testing.T) {
 		client.rpcClient = rpcClient
 
 		// We call the RPC method via HTTP and expect a proper result.
-		resp, err := client.GetPayload(ctx, payloadId, 1)
+		resp, _, err := client.GetPayload(ctx, payloadId, 1)
 		require.NoError(t, err)
 		pb, err := resp.PbBellatrix()
 		require.NoError(t, err)
@@ -208,7 +210,7 @@ func TestClient_HTTP(t *testing.T) {
 	})
 	t.Run(GetPayloadMethodV2, func(t *testing.T) {
 		payloadId := [8]byte{1}
-		want, ok := fix["ExecutionPayloadCapella"].(*pb.ExecutionPayloadCapella)
+		want, ok := fix["ExecutionPayloadCapellaWithValue"].(*pb.ExecutionPayloadCapellaWithValue)
 		require.Equal(t, true, ok)
 		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
@@ -244,11 +246,12 @@ func TestClient_HTTP(t *testing.T) {
 		client.rpcClient = rpcClient
 
 		// We call the RPC method via HTTP and expect a proper result.
-		resp, err := client.GetPayload(ctx, payloadId, params.BeaconConfig().SlotsPerEpoch)
+		resp, value, err := client.GetPayload(ctx, payloadId, params.BeaconConfig().SlotsPerEpoch)
 		require.NoError(t, err)
 		pb, err := resp.PbCapella()
 		require.NoError(t, err)
-		require.DeepEqual(t, want, pb)
+		require.DeepEqual(t, want.Payload, pb)
+		require.DeepEqual(t, want.Value, value)
 	})
 	t.Run(ForkchoiceUpdatedMethod+" VALID status", func(t *testing.T) {
 		forkChoiceState := &pb.ForkchoiceState{
@@ -853,6 +856,8 @@ func TestReconstructFullBellatrixBlockBatch(t *testing.T) {
 
 		service := &Service{}
 		service.rpcClient = rpcClient
+		// The execution client doesn't support the payload bodies methods.
+		service.setCapabilities([]string{})
 		blindedBlock := util.NewBlindedBeaconBlockBellatrix()
 
 		blindedBlock.Block.Body.ExecutionPayloadHeader = header
@@ -878,6 +883,261 @@ func TestReconstructFullBellatrixBlockBatch(t *testing.T) {
 	})
 }
 
+func TestReconstructFullBellatrixBlockBatch_PayloadBodies(t *testing.T) {
+	ctx := context.Background()
+	newBlock := func(t *testing.T, number uint64, txs [][]byte) (interfaces.SignedBeaconBlock, *pb.ExecutionPayload) {
+		payload, ok := proto.Clone(fixtures()["ExecutionPayload"].(*pb.ExecutionPayload)).(*pb.ExecutionPayload)
+		require.Equal(t, true, ok)
+		payload.BlockNumber = number
+		payload.BlockHash = bytesutil.PadTo(bytesutil.Bytes8(number), 32)
+		payload.Transactions = txs
+		wrappedPayload, err := blocks.WrappedExecutionPayload(payload)
+		require.NoError(t, err)
+		header, err := blocks.PayloadToHeader(wrappedPayload)
+		require.NoError(t, err)
+		blindedBlock := util.NewBlindedBeaconBlockBellatrix()
+		blindedBlock.Block.Body.ExecutionPayloadHeader = header
+		wrapped, err := blocks.NewSignedBeaconBlock(blindedBlock)
+		require.NoError(t, err)
+		return wrapped, payload
+	}
+	body := func(payload *pb.ExecutionPayload) *pb.ExecutionPayloadBodyV1 {
+		txs := make([]hexutil.Bytes, len(payload.Transactions))
+		for i, tx := range payload.Transactions {
+			txs[i] = tx
+		}
+		return &pb.ExecutionPayloadBodyV1{Transactions: txs}
+	}
+
+	t.Run("contiguous blocks by range", func(t *testing.T) {
+		blk1, payload1 := newBlock(t, 10, [][]byte{{'a'}})
+		blk2, payload2 := newBlock(t, 11, [][]byte{{'b'}, {'c'}})
+		srv := newEngineMethodServer(t, map[string]func(params []json.RawMessage) (interface{}, int){
+			ExchangeCapabilities: func(_ []json.RawMessage) (interface{}, int) {
+				return []string{GetPayloadBodiesByHashV1, GetPayloadBodiesByRangeV1}, 0
+			},
+			GetPayloadBodiesByRangeV1: func(params []json.RawMessage) (interface{}, int) {
+				require.Equal(t, `"0xa"`, string(params[0]))
+				require.Equal(t, `"0x2"`, string(params[1]))
+				return []*pb.ExecutionPayloadBodyV1{body(payload1), body(payload2)}, 0
+			},
+		})
+		defer srv.Close()
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		defer rpcClient.Close()
+		service := &Service{rpcClient: rpcClient}
+
+		reconstructed, err := service.ReconstructFullBellatrixBlockBatch(ctx, []interfaces.SignedBeaconBlock{blk1, blk2})
+		require.NoError(t, err)
+		got, err := reconstructed[0].Block().Body().Execution()
+		require.NoError(t, err)
+		require.DeepEqual(t, payload1, got.Proto())
+		got, err = reconstructed[1].Block().Body().Execution()
+		require.NoError(t, err)
+		require.DeepEqual(t, payload2, got.Proto())
+	})
+	t.Run("mismatching range bodies are requested by hash", func(t *testing.T) {
+		blk1, payload1 := newBlock(t, 10, [][]byte{{'a'}})
+		blk2, payload2 := newBlock(t, 11, [][]byte{{'b'}})
+		srv := newEngineMethodServer(t, map[string]func(params []json.RawMessage) (interface{}, int){
+			ExchangeCapabilities: func(_ []json.RawMessage) (interface{}, int) {
+				return []string{GetPayloadBodiesByHashV1, GetPayloadBodiesByRangeV1}, 0
+			},
+			GetPayloadBodiesByRangeV1: func(_ []json.RawMessage) (interface{}, int) {
+				// The execution engine follows a different chain for the second block.
+				return []*pb.ExecutionPayloadBodyV1{body(payload1), {Transactions: []hexutil.Bytes{{'d'}}}}, 0
+			},
+			GetPayloadBodiesByHashV1: func(params []json.RawMessage) (interface{}, int) {
+				var hashes []common.Hash
+				require.NoError(t, json.Unmarshal(params[0], &hashes))
+				require.DeepEqual(t, []common.Hash{common.BytesToHash(payload2.BlockHash)}, hashes)
+				return []*pb.ExecutionPayloadBodyV1{body(payload2)}, 0
+			},
+		})
+		defer srv.Close()
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		defer rpcClient.Close()
+		service := &Service{rpcClient: rpcClient}
+
+		reconstructed, err := service.ReconstructFullBellatrixBlockBatch(ctx, []interfaces.SignedBeaconBlock{blk1, blk2})
+		require.NoError(t, err)
+		got, err := reconstructed[1].Block().Body().Execution()
+		require.NoError(t, err)
+		require.DeepEqual(t, payload2, got.Proto())
+	})
+	t.Run("non contiguous blocks by hash", func(t *testing.T) {
+		blk1, payload1 := newBlock(t, 10, [][]byte{{'a'}})
+		blk2, payload2 := newBlock(t, 12, nil)
+		srv := newEngineMethodServer(t, map[string]func(params []json.RawMessage) (interface{}, int){
+			ExchangeCapabilities: func(_ []json.RawMessage) (interface{}, int) {
+				return []string{GetPayloadBodiesByHashV1, GetPayloadBodiesByRangeV1}, 0
+			},
+			GetPayloadBodiesByHashV1: func(params []json.RawMessage) (interface{}, int) {
+				var hashes []common.Hash
+				require.NoError(t, json.Unmarshal(params[0], &hashes))
+				require.Equal(t, 2, len(hashes))
+				return []*pb.ExecutionPayloadBodyV1{body(payload1), body(payload2)}, 0
+			},
+		})
+		defer srv.Close()
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		defer rpcClient.Close()
+		service := &Service{rpcClient: rpcClient}
+
+		reconstructed, err := service.ReconstructFullBellatrixBlockBatch(ctx, []interfaces.SignedBeaconBlock{blk1, blk2})
+		require.NoError(t, err)
+		got, err := reconstructed[0].Block().Body().Execution()
+		require.NoError(t, err)
+		require.DeepEqual(t, payload1, got.Proto())
+		got, err = reconstructed[1].Block().Body().Execution()
+		require.NoError(t, err)
+		require.DeepEqual(t, payload2, got.Proto())
+	})
+	t.Run("unknown block falls back to eth_getBlockByHash", func(t *testing.T) {
+		blk, _ := newBlock(t, 10, nil)
+		calledByHash := false
+		srv := newEngineMethodServer(t, map[string]func(params []json.RawMessage) (interface{}, int){
+			ExchangeCapabilities: func(_ []json.RawMessage) (interface{}, int) {
+				return []string{GetPayloadBodiesByHashV1}, 0
+			},
+			GetPayloadBodiesByHashV1: func(_ []json.RawMessage) (interface{}, int) {
+				return []*pb.ExecutionPayloadBodyV1{nil}, 0
+			},
+			ExecutionBlockByHashMethod: func(_ []json.RawMessage) (interface{}, int) {
+				calledByHash = true
+				return nil, 0
+			},
+		})
+		defer srv.Close()
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		defer rpcClient.Close()
+		service := &Service{rpcClient: rpcClient}
+
+		_, err = service.ReconstructFullBellatrixBlockBatch(ctx, []interfaces.SignedBeaconBlock{blk})
+		require.NotNil(t, err)
+		assert.Equal(t, true, calledByHash)
+	})
+	t.Run("failing payload bodies requests fall back to eth_getBlockByHash", func(t *testing.T) {
+		blk, _ := newBlock(t, 10, nil)
+		calledByHash := false
+		srv := newEngineMethodServer(t, map[string]func(params []json.RawMessage) (interface{}, int){
+			ExchangeCapabilities: func(_ []json.RawMessage) (interface{}, int) {
+				return []string{GetPayloadBodiesByHashV1, GetPayloadBodiesByRangeV1}, 0
+			},
+			GetPayloadBodiesByRangeV1: func(_ []json.RawMessage) (interface{}, int) {
+				return nil, -32000
+			},
+			GetPayloadBodiesByHashV1: func(_ []json.RawMessage) (interface{}, int) {
+				return nil, -32000
+			},
+			ExecutionBlockByHashMethod: func(_ []json.RawMessage) (interface{}, int) {
+				calledByHash = true
+				return nil, 0
+			},
+		})
+		defer srv.Close()
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		defer rpcClient.Close()
+		service := &Service{rpcClient: rpcClient}
+
+		_, err = service.ReconstructFullBellatrixBlockBatch(ctx, []interfaces.SignedBeaconBlock{blk})
+		require.NotNil(t, err)
+		assert.Equal(t, true, calledByHash)
+	})
+}
+
+func TestExchangeCapabilities(t *testing.T) {
+	ctx := context.Background()
+	t.Run("caches supported methods", func(t *testing.T) {
+		srv := newEngineMethodServer(t, map[string]func(params []json.RawMessage) (interface{}, int){
+			ExchangeCapabilities: func(params []json.RawMessage) (interface{}, int) {
+				var methods []string
+				require.NoError(t, json.Unmarshal(params[0], &methods))
+				require.DeepEqual(t, supportedEngineEndpoints, methods)
+				return []string{NewPayloadMethod, GetPayloadBodiesByHashV1}, 0
+			},
+		})
+		defer srv.Close()
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		defer rpcClient.Close()
+		service := &Service{rpcClient: rpcClient}
+
+		assert.Equal(t, true, service.supportsCapability(ctx, GetPayloadBodiesByHashV1))
+		assert.Equal(t, false, service.supportsCapability(ctx, GetPayloadBodiesByRangeV1))
+		service.resetCapabilities()
+		assert.Equal(t, true, service.capabilities == nil)
+	})
+	t.Run("method not found", func(t *testing.T) {
+		srv := newEngineMethodServer(t, map[string]func(params []json.RawMessage) (interface{}, int){})
+		defer srv.Close()
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		defer rpcClient.Close()
+		service := &Service{rpcClient: rpcClient}
+
+		_, err = service.ExchangeCapabilities(ctx)
+		require.ErrorIs(t, err, ErrMethodNotFound)
+		assert.Equal(t, false, service.supportsCapability(ctx, GetPayloadBodiesByHashV1))
+		assert.Equal(t, 0, len(service.capabilities))
+		assert.Equal(t, true, service.capabilities != nil)
+	})
+}
+
+// newEngineMethodServer returns a JSON-RPC server answering single and batch requests with the handler of
+// the requested method. The handler returns the result of the call, or a non-zero JSON-RPC error code.
+// Unknown methods are answered with a method not found error.
+func newEngineMethodServer(
+	t *testing.T, handlers map[string]func(params []json.RawMessage) (interface{}, int),
+) *httptest.Server {
+	type request struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	handle := func(req *request) map[string]interface{} {
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		handler, ok := handlers[req.Method]
+		if !ok {
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+			return resp
+		}
+		result, code := handler(req.Params)
+		if code != 0 {
+			resp["error"] = map[string]interface{}{"code": code, "message": "error"}
+			return resp
+		}
+		resp["result"] = result
+		return resp
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		enc, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if strings.HasPrefix(strings.TrimSpace(string(enc)), "[") {
+			var reqs []*request
+			require.NoError(t, json.Unmarshal(enc, &reqs))
+			resps := make([]map[string]interface{}, len(reqs))
+			for i, req := range reqs {
+				resps[i] = handle(req)
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(resps))
+			return
+		}
+		req := &request{}
+		require.NoError(t, json.Unmarshal(enc, req))
+		require.NoError(t, json.NewEncoder(w).Encode(handle(req)))
+	}))
+}
+
 func TestServer_getPowBlockHashAtTerminalTotalDifficulty(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -1228,6 +1488,11 @@ func Test_handleRPCError(t *testing.T) {
 			expectedContains: ErrInvalidPayloadAttributes.Error(),
 			given:            &customError{code: -38003},
 		},
+		{
+			name:             "ErrRequestTooLarge",
+			expectedContains: ErrRequestTooLarge.Error(),
+			given:            &customError{code: -38004},
+		},
 		{
 			name:             "ErrServer unexpected no data",
 			expectedContains: "got an unexpected error",
@@ -1294,6 +1559,10 @@ func fixtures() map[string]interface{} {
 		Transactions:  [][]byte{foo[:]},
 		Withdrawals:   []*pb.Withdrawal{},
 	}
+	executionPayloadWithValueFixtureCapella := &pb.ExecutionPayloadCapellaWithValue{
+		Payload: executionPayloadFixtureCapella,
+		Value:   big.NewInt(1236),
+	}
 	parent := bytesutil.PadTo([]byte("parentHash"), fieldparams.RootLength)
 	sha3Uncles := bytesutil.PadTo([]byte("sha3Uncles"), fieldparams.RootLength)
 	miner := bytesutil.PadTo([]byte("miner"), fieldparams.FeeRecipientLength)
@@ -1389,6 +1658,7 @@ func fixtures() map[string]interface{} {
 		"ExecutionBlock":                    executionBlock,
 		"ExecutionPayload":                  executionPayloadFixture,
 		"ExecutionPayloadCapella":           executionPayloadFixtureCapella,
+		"ExecutionPayloadCapellaWithValue":  executionPayloadWithValueFixtureCapella,
 		"ValidPayloadStatus":                validStatus,
 		"InvalidBlockHashStatus":            inValidBlockHashStatus,
 		"AcceptedStatus":                    acceptedStatus,
@@ -1574,9 +1844,9 @@ func (*testEngineService) GetPayloadV1(
 
 func (*testEngineService) GetPayloadV2(
 	_ context.Context, _ pb.PayloadIDBytes,
-) *pb.ExecutionPayloadCapella {
+) *pb.ExecutionPayloadCapellaWithValue {
 	fix := fixtures()
-	item, ok := fix["ExecutionPayloadCapella"].(*pb.ExecutionPayloadCapella)
+	item, ok := fix["ExecutionPayloadCapellaWithValue"].(*pb.ExecutionPayloadCapellaWithValue)
 	if !ok {
 		panic("not found")
 	}
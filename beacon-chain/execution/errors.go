@@ -21,6 +21,8 @@ var (
 	ErrInvalidForkchoiceState = errors.New("invalid forkchoice state")
 	// ErrInvalidPayloadAttributes corresponds to JSON-RPC code -38003.
 	ErrInvalidPayloadAttributes = errors.New("payload attributes are invalid / inconsistent")
+	// ErrRequestTooLarge corresponds to JSON-RPC code -38004.
+	ErrRequestTooLarge = errors.New("number of requested entities is too large")
 	// ErrUnknownPayloadStatus when the payload status is unknown.
 	ErrUnknownPayloadStatus = errors.New("unknown payload status")
 	// ErrConfigMismatch when the execution node's terminal total difficulty or
@@ -63,6 +63,10 @@ var (
 		Name: "execution_invalid_payload_attributes_count",
 		Help: "The number of errors that occurred due to invalid payload attributes",
 	})
+	errRequestTooLargeCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_request_too_large_count",
+		Help: "The number of errors that occurred due to a request too large",
+	})
 	errServerErrorCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "execution_server_error_count",
 		Help: "The number of errors that occurred due to server error",
@@ -71,4 +75,8 @@ var (
 		Name: "reconstructed_execution_payload_count",
 		Help: "Count the number of execution payloads that are reconstructed using JSON-RPC from payload headers",
 	})
+	reconstructedFromPayloadBodiesCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reconstructed_from_payload_bodies_count",
+		Help: "Count the number of execution payloads that are reconstructed from engine API payload bodies",
+	})
 )
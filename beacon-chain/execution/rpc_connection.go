@@ -46,6 +46,7 @@ func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpo
 		return errors.Wrap(err, errStr)
 	}
 	s.updateConnectedETH1(true)
+	s.resetCapabilities()
 	s.runError = nil
 	return nil
 }
@@ -154,6 +154,8 @@ type Service struct {
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
 	preGenesisState         state.BeaconState
+	capabilitiesLock        sync.RWMutex
+	capabilities            map[string]struct{} // nil until capabilities are exchanged with the execution client.
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
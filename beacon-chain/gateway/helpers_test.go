@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v3/api/gateway"
+	"github.com/prysmaticlabs/prysm/v3/config/features"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 )
@@ -53,4 +54,11 @@ func TestDefaultConfig(t *testing.T) {
 		assert.Equal(t, "/eth/v1alpha2/", cfg.V1AlphaPbMux.Patterns[1])
 		assert.Equal(t, 5, len(cfg.V1AlphaPbMux.Registrations))
 	})
+	t.Run("With slasher", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{EnableSlasher: true})
+		defer resetCfg()
+		cfg := DefaultConfig(false, "eth,prysm")
+		assert.Equal(t, 4, len(cfg.EthPbMux.Registrations))
+		assert.Equal(t, 5, len(cfg.V1AlphaPbMux.Registrations))
+	})
 }
@@ -2,6 +2,7 @@ package node
 
 import (
 	"fmt"
+	"math/bits"
 
 	"github.com/ethereum/go-ethereum/common"
 	fastssz "github.com/prysmaticlabs/fastssz"
@@ -71,6 +72,24 @@ func configureBuilderCircuitBreaker(cliCtx *cli.Context) error {
 	return nil
 }
 
+func configureBuilderBidEvaluation(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(flags.LocalBlockValueBoost.Name) {
+		c := params.BeaconConfig().Copy()
+		c.LocalBlockValueBoost = cliCtx.Uint64(flags.LocalBlockValueBoost.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	if cliCtx.IsSet(flags.MinBuilderBid.Name) {
+		c := params.BeaconConfig().Copy()
+		c.MinBuilderBid = cliCtx.Uint64(flags.MinBuilderBid.Name)
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func configureSlotsPerArchivedPoint(cliCtx *cli.Context) error {
 	if cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
 		c := params.BeaconConfig().Copy()
@@ -82,6 +101,31 @@ func configureSlotsPerArchivedPoint(cliCtx *cli.Context) error {
 	return nil
 }
 
+func configureStateDiffExponents(cliCtx *cli.Context) error {
+	if !cliCtx.IsSet(flags.StateDiffExponents.Name) {
+		return nil
+	}
+	cliExponents := cliCtx.IntSlice(flags.StateDiffExponents.Name)
+	if len(cliExponents) == 0 {
+		return fmt.Errorf("%s must not be empty", flags.StateDiffExponents.Name)
+	}
+	// Diffs can only be archived at epoch boundaries, so the finest layer can't be shorter than an epoch.
+	minExponent := bits.Len64(uint64(params.BeaconConfig().SlotsPerEpoch)) - 1
+	exponents := make([]uint64, len(cliExponents))
+	for i, e := range cliExponents {
+		if e < minExponent || e >= 64 {
+			return fmt.Errorf("%s values must be between %d and 63, got %d", flags.StateDiffExponents.Name, minExponent, e)
+		}
+		if i > 0 && e <= cliExponents[i-1] {
+			return fmt.Errorf("%s values must be strictly ascending", flags.StateDiffExponents.Name)
+		}
+		exponents[i] = uint64(e)
+	}
+	c := params.BeaconConfig().Copy()
+	c.StateDiffExponents = exponents
+	return params.SetActive(c)
+}
+
 func configureEth1Config(cliCtx *cli.Context) error {
 	c := params.BeaconConfig().Copy()
 	if cliCtx.IsSet(flags.ChainID.Name) {
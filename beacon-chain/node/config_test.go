@@ -53,6 +53,56 @@ func TestConfigureSlotsPerArchivedPoint(t *testing.T) {
 	assert.Equal(t, types.Slot(100), params.BeaconConfig().SlotsPerArchivedPoint)
 }
 
+func TestConfigureStateDiffExponents(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	tests := []struct {
+		name      string
+		exponents string
+		want      []uint64
+		wantErr   string
+	}{
+		{name: "valid", exponents: "6,9,12", want: []uint64{6, 9, 12}},
+		{name: "not ascending", exponents: "9,6", wantErr: "strictly ascending"},
+		{name: "shorter than an epoch", exponents: "2,6", wantErr: "must be between 5 and 63"},
+		{name: "too large", exponents: "6,64", wantErr: "must be between 5 and 63"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := cli.App{}
+			set := flag.NewFlagSet("test", 0)
+			require.NoError(t, flags.StateDiffExponents.Apply(set))
+			cliCtx := cli.NewContext(&app, set, nil)
+			require.NoError(t, cliCtx.Set(flags.StateDiffExponents.Name, tt.exponents))
+
+			err := configureStateDiffExponents(cliCtx)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, params.BeaconConfig().StateDiffExponents)
+		})
+	}
+}
+
+func TestConfigureBuilderBidEvaluation(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.LocalBlockValueBoost.Name, 0, "")
+	set.Uint64(flags.MinBuilderBid.Name, 0, "")
+	require.NoError(t, set.Set(flags.LocalBlockValueBoost.Name, "10"))
+	require.NoError(t, set.Set(flags.MinBuilderBid.Name, "1000000"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureBuilderBidEvaluation(cliCtx))
+
+	assert.Equal(t, uint64(10), params.BeaconConfig().LocalBlockValueBoost)
+	assert.Equal(t, uint64(1000000), params.BeaconConfig().MinBuilderBid)
+}
+
 func TestConfigureProofOfWork(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 
@@ -16,6 +16,7 @@ import (
 	"syscall"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	apigateway "github.com/prysmaticlabs/prysm/v3/api/gateway"
 	"github.com/prysmaticlabs/prysm/v3/async/event"
@@ -25,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/v3/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
@@ -110,6 +112,8 @@ type BeaconNode struct {
 	GenesisInitializer      genesis.Initializer
 	CheckpointInitializer   checkpoint.Initializer
 	forkChoicer             forkchoice.ForkChoicer
+	router                  *mux.Router
+	backfillStatus          *backfill.Status
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -139,9 +143,15 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := configureBuilderBidEvaluation(cliCtx); err != nil {
+		return nil, err
+	}
 	if err := configureSlotsPerArchivedPoint(cliCtx); err != nil {
 		return nil, err
 	}
+	if err := configureStateDiffExponents(cliCtx); err != nil {
+		return nil, err
+	}
 	if err := configureEth1Config(cliCtx); err != nil {
 		return nil, err
 	}
@@ -178,6 +188,7 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		router:                  mux.NewRouter(),
 	}
 
 	for _, opt := range opts {
@@ -201,10 +212,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
-	bfs := backfill.NewStatus(beacon.db)
+	bfs := backfill.NewStatus(beacon.db, cliCtx.Bool(flags.EnableBackfill.Name))
 	if err := bfs.Reload(ctx); err != nil {
 		return nil, errors.Wrap(err, "backfill status initialization error")
 	}
+	beacon.backfillStatus = bfs
 
 	log.Debugln("Starting State Gen")
 	if err := beacon.startStateGen(ctx, bfs, beacon.forkChoicer); err != nil {
@@ -256,6 +268,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Pruner Service")
+	if err := beacon.registerPrunerService(); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering RPC Service")
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
@@ -526,24 +543,30 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	}
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootstrapNodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           dataDir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		StateNotifier:     b,
-		DB:                b.db,
+		NoDiscovery:            cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:            slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		TrustedPeers:           slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.TrustedPeers.Name)),
+		BootstrapNodeAddr:      bootstrapNodeAddrs,
+		RelayNodeAddr:          cliCtx.String(cmd.RelayNode.Name),
+		DataDir:                dataDir,
+		LocalIP:                cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:            cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:                cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:             cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:            cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:                cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:                cliCtx.Uint(cmd.P2PUDPPort.Name),
+		QUICPort:               cliCtx.Uint(cmd.P2PQUICPort.Name),
+		MaxPeers:               cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:          cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:           slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		GossipTraceDir:         cliCtx.String(cmd.P2PGossipTraceDir.Name),
+		GossipTraceMaxFileSize: cliCtx.Uint64(cmd.P2PGossipTraceMaxFileSize.Name) * 1024 * 1024,
+		GossipTraceMaxFiles:    cliCtx.Uint64(cmd.P2PGossipTraceMaxFiles.Name),
+		EnableUPnP:             cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		EnableQUIC:             cliCtx.Bool(cmd.EnableQUICFlag.Name),
+		StateNotifier:          b,
+		DB:                     b.db,
 	})
 	if err != nil {
 		return err
@@ -551,7 +574,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	return b.services.RegisterService(svc)
 }
 
-func (b *BeaconNode) fetchP2P() p2p.P2P {
+func (b *BeaconNode) fetchP2P() *p2p.Service {
 	var p *p2p.Service
 	if err := b.services.FetchService(&p); err != nil {
 		panic(err)
@@ -569,7 +592,9 @@ func (b *BeaconNode) fetchBuilderService() *builder.Service {
 
 func (b *BeaconNode) registerAttestationPool() error {
 	s, err := attestations.NewService(b.ctx, &attestations.Config{
-		Pool: b.attestationPool,
+		Pool:            b.attestationPool,
+		BeaconDB:        b.db,
+		PersistInterval: b.cliCtx.Duration(flags.AttestationPoolPersistInterval.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register atts pool service")
@@ -664,8 +689,7 @@ func (b *BeaconNode) registerSyncService() error {
 		return err
 	}
 
-	rs := regularsync.NewService(
-		b.ctx,
+	opts := []regularsync.Option{
 		regularsync.WithDatabase(b.db),
 		regularsync.WithP2P(b.fetchP2P()),
 		regularsync.WithChainService(chainService),
@@ -683,7 +707,20 @@ func (b *BeaconNode) registerSyncService() error {
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
 		regularsync.WithExecutionPayloadReconstructor(web3Service),
-	)
+	}
+	if b.cliCtx.Bool(flags.EnableBackfill.Name) {
+		if b.cliCtx.Uint64(flags.HistoryRetentionEpochs.Name) > 0 {
+			return fmt.Errorf("%s cannot be used with %s", flags.EnableBackfill.Name, flags.HistoryRetentionEpochs.Name)
+		}
+		opts = append(opts,
+			regularsync.WithBackfillStatus(b.backfillStatus),
+			regularsync.WithBackfillRateLimit(
+				b.cliCtx.Uint64(flags.BackfillBatchSize.Name),
+				b.cliCtx.Uint64(flags.BackfillBlocksPerSecond.Name),
+			),
+		)
+	}
+	rs := regularsync.NewService(b.ctx, opts...)
 	return b.services.RegisterService(rs)
 }
 
@@ -716,6 +753,18 @@ func (b *BeaconNode) registerSlasherService() error {
 		return err
 	}
 
+	evidenceFile := b.cliCtx.String(flags.SlasherEvidenceFile.Name)
+	if evidenceFile == "" {
+		evidenceFile = filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "slasher-evidence.jsonl")
+	}
+	slasherParams, err := slasher.NewParams(
+		b.cliCtx.Uint64(flags.SlasherChunkSize.Name),
+		b.cliCtx.Uint64(flags.SlasherValidatorChunkSize.Name),
+		types.Epoch(b.cliCtx.Uint64(flags.SlasherHistoryLength.Name)),
+	)
+	if err != nil {
+		return errors.Wrap(err, "invalid slasher parameters")
+	}
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
@@ -726,6 +775,11 @@ func (b *BeaconNode) registerSlasherService() error {
 		SlashingPoolInserter:    b.slashingsPool,
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
+		OperationNotifier:       b,
+		BeaconDatabase:          b.db,
+		Backfill:                b.cliCtx.Bool(flags.SlasherBackfill.Name),
+		EvidenceFile:            evidenceFile,
+		Params:                  slasherParams,
 	})
 	if err != nil {
 		return err
@@ -749,11 +803,27 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
-	var slasherService *slasher.Service
+	var slashingChecker slasher.SlashingChecker
 	if features.Get().EnableSlasher {
+		var slasherService *slasher.Service
 		if err := b.services.FetchService(&slasherService); err != nil {
 			return err
 		}
+		slashingChecker = slasherService
+	}
+
+	var pruneStatusFetcher pruner.StatusFetcher
+	if b.cliCtx.Uint64(flags.HistoryRetentionEpochs.Name) > 0 {
+		var prunerService *pruner.Service
+		if err := b.services.FetchService(&prunerService); err != nil {
+			return err
+		}
+		pruneStatusFetcher = prunerService
+	}
+
+	var backfillStatusFetcher backfill.StatusFetcher
+	if b.cliCtx.Bool(flags.EnableBackfill.Name) {
+		backfillStatusFetcher = b.backfillStatus
 	}
 
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -800,6 +870,8 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:                   p2pService,
 		PeersFetcher:                  p2pService,
 		PeerManager:                   p2pService,
+		PeerBanner:                    p2pService,
+		TrustedPeerManager:            p2pService,
 		MetadataProvider:              p2pService,
 		ChainInfoFetcher:              chainService,
 		HeadUpdater:                   chainService,
@@ -807,6 +879,7 @@ func (b *BeaconNode) registerRPCService() error {
 		CanonicalFetcher:              chainService,
 		ForkFetcher:                   chainService,
 		FinalizationFetcher:           chainService,
+		LightClientFetcher:            chainService,
 		BlockReceiver:                 chainService,
 		AttestationReceiver:           chainService,
 		GenesisTimeFetcher:            chainService,
@@ -816,7 +889,9 @@ func (b *BeaconNode) registerRPCService() error {
 		ExitPool:                      b.exitPool,
 		SlashingsPool:                 b.slashingsPool,
 		BLSChangesPool:                b.blsToExecPool,
-		SlashingChecker:               slasherService,
+		SlashingChecker:               slashingChecker,
+		PruneStatusFetcher:            pruneStatusFetcher,
+		BackfillStatusFetcher:         backfillStatusFetcher,
 		SyncCommitteeObjectPool:       b.syncCommitteePool,
 		ExecutionChainService:         web3Service,
 		ExecutionChainInfoFetcher:     web3Service,
@@ -833,6 +908,7 @@ func (b *BeaconNode) registerRPCService() error {
 		MaxMsgSize:                    maxMsgSize,
 		ProposerIdsCache:              b.proposerIdsCache,
 		BlockBuilder:                  b.fetchBuilderService(),
+		Router:                        b.router,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -844,7 +920,11 @@ func (b *BeaconNode) registerPrometheusService(_ *cli.Context) error {
 	if err := b.services.FetchService(&p); err != nil {
 		panic(err)
 	}
-	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/p2p", Handler: p.InfoHandler})
+	additionalHandlers = append(additionalHandlers,
+		prometheus.Handler{Path: "/p2p", Handler: p.InfoHandler},
+		prometheus.Handler{Path: "/p2p/peers", Handler: p.PeersHandler},
+		prometheus.Handler{Path: "/p2p/trusted", Handler: p.TrustedPeersHandler},
+	)
 
 	var c *blockchain.Service
 	if err := b.services.FetchService(&c); err != nil {
@@ -898,6 +978,7 @@ func (b *BeaconNode) registerGRPCGateway() error {
 		apigateway.WithMaxCallRecvMsgSize(maxCallSize),
 		apigateway.WithAllowedOrigins(allowedOrigins),
 		apigateway.WithTimeout(uint64(timeout)),
+		apigateway.WithRouter(b.router),
 	}
 	if flags.EnableHTTPEthAPI(httpModules) {
 		opts = append(opts, apigateway.WithApiMiddleware(&apimiddleware.BeaconEndpointFactory{}))
@@ -964,6 +1045,28 @@ func (b *BeaconNode) registerValidatorMonitorService() error {
 	return b.services.RegisterService(svc)
 }
 
+func (b *BeaconNode) registerPrunerService() error {
+	retention := b.cliCtx.Uint64(flags.HistoryRetentionEpochs.Name)
+	if retention == 0 {
+		return nil
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc, err := pruner.New(
+		b.ctx,
+		pruner.WithDatabase(b.db),
+		pruner.WithStateNotifier(b),
+		pruner.WithHeadFetcher(chainService),
+		pruner.WithRetentionEpochs(types.Epoch(retention)),
+	)
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerBuilderService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -30,6 +30,14 @@ var (
 		Name: "expired_block_atts_total",
 		Help: "The number of expired and deleted block attestations in the pool.",
 	})
+	persistedAtts = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "persisted_atts_in_pool_total",
+		Help: "The number of attestations in the last snapshot of the pool persisted to the database.",
+	})
+	restoredAtts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "restored_atts_total",
+		Help: "The number of persisted attestations restored in the pool at startup.",
+	})
 )
 
 func (s *Service) updateMetrics() {
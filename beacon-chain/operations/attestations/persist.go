@@ -0,0 +1,151 @@
+package attestations
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// persistPool restores the attestations persisted before the last shutdown once the head state
+// is known, then snapshots the pool to the database every persist interval.
+func (s *Service) persistPool() {
+	select {
+	case <-s.headStateSet:
+	case <-s.ctx.Done():
+		log.Debug("Context closed, exiting routine")
+		return
+	}
+	if err := s.restorePool(s.ctx); err != nil {
+		log.WithError(err).Error("Could not restore persisted attestation pool")
+	}
+	close(s.poolRestored)
+
+	ticker := time.NewTicker(s.cfg.PersistInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.savePool(s.ctx); err != nil {
+				log.WithError(err).Error("Could not persist attestation pool")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// savePool snapshots the aggregated, unaggregated, block and fork choice attestations of the pool
+// to the database.
+func (s *Service) savePool(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "Operations.attestations.savePool")
+	defer span.End()
+
+	unaggregatedAtts, err := s.cfg.Pool.UnaggregatedAttestations()
+	if err != nil {
+		return errors.Wrap(err, "could not get unaggregated attestations")
+	}
+	poolAtts := append(s.cfg.Pool.AggregatedAttestations(), unaggregatedAtts...)
+	forkchoiceAtts := append(s.cfg.Pool.ForkchoiceAttestations(), s.cfg.Pool.BlockAttestations()...)
+	if err := s.cfg.BeaconDB.SaveAttestationPool(ctx, poolAtts, forkchoiceAtts); err != nil {
+		return err
+	}
+	persistedAtts.Set(float64(len(poolAtts) + len(forkchoiceAtts)))
+	return nil
+}
+
+// restorePool loads the persisted attestations back into the pool, dropping the ones which
+// expired in the meantime or are not valid against the head state. The fork choice attestations
+// are batched again for fork choice.
+func (s *Service) restorePool(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "Operations.attestations.restorePool")
+	defer span.End()
+
+	poolAtts, forkchoiceAtts, err := s.cfg.BeaconDB.AttestationPool(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get persisted attestation pool")
+	}
+	restored, dropped := 0, 0
+	for _, att := range poolAtts {
+		if err := s.restorable(ctx, att); err != nil {
+			log.WithError(err).Debug("Dropped persisted attestation")
+			dropped++
+			continue
+		}
+		if helpers.IsAggregated(att) {
+			err = s.cfg.Pool.SaveAggregatedAttestation(att)
+		} else {
+			err = s.cfg.Pool.SaveUnaggregatedAttestation(att)
+		}
+		if err != nil {
+			log.WithError(err).Debug("Could not restore persisted attestation")
+			dropped++
+			continue
+		}
+		restored++
+	}
+	for _, att := range forkchoiceAtts {
+		if err := s.restorable(ctx, att); err != nil {
+			log.WithError(err).Debug("Dropped persisted fork choice attestation")
+			dropped++
+			continue
+		}
+		if err := s.cfg.Pool.SaveForkchoiceAttestation(att); err != nil {
+			log.WithError(err).Debug("Could not restore persisted fork choice attestation")
+			dropped++
+			continue
+		}
+		restored++
+	}
+	restoredAtts.Add(float64(restored))
+	log.WithFields(logrus.Fields{
+		"restored": restored,
+		"dropped":  dropped,
+	}).Info("Restored persisted attestation pool")
+	if err := s.batchForkChoiceAtts(ctx); err != nil {
+		return errors.Wrap(err, "could not prepare restored attestations for fork choice")
+	}
+	s.updateMetrics()
+	return nil
+}
+
+// restorable returns an error if a persisted attestation is malformed, expired, or not valid
+// against the head state: its committee must exist, its aggregation bits must match the size
+// of the committee, and it must be signed for the fork of the head state.
+func (s *Service) restorable(ctx context.Context, att *ethpb.Attestation) error {
+	if err := helpers.ValidateNilAttestation(att); err != nil {
+		return err
+	}
+	if s.expired(att.Data.Slot) {
+		return errors.New("attestation expired")
+	}
+	if err := helpers.ValidateSlotTargetEpoch(att.Data); err != nil {
+		return err
+	}
+	if s.headState == nil || s.headState.IsNil() {
+		return errors.New("head state is not set")
+	}
+	// The committees of later epochs can not be computed from the head state.
+	if headEpoch := slots.ToEpoch(s.headState.Slot()); att.Data.Target.Epoch > headEpoch+params.BeaconConfig().MinSeedLookahead {
+		return errors.Errorf("target epoch %d is too far ahead of head state epoch %d", att.Data.Target.Epoch, headEpoch)
+	}
+	activeCount, err := helpers.ActiveValidatorCount(ctx, s.headState, att.Data.Target.Epoch)
+	if err != nil {
+		return errors.Wrap(err, "could not get active validator count")
+	}
+	if count := helpers.SlotCommitteeCount(activeCount); uint64(att.Data.CommitteeIndex) >= count {
+		return errors.Errorf("committee index %d >= committee count %d", att.Data.CommitteeIndex, count)
+	}
+	if err := helpers.VerifyAttestationBitfieldLengths(ctx, s.headState, att); err != nil {
+		return err
+	}
+	return blocks.VerifyAttestationSignature(ctx, s.headState, att)
+}
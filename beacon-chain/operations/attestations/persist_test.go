@@ -0,0 +1,161 @@
+package attestations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	dbtest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+func persistedAtt(slot types.Slot, bits bitfield.Bitlist) *ethpb.Attestation {
+	return &ethpb.Attestation{
+		Data:            util.HydrateAttestationData(&ethpb.AttestationData{Slot: slot}),
+		AggregationBits: bits,
+		Signature:       make([]byte, fieldparams.BLSSignatureLength),
+	}
+}
+
+// signedAtt returns an attestation of the given committee members of the first committee of the
+// slot, signed for the fork of the given state.
+func signedAtt(t *testing.T, st state.BeaconState, keys []bls.SecretKey, slot types.Slot, members ...uint64) *ethpb.Attestation {
+	ctx := context.Background()
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, slot, 0)
+	require.NoError(t, err)
+	att := persistedAtt(slot, bitfield.NewBitlist(uint64(len(committee))))
+	att.Data.Target.Epoch = slots.ToEpoch(slot)
+	domain, err := signing.Domain(st.Fork(), att.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(att.Data, domain)
+	require.NoError(t, err)
+	sigs := make([]bls.Signature, 0, len(members))
+	for _, m := range members {
+		att.AggregationBits.SetBitAt(m, true)
+		sigs = append(sigs, keys[committee[m]].Sign(root[:]))
+	}
+	att.Signature = bls.AggregateSignatures(sigs).Marshal()
+	return att
+}
+
+func TestPersistPool_SaveAndRestore(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	st, keys := util.DeterministicGenesisState(t, 128)
+	s, err := NewService(ctx, &Config{Pool: NewPool(), BeaconDB: beaconDB, PersistInterval: time.Minute})
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.Pool.SaveUnaggregatedAttestations([]*ethpb.Attestation{
+		signedAtt(t, st, keys, 0, 0),
+		signedAtt(t, st, keys, 1, 0),
+	}))
+	require.NoError(t, s.cfg.Pool.SaveAggregatedAttestations([]*ethpb.Attestation{
+		signedAtt(t, st, keys, 0, 0, 2, 3),
+		signedAtt(t, st, keys, 1, 0, 2, 3),
+	}))
+	require.NoError(t, s.cfg.Pool.SaveBlockAttestation(signedAtt(t, st, keys, 1, 2, 3)))
+	require.NoError(t, s.cfg.Pool.SaveForkchoiceAttestation(signedAtt(t, st, keys, 2, 1, 2, 3)))
+	require.NoError(t, s.savePool(ctx))
+
+	restored, err := NewService(ctx, &Config{Pool: NewPool(), BeaconDB: beaconDB, PersistInterval: time.Minute})
+	require.NoError(t, err)
+	// Rewind back one epoch worth of time, so the attestations of slot 0 expired.
+	restored.genesisTime = uint64(prysmTime.Now().Unix()) - uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot))
+	restored.SetHeadState(st)
+	require.NoError(t, restored.restorePool(ctx))
+
+	unaggregated, err := restored.cfg.Pool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{signedAtt(t, st, keys, 1, 0)}, unaggregated)
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{signedAtt(t, st, keys, 1, 0, 2, 3)}, restored.cfg.Pool.AggregatedAttestations())
+	// The block and fork choice attestations are batched for fork choice, along with the aggregated ones.
+	assert.Equal(t, 0, len(restored.cfg.Pool.BlockAttestations()))
+	forkchoiceSlots := make(map[types.Slot]bool)
+	for _, att := range restored.cfg.Pool.ForkchoiceAttestations() {
+		forkchoiceSlots[att.Data.Slot] = true
+	}
+	assert.DeepEqual(t, map[types.Slot]bool{1: true, 2: true}, forkchoiceSlots)
+}
+
+func TestPersistPool_DropsInvalidAttestations(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	st, keys := util.DeterministicGenesisState(t, 128)
+	valid := signedAtt(t, st, keys, 1, 0, 2)
+	badBits := signedAtt(t, st, keys, 1, 0, 3)
+	badBits.AggregationBits = bitfield.Bitlist{0b1101, 0b1}
+	badCommittee := signedAtt(t, st, keys, 1, 1, 3)
+	badCommittee.Data.CommitteeIndex = 1
+	badSignature := signedAtt(t, st, keys, 1, 0, 1, 2)
+	badSignature.Signature = signedAtt(t, st, keys, 2, 0, 1, 2).Signature
+	otherFork := signedAtt(t, st, keys, 1, 1, 2, 3)
+	otherState := st.Copy()
+	require.NoError(t, otherState.SetFork(&ethpb.Fork{
+		PreviousVersion: []byte{1, 2, 3, 4},
+		CurrentVersion:  []byte{1, 2, 3, 4},
+	}))
+	otherFork.Signature = signedAtt(t, otherState, keys, 1, 1, 2, 3).Signature
+	// The committees two epochs ahead of the head state are unknown.
+	tooFarAhead := signedAtt(t, st, keys, 2*params.BeaconConfig().SlotsPerEpoch+1, 0, 1, 2)
+	require.NoError(t, beaconDB.SaveAttestationPool(ctx,
+		[]*ethpb.Attestation{valid, badBits, badCommittee, badSignature, tooFarAhead},
+		[]*ethpb.Attestation{otherFork},
+	))
+
+	s, err := NewService(ctx, &Config{Pool: NewPool(), BeaconDB: beaconDB, PersistInterval: time.Minute})
+	require.NoError(t, err)
+	s.genesisTime = uint64(prysmTime.Now().Unix())
+	s.SetHeadState(st)
+	require.NoError(t, s.restorePool(ctx))
+
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{valid}, s.cfg.Pool.AggregatedAttestations())
+	unaggregated, err := s.cfg.Pool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(unaggregated))
+	// The valid aggregated attestation is batched for fork choice, the fork choice attestation is dropped.
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{valid}, s.cfg.Pool.ForkchoiceAttestations())
+}
+
+func TestPersistPool_RestoresOnHeadStateAndSavesOnStop(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	st, keys := util.DeterministicGenesisState(t, 128)
+	want := signedAtt(t, st, keys, 1, 0, 2, 3)
+	require.NoError(t, beaconDB.SaveAttestationPool(context.Background(), []*ethpb.Attestation{want}, nil))
+
+	s, err := NewService(context.Background(), &Config{Pool: NewPool(), BeaconDB: beaconDB, PersistInterval: time.Minute})
+	require.NoError(t, err)
+	s.Start()
+	s.SetGenesisTime(uint64(prysmTime.Now().Unix()))
+	// The persisted pool is not overwritten before it was restored.
+	select {
+	case <-s.poolRestored:
+		t.Fatal("Pool restored before head state was set")
+	default:
+	}
+	s.SetHeadState(st)
+	select {
+	case <-s.poolRestored:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Pool was not restored")
+	}
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{want}, s.cfg.Pool.AggregatedAttestations())
+
+	other := persistedAtt(2, bitfield.Bitlist{0b1011, 0b1})
+	require.NoError(t, s.cfg.Pool.SaveAggregatedAttestation(other))
+	require.NoError(t, s.Stop())
+	poolAtts, _, err := beaconDB.AttestationPool(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(poolAtts))
+}
@@ -5,9 +5,12 @@ package attestations
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
 	lruwrpr "github.com/prysmaticlabs/prysm/v3/cache/lru"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 )
@@ -22,12 +25,20 @@ type Service struct {
 	err                      error
 	forkChoiceProcessedRoots *lru.Cache
 	genesisTime              uint64
+	headState                state.ReadOnlyBeaconState
+	headStateSet             chan struct{}
+	headStateOnce            sync.Once
+	poolRestored             chan struct{}
 }
 
 // Config options for the service.
 type Config struct {
-	Pool          Pool
-	pruneInterval time.Duration
+	Pool     Pool
+	BeaconDB db.NoHeadAccessDatabase
+	// PersistInterval is the interval at which the pool is persisted to the beacon DB, so it
+	// survives restarts. The pool is not persisted when it is 0.
+	PersistInterval time.Duration
+	pruneInterval   time.Duration
 }
 
 // NewService instantiates a new attestation pool service instance that will
@@ -46,6 +57,8 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		ctx:                      ctx,
 		cancel:                   cancel,
 		forkChoiceProcessedRoots: cache,
+		headStateSet:             make(chan struct{}),
+		poolRestored:             make(chan struct{}),
 	}, nil
 }
 
@@ -53,12 +66,26 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 func (s *Service) Start() {
 	go s.prepareForkChoiceAtts()
 	go s.pruneAttsPool()
+	if s.persistenceEnabled() {
+		go s.persistPool()
+	}
 }
 
 // Stop the beacon block attestation pool service's main event loop
-// and associated goroutines.
+// and associated goroutines. The pool is persisted one last time, unless
+// the previously persisted pool was not restored yet.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if !s.persistenceEnabled() {
+		return nil
+	}
+	select {
+	case <-s.poolRestored:
+		if err := s.savePool(s.ctx); err != nil {
+			log.WithError(err).Error("Could not persist attestation pool")
+		}
+	default:
+	}
 	return nil
 }
 
@@ -74,3 +101,18 @@ func (s *Service) Status() error {
 func (s *Service) SetGenesisTime(t uint64) {
 	s.genesisTime = t
 }
+
+// SetHeadState sets the head state of the chain at start up, which the persisted attestations are
+// validated against before being restored into the pool.
+func (s *Service) SetHeadState(st state.ReadOnlyBeaconState) {
+	s.headStateOnce.Do(func() {
+		s.headState = st
+		if s.headStateSet != nil {
+			close(s.headStateSet)
+		}
+	})
+}
+
+func (s *Service) persistenceEnabled() bool {
+	return s.cfg.BeaconDB != nil && s.cfg.PersistInterval > 0
+}
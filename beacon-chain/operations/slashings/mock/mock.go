@@ -11,6 +11,7 @@ import (
 type PoolMock struct {
 	PendingAttSlashings  []*ethpb.AttesterSlashing
 	PendingPropSlashings []*ethpb.ProposerSlashing
+	ErrInsert            error
 }
 
 // PendingAttesterSlashings --
@@ -25,12 +26,18 @@ func (m *PoolMock) PendingProposerSlashings(_ context.Context, _ state.ReadOnlyB
 
 // InsertAttesterSlashing --
 func (m *PoolMock) InsertAttesterSlashing(_ context.Context, _ state.ReadOnlyBeaconState, slashing *ethpb.AttesterSlashing) error {
+	if m.ErrInsert != nil {
+		return m.ErrInsert
+	}
 	m.PendingAttSlashings = append(m.PendingAttSlashings, slashing)
 	return nil
 }
 
 // InsertProposerSlashing --
 func (m *PoolMock) InsertProposerSlashing(_ context.Context, _ state.ReadOnlyBeaconState, slashing *ethpb.ProposerSlashing) error {
+	if m.ErrInsert != nil {
+		return m.ErrInsert
+	}
 	m.PendingPropSlashings = append(m.PendingPropSlashings, slashing)
 	return nil
 }
@@ -7,8 +7,12 @@ import (
 	ma "github.com/multiformats/go-multiaddr"
 )
 
+// quicV1Protocol is the multiaddr protocol of the QUIC transport, as specified by RFC 9000.
+const quicV1Protocol = "quic-v1"
+
 // withRelayAddrs returns an AddrFactory which will return Multiaddr via
-// specified relay string in addition to existing MultiAddr.
+// specified relay string in addition to existing MultiAddr. Both the relay
+// and the existing MultiAddr may be TCP or QUIC addresses.
 func withRelayAddrs(relay string) config.AddrsFactory {
 	return func(addrs []ma.Multiaddr) []ma.Multiaddr {
 		if relay == "" {
@@ -36,3 +40,13 @@ func withRelayAddrs(relay string) config.AddrsFactory {
 		return append(addrs, relayAddrs...)
 	}
 }
+
+// isQUICAddr returns true if the address is a QUIC multiaddr.
+func isQUICAddr(addr string) bool {
+	maddr, err := ma.NewMultiaddr(addr)
+	if err != nil {
+		return false
+	}
+	_, err = maddr.ValueForProtocol(ma.P_QUIC_V1)
+	return err == nil
+}
@@ -43,3 +43,19 @@ func TestRelayAddrs_UseNonRelayAddrs(t *testing.T) {
 	assert.Equal(t, 2, len(result), "Unexpected number of addresses")
 	assert.DeepEqual(t, addrs, result)
 }
+
+func TestRelayAddrs_QUIC(t *testing.T) {
+	relay := "/ip4/127.0.0.1/udp/6660/quic-v1/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi"
+	assert.Equal(t, true, isQUICAddr(relay))
+	assert.Equal(t, false, isQUICAddr("/ip4/127.0.0.1/tcp/6660/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi"))
+	assert.Equal(t, false, isQUICAddr(""))
+	f := withRelayAddrs(relay)
+
+	a, err := ma.NewMultiaddr("/ip4/127.0.0.1/udp/33201/quic-v1/p2p/QmaXZhW44pwQxBSeLkE5FNeLz8tGTTEsRciFg1DNWXXrWG")
+	require.NoError(t, err)
+	result := f([]ma.Multiaddr{a})
+	require.Equal(t, 2, len(result), "Unexpected number of addresses")
+
+	expected := "/ip4/127.0.0.1/udp/6660/quic-v1/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi/p2p-circuit/ip4/127.0.0.1/udp/33201/quic-v1/p2p/QmaXZhW44pwQxBSeLkE5FNeLz8tGTTEsRciFg1DNWXXrWG"
+	assert.Equal(t, expected, result[1].String())
+}
@@ -8,23 +8,29 @@ import (
 // Config for the p2p service. These parameters are set from application level flags
 // to initialize the p2p service.
 type Config struct {
-	NoDiscovery         bool
-	EnableUPnP          bool
-	StaticPeers         []string
-	BootstrapNodeAddr   []string
-	Discv5BootStrapAddr []string
-	RelayNodeAddr       string
-	LocalIP             string
-	HostAddress         string
-	HostDNS             string
-	PrivateKey          string
-	DataDir             string
-	MetaDataDir         string
-	TCPPort             uint
-	UDPPort             uint
-	MaxPeers            uint
-	AllowListCIDR       string
-	DenyListCIDR        []string
-	StateNotifier       statefeed.Notifier
-	DB                  db.ReadOnlyDatabase
+	NoDiscovery            bool
+	EnableUPnP             bool
+	EnableQUIC             bool
+	StaticPeers            []string
+	TrustedPeers           []string
+	BootstrapNodeAddr      []string
+	Discv5BootStrapAddr    []string
+	RelayNodeAddr          string
+	LocalIP                string
+	HostAddress            string
+	HostDNS                string
+	PrivateKey             string
+	DataDir                string
+	MetaDataDir            string
+	TCPPort                uint
+	UDPPort                uint
+	QUICPort               uint
+	MaxPeers               uint
+	AllowListCIDR          string
+	DenyListCIDR           []string
+	GossipTraceDir         string
+	GossipTraceMaxFileSize uint64
+	GossipTraceMaxFiles    uint64
+	StateNotifier          statefeed.Notifier
+	DB                     db.NoHeadAccessDatabase
 }
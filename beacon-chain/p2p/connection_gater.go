@@ -25,8 +25,9 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (_ *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	// Disallow dialing banned peers.
+	return !s.peers.IsBanned(pid)
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
@@ -36,6 +37,9 @@ func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow b
 	if s.peers.IsBad(pid) {
 		return false
 	}
+	if s.peers.IsAddrBanned(m) {
+		return false
+	}
 	return filterConnections(s.addrFilter, m)
 }
 
@@ -45,6 +49,11 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 	if !s.started {
 		return false
 	}
+	if s.peers.IsAddrBanned(n.RemoteMultiaddr()) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "banned subnet"}).Trace("Not accepting inbound dial")
+		return false
+	}
 	if !s.validateDial(n.RemoteMultiaddr()) {
 		// Allow other go-routines to run in the event
 		// we receive a large amount of junk connections.
@@ -53,7 +62,7 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
 		return false
 	}
-	if s.isPeerAtLimit(true /* inbound */) {
+	if s.isPeerAtLimit(true /* inbound */) && !s.isTrustedAddr(n.RemoteMultiaddr()) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
 		return false
@@ -63,7 +72,25 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (_ *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(dir network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	// The identity of inbound peers is only known once the connection is secured,
+	// so this is where banned peers dialing in are turned away.
+	if s.peers.IsBanned(pid) {
+		log.WithFields(logrus.Fields{"peer": pid,
+			"reason": "banned peer"}).Trace("Not accepting connection")
+		return false
+	}
+	if s.peers.IsAddrBanned(n.RemoteMultiaddr()) {
+		return false
+	}
+	// Inbound connections from the IP address of a trusted peer get past the inbound limit before
+	// their identity is known; only the trusted peer itself may keep such a connection.
+	if dir == network.DirInbound && s.isTrustedAddr(n.RemoteMultiaddr()) &&
+		!s.isTrustedPeerAddr(pid, n.RemoteMultiaddr()) && s.isPeerAtLimit(true /* inbound */) {
+		log.WithFields(logrus.Fields{"peer": pid,
+			"reason": "at peer limit"}).Trace("Not accepting connection")
+		return false
+	}
 	return true
 }
 
@@ -3,10 +3,12 @@ package p2p
 import (
 	"context"
 	"fmt"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers"
@@ -492,6 +494,96 @@ func TestService_InterceptAddrDial_AllowConflict(t *testing.T) {
 	}
 }
 
+func TestService_InterceptBannedPeer(t *testing.T) {
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, 1*time.Second, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	multiAddress, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	pid := peer.ID("banned")
+
+	assert.Equal(t, true, s.InterceptPeerDial(pid))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: multiAddress}))
+
+	s.peers.BanPeer(pid, &peerdata.Ban{Reason: "spam", Created: time.Now()})
+	assert.Equal(t, false, s.InterceptPeerDial(pid), "Expected banned peer to not be dialed")
+	assert.Equal(t, false, s.InterceptAddrDial(pid, multiAddress), "Expected banned peer to not be dialed")
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: multiAddress}),
+		"Expected banned peer to be rejected")
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, "other", &maEndpoints{raddr: multiAddress}))
+}
+
+func TestService_InterceptBannedSubnet(t *testing.T) {
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, 1*time.Second, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    20,
+			ScorerParams: &scorers.Config{},
+		}),
+		host: mockp2p.NewTestP2P(t).BHost,
+		cfg:  &Config{MaxPeers: 20},
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	s.started = true
+	_, subnet, err := net.ParseCIDR("212.67.10.0/24")
+	require.NoError(t, err)
+	s.peers.BanSubnet(&peerdata.SubnetBan{Subnet: subnet, Ban: &peerdata.Ban{Reason: "sybil", Created: time.Now()}})
+
+	banned, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	allowed, err := ma.NewMultiaddr("/ip4/212.67.11.122/tcp/3000")
+	require.NoError(t, err)
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: banned}), "Expected address in banned subnet to be rejected")
+	assert.Equal(t, false, s.InterceptAddrDial("", banned), "Expected address in banned subnet to not be dialed")
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, "", &maEndpoints{raddr: banned}))
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: allowed}))
+	assert.Equal(t, true, s.InterceptAddrDial("", allowed))
+}
+
+func TestService_InterceptTrustedPeerAtLimit(t *testing.T) {
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, 1*time.Second, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+		host:         mockp2p.NewTestP2P(t).BHost,
+		cfg:          &Config{MaxPeers: 30},
+		trustedPeers: make(map[peer.ID]*trustedPeer),
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	s.started = true
+	for i := 0; i < 30+highWatermarkBuffer; i++ {
+		_ = addPeer(t, s.peers, peers.PeerConnected)
+	}
+	require.Equal(t, true, s.isPeerAtLimit(true /* inbound */))
+
+	trustedAddr, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	otherAddr, err := ma.NewMultiaddr("/ip4/212.67.10.123/tcp/3000")
+	require.NoError(t, err)
+	trusted := peer.ID("trusted")
+	s.trustedPeers[trusted] = &trustedPeer{info: peer.AddrInfo{ID: trusted, Addrs: []ma.Multiaddr{trustedAddr}}}
+	s.peers.AddTrustedPeer(trusted)
+
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: otherAddr}), "Expected inbound dial to be rejected at peer limit")
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: trustedAddr}), "Expected inbound dial from trusted IP to be accepted")
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, trusted, &maEndpoints{raddr: trustedAddr}))
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, "other", &maEndpoints{raddr: trustedAddr}),
+		"Expected other peer sharing the IP of a trusted peer to be rejected at peer limit")
+	assert.Equal(t, true, s.InterceptSecured(network.DirOutbound, "other", &maEndpoints{raddr: trustedAddr}))
+}
+
 // Mock type for testing.
 type maEndpoints struct {
 	laddr ma.Multiaddr
@@ -8,7 +8,7 @@ import (
 	"go.opencensus.io/trace"
 )
 
-// MakePeer from multiaddress string.
+// MakePeer from multiaddress string. The multiaddress may be a TCP or a QUIC (/quic-v1) one.
 func MakePeer(addr string) (*peer.AddrInfo, error) {
 	maddr, err := multiAddrFromString(addr)
 	if err != nil {
@@ -17,6 +17,8 @@ func MakePeer(addr string) (*peer.AddrInfo, error) {
 	return peer.AddrInfoFromP2pAddr(maddr)
 }
 
+// dialRelayNode connects to the relay node. The host must have the QUIC transport
+// to dial a relay node given with a QUIC address.
 func dialRelayNode(ctx context.Context, h host.Host, relayAddr string) error {
 	ctx, span := trace.StartSpan(ctx, "p2p_dialRelayNode")
 	defer span.End()
@@ -7,6 +7,7 @@ import (
 
 	bh "github.com/libp2p/go-libp2p/p2p/host/blank"
 	swarmt "github.com/libp2p/go-libp2p/p2p/net/swarm/testing"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 )
@@ -22,6 +23,14 @@ func TestMakePeer_OK(t *testing.T) {
 	assert.Equal(t, "QmUn6ycS8Fu6L462uZvuEfDoSgYX6kqP4aSZWMa7z1tWAX", a.ID.Pretty(), "Unexpected peer ID")
 }
 
+func TestMakePeer_QUIC(t *testing.T) {
+	a, err := MakePeer("/ip4/127.0.0.1/udp/5678/quic-v1/p2p/QmUn6ycS8Fu6L462uZvuEfDoSgYX6kqP4aSZWMa7z1tWAX")
+	require.NoError(t, err, "Unexpected error when making a valid peer")
+	assert.Equal(t, "QmUn6ycS8Fu6L462uZvuEfDoSgYX6kqP4aSZWMa7z1tWAX", a.ID.Pretty(), "Unexpected peer ID")
+	require.Equal(t, 1, len(a.Addrs))
+	assert.Equal(t, "/ip4/127.0.0.1/udp/5678/quic-v1", a.Addrs[0].String())
+}
+
 func TestDialRelayNode_InvalidPeerString(t *testing.T) {
 	err := dialRelayNode(context.Background(), nil, "/ip4")
 	assert.ErrorContains(t, "failed to parse multiaddr \"/ip4\"", err, "Expected to fail with invalid peer string")
@@ -36,3 +45,20 @@ func TestDialRelayNode_OK(t *testing.T) {
 	assert.NoError(t, dialRelayNode(ctx, host, relayAddr), "Unexpected error when dialing relay node")
 	assert.Equal(t, relay.ID(), host.Peerstore().PeerInfo(relay.ID()).ID, "Host peerstore does not have peer info on relay node")
 }
+
+func TestDialRelayNode_QUIC(t *testing.T) {
+	ctx := context.Background()
+	relay := bh.NewBlankHost(swarmt.GenSwarm(t, swarmt.OptDisableTCP))
+	require.NoError(t, relay.Network().Listen(ma.StringCast("/ip4/127.0.0.1/udp/0/quic-v1")))
+	host := bh.NewBlankHost(swarmt.GenSwarm(t, swarmt.OptDisableTCP))
+	var relayAddr string
+	for _, addr := range relay.Addrs() {
+		if isQUICAddr(addr.String()) {
+			relayAddr = fmt.Sprintf("%s/p2p/%s", addr, relay.ID().Pretty())
+		}
+	}
+	require.NotEqual(t, "", relayAddr, "Relay node does not listen over QUIC")
+
+	assert.NoError(t, dialRelayNode(ctx, host, relayAddr), "Unexpected error when dialing relay node over QUIC")
+	assert.Equal(t, relay.ID(), host.Peerstore().PeerInfo(relay.ID()).ID, "Host peerstore does not have peer info on relay node")
+}
@@ -34,6 +34,12 @@ type Listener interface {
 	LocalNode() *enode.LocalNode
 }
 
+// quicProtocol is the "quic" ENR entry, which holds the UDP port of the QUIC transport of libp2p.
+type quicProtocol uint16
+
+// ENRKey returns the ENR key of the QUIC port.
+func (quicProtocol) ENRKey() string { return "quic" }
+
 // RefreshENR uses an epoch to refresh the enr entry for our node
 // with the tracked committee ids for the epoch, allowing our node
 // to be dynamically discoverable by others given our tracked committee ids.
@@ -171,6 +177,9 @@ func (s *Service) createListener(
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create local node")
 	}
+	if s.cfg.EnableQUIC {
+		localNode.Set(quicProtocol(s.cfg.QUICPort))
+	}
 	if s.cfg.HostAddress != "" {
 		hostIP := net.ParseIP(s.cfg.HostAddress)
 		if hostIP.To4() == nil && hostIP.To16() == nil {
@@ -315,24 +324,26 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 // determines whether our currently connected and
 // active peers are above our set max peer limit.
 func (s *Service) isPeerAtLimit(inbound bool) bool {
-	numOfConns := len(s.host.Network().Peers())
+	// Trusted peers have reserved slots, they do not count against the limits.
+	numOfConns := len(s.peers.WithoutTrusted(s.host.Network().Peers()))
 	maxPeers := int(s.cfg.MaxPeers)
 	// If we are measuring the limit for inbound peers
 	// we apply the high watermark buffer.
 	if inbound {
 		maxPeers += highWatermarkBuffer
 		maxInbound := s.peers.InboundLimit() + highWatermarkBuffer
-		currInbound := len(s.peers.InboundConnected())
+		currInbound := len(s.peers.WithoutTrusted(s.peers.InboundConnected()))
 		// Exit early if we are at the inbound limit.
 		if currInbound >= maxInbound {
 			return true
 		}
 	}
-	activePeers := len(s.Peers().Active())
+	activePeers := len(s.peers.WithoutTrusted(s.Peers().Active()))
 	return activePeers >= maxPeers || numOfConns >= maxPeers
 }
 
-// PeersFromStringAddrs convers peer raw ENRs into multiaddrs for p2p.
+// PeersFromStringAddrs convers peer raw ENRs into multiaddrs for p2p. The QUIC multiaddr
+// of an ENR with a QUIC port is returned along with its TCP multiaddr.
 func PeersFromStringAddrs(addrs []string) ([]ma.Multiaddr, error) {
 	var allAddrs []ma.Multiaddr
 	enodeString, multiAddrString := parseGenericAddrs(addrs)
@@ -353,6 +364,14 @@ func PeersFromStringAddrs(addrs []string) ([]ma.Multiaddr, error) {
 			return nil, errors.Wrapf(err, "Could not get multiaddr")
 		}
 		allAddrs = append(allAddrs, addr)
+		quicAddr, err := convertToQUICMultiAddr(enodeAddr)
+		if err != nil {
+			if !enr.IsNotFound(err) {
+				return nil, errors.Wrapf(err, "Could not get QUIC multiaddr")
+			}
+			continue
+		}
+		allAddrs = append(allAddrs, quicAddr)
 	}
 	return allAddrs, nil
 }
@@ -403,6 +422,8 @@ func convertToMultiAddr(nodes []*enode.Node) []ma.Multiaddr {
 	return multiAddrs
 }
 
+// convertToAddrInfo returns the address info of the node, which also holds the QUIC address
+// of the node if its ENR has a QUIC port, along with the TCP address of the node.
 func convertToAddrInfo(node *enode.Node) (*peer.AddrInfo, ma.Multiaddr, error) {
 	multiAddr, err := convertToSingleMultiAddr(node)
 	if err != nil {
@@ -412,6 +433,18 @@ func convertToAddrInfo(node *enode.Node) (*peer.AddrInfo, ma.Multiaddr, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	quicAddr, err := convertToQUICMultiAddr(node)
+	if err != nil {
+		if !enr.IsNotFound(err) {
+			log.WithError(err).Debug("Could not retrieve QUIC address")
+		}
+		return info, multiAddr, nil
+	}
+	quicInfo, err := peer.AddrInfoFromP2pAddr(quicAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	info.Addrs = append(info.Addrs, quicInfo.Addrs...)
 	return info, multiAddr, nil
 }
 
@@ -428,6 +461,25 @@ func convertToSingleMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
 	return multiAddressBuilderWithID(node.IP().String(), "tcp", uint(node.TCP()), id)
 }
 
+// convertToQUICMultiAddr returns the QUIC address of the node. The error satisfies enr.IsNotFound
+// if the ENR of the node has no QUIC port.
+func convertToQUICMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
+	var quicPort quicProtocol
+	if err := node.Load(&quicPort); err != nil {
+		return nil, err
+	}
+	pubkey := node.Pubkey()
+	assertedKey, err := ecdsaprysm.ConvertToInterfacePubkey(pubkey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pubkey")
+	}
+	id, err := peer.IDFromPublicKey(assertedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get peer id")
+	}
+	return multiAddressBuilderWithID(node.IP().String(), quicV1Protocol, uint(quicPort), id)
+}
+
 func convertToUdpMultiAddr(node *enode.Node) ([]ma.Multiaddr, error) {
 	pubkey := node.Pubkey()
 	assertedKey, err := ecdsaprysm.ConvertToInterfacePubkey(pubkey)
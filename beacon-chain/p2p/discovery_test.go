@@ -290,6 +290,44 @@ func TestUDPMultiAddress(t *testing.T) {
 	assert.Equal(t, true, strings.Contains(multiAddresses[0].String(), "udp"))
 }
 
+func TestQUICMultiAddress(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		cfg:                   &Config{TCPPort: 6600, UDPPort: 6500, QUICPort: 6700, EnableQUIC: true},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: make([]byte, 32),
+	}
+	listener, err := s.createListener(ipAddr, pkey)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	var quicPort quicProtocol
+	require.NoError(t, listener.Self().Load(&quicPort))
+	assert.Equal(t, quicProtocol(6700), quicPort)
+
+	info, multiAddr, err := convertToAddrInfo(listener.Self())
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("/ip4/%s/tcp/6600/p2p/%s", ipAddr, info.ID), multiAddr.String())
+	require.Equal(t, 2, len(info.Addrs))
+	assert.Equal(t, fmt.Sprintf("/ip4/%s/tcp/6600", ipAddr), info.Addrs[0].String())
+	assert.Equal(t, fmt.Sprintf("/ip4/%s/udp/6700/quic-v1", ipAddr), info.Addrs[1].String())
+
+	addrs, err := PeersFromStringAddrs([]string{listener.Self().String()})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(addrs))
+	assert.Equal(t, fmt.Sprintf("/ip4/%s/udp/6700/quic-v1/p2p/%s", ipAddr, info.ID), addrs[1].String())
+
+	// Without QUIC, the ENR has no QUIC port and only the TCP address is used.
+	s.cfg.EnableQUIC = false
+	listener2, err := s.createListener(ipAddr, pkey)
+	require.NoError(t, err)
+	defer listener2.Close()
+	assert.Equal(t, true, enr.IsNotFound(listener2.Self().Load(&quicPort)))
+	info, _, err = convertToAddrInfo(listener2.Self())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(info.Addrs))
+}
+
 func TestMultipleDiscoveryAddresses(t *testing.T) {
 	db, err := enode.OpenDB(t.TempDir())
 	require.NoError(t, err)
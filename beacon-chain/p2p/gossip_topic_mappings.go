@@ -21,6 +21,8 @@ var gossipTopicMappings = map[string]proto.Message{
 	SyncContributionAndProofSubnetTopicFormat: &ethpb.SignedContributionAndProof{},
 	SyncCommitteeSubnetTopicFormat:            &ethpb.SyncCommitteeMessage{},
 	BlsToExecutionChangeSubnetTopicFormat:     &ethpb.SignedBLSToExecutionChange{},
+	LightClientFinalityUpdateTopicFormat:      &ethpb.LightClientFinalityUpdate{},
+	LightClientOptimisticUpdateTopicFormat:    &ethpb.LightClientOptimisticUpdate{},
 }
 
 // GossipTopicMappings is a function to return the assigned data type
@@ -0,0 +1,205 @@
+package p2p
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/gossiptrace"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+)
+
+const (
+	// gossipTraceBufferSize is the number of traced messages waiting to be written, beyond which
+	// messages are dropped from the trace rather than slowing down the pubsub router.
+	gossipTraceBufferSize = 4096
+	// gossipTraceFlushPeriod is how often the traced messages are flushed to disk.
+	gossipTraceFlushPeriod = time.Second
+	// maxTrackedArrivals bounds the arrival times of the messages being validated.
+	maxTrackedArrivals = 1 << 16
+)
+
+var _ pubsub.RawTracer = (*gossipTracer)(nil)
+
+// gossipTracer is a pubsub tracer writing every gossip message received, along with its arrival time
+// and validation result, to rotating trace files. Writes happen in the background so that tracing
+// never blocks the pubsub router.
+type gossipTracer struct {
+	writer       *gossiptrace.Writer
+	records      chan *gossiptrace.Record
+	arrivals     map[string]time.Time
+	arrivalsLock sync.Mutex
+	quit         chan struct{}
+	done         chan struct{}
+	stopOnce     sync.Once
+}
+
+func newGossipTracer(cfg *Config) (*gossipTracer, error) {
+	w, err := gossiptrace.NewWriter(cfg.GossipTraceDir, int64(cfg.GossipTraceMaxFileSize), int(cfg.GossipTraceMaxFiles))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create gossip trace writer")
+	}
+	return &gossipTracer{
+		writer:   w,
+		records:  make(chan *gossiptrace.Record, gossipTraceBufferSize),
+		arrivals: make(map[string]time.Time),
+		quit:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// run writes the traced messages until the tracer is stopped.
+func (t *gossipTracer) run(ctx context.Context) {
+	defer close(t.done)
+	ticker := time.NewTicker(gossipTraceFlushPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case rec := <-t.records:
+			t.write(rec)
+		case <-ticker.C:
+			if err := t.writer.Flush(); err != nil {
+				log.WithError(err).Error("Could not flush gossip trace")
+			}
+		case <-ctx.Done():
+			t.close()
+			return
+		case <-t.quit:
+			t.close()
+			return
+		}
+	}
+}
+
+// stop writes the pending traced messages and closes the trace file.
+func (t *gossipTracer) stop() {
+	t.stopOnce.Do(func() {
+		close(t.quit)
+	})
+	<-t.done
+}
+
+func (t *gossipTracer) write(rec *gossiptrace.Record) {
+	if err := t.writer.Write(rec); err != nil {
+		log.WithError(err).Error("Could not write gossip trace")
+		return
+	}
+	gossipTraceRecords.Inc()
+}
+
+func (t *gossipTracer) close() {
+	for {
+		select {
+		case rec := <-t.records:
+			t.write(rec)
+		default:
+			if err := t.writer.Close(); err != nil {
+				log.WithError(err).Error("Could not close gossip trace")
+			}
+			return
+		}
+	}
+}
+
+// trace queues the message for writing, dropping it if the writer is behind.
+func (t *gossipTracer) trace(msg *pubsub.Message, result gossiptrace.Result) {
+	if msg == nil || msg.Message == nil {
+		return
+	}
+	t.arrivalsLock.Lock()
+	arrival, ok := t.arrivals[msg.ID]
+	delete(t.arrivals, msg.ID)
+	t.arrivalsLock.Unlock()
+	if !ok {
+		arrival = prysmTime.Now()
+	}
+
+	rec := &gossiptrace.Record{
+		Arrival:   arrival,
+		Topic:     msg.GetTopic(),
+		From:      msg.ReceivedFrom,
+		Result:    result,
+		MessageID: msg.ID,
+	}
+	// The data of duplicates was already traced with the first copy of the message.
+	if result != gossiptrace.ResultDuplicate {
+		rec.Data = msg.Data
+	}
+	select {
+	case t.records <- rec:
+	default:
+		gossipTraceDropped.Inc()
+	}
+}
+
+// ValidateMessage records the arrival time of a message entering validation.
+func (t *gossipTracer) ValidateMessage(msg *pubsub.Message) {
+	t.arrivalsLock.Lock()
+	defer t.arrivalsLock.Unlock()
+	if len(t.arrivals) >= maxTrackedArrivals {
+		t.arrivals = make(map[string]time.Time)
+	}
+	t.arrivals[msg.ID] = prysmTime.Now()
+}
+
+// DeliverMessage traces a message which passed validation.
+func (t *gossipTracer) DeliverMessage(msg *pubsub.Message) {
+	t.trace(msg, gossiptrace.ResultAccept)
+}
+
+// RejectMessage traces a message which was rejected, ignored or throttled.
+func (t *gossipTracer) RejectMessage(msg *pubsub.Message, reason string) {
+	switch reason {
+	case pubsub.RejectSelfOrigin:
+		// Our own messages coming back to us are not of interest.
+		return
+	case pubsub.RejectValidationIgnored:
+		t.trace(msg, gossiptrace.ResultIgnore)
+	case pubsub.RejectValidationThrottled, pubsub.RejectValidationQueueFull:
+		t.trace(msg, gossiptrace.ResultThrottled)
+	default:
+		t.trace(msg, gossiptrace.ResultReject)
+	}
+}
+
+// DuplicateMessage traces a message which was already seen.
+func (t *gossipTracer) DuplicateMessage(msg *pubsub.Message) {
+	t.trace(msg, gossiptrace.ResultDuplicate)
+}
+
+// AddPeer is a no-op.
+func (_ *gossipTracer) AddPeer(_ peer.ID, _ protocol.ID) {}
+
+// RemovePeer is a no-op.
+func (_ *gossipTracer) RemovePeer(_ peer.ID) {}
+
+// Join is a no-op.
+func (_ *gossipTracer) Join(_ string) {}
+
+// Leave is a no-op.
+func (_ *gossipTracer) Leave(_ string) {}
+
+// Graft is a no-op.
+func (_ *gossipTracer) Graft(_ peer.ID, _ string) {}
+
+// Prune is a no-op.
+func (_ *gossipTracer) Prune(_ peer.ID, _ string) {}
+
+// ThrottlePeer is a no-op.
+func (_ *gossipTracer) ThrottlePeer(_ peer.ID) {}
+
+// RecvRPC is a no-op.
+func (_ *gossipTracer) RecvRPC(_ *pubsub.RPC) {}
+
+// SendRPC is a no-op.
+func (_ *gossipTracer) SendRPC(_ *pubsub.RPC, _ peer.ID) {}
+
+// DropRPC is a no-op.
+func (_ *gossipTracer) DropRPC(_ *pubsub.RPC, _ peer.ID) {}
+
+// UndeliverableMessage is a no-op.
+func (_ *gossipTracer) UndeliverableMessage(_ *pubsub.Message) {}
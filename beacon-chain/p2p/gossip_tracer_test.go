@@ -0,0 +1,86 @@
+package p2p
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/gossiptrace"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestGossipTracer(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "trace")
+	tracer, err := newGossipTracer(&Config{
+		GossipTraceDir:         dir,
+		GossipTraceMaxFileSize: 1 << 20,
+		GossipTraceMaxFiles:    2,
+	})
+	require.NoError(t, err)
+	go tracer.run(context.Background())
+
+	topic := "/eth2/abababab/beacon_block/ssz_snappy"
+	newMsg := func(id string, from peer.ID) *pubsub.Message {
+		return &pubsub.Message{
+			Message:      &pubsubpb.Message{Topic: &topic, Data: []byte(id + " data")},
+			ID:           id,
+			ReceivedFrom: from,
+		}
+	}
+	accepted := newMsg("accepted", "peer-a")
+	tracer.ValidateMessage(accepted)
+	tracer.DeliverMessage(accepted)
+	ignored := newMsg("ignored", "peer-b")
+	tracer.ValidateMessage(ignored)
+	tracer.RejectMessage(ignored, pubsub.RejectValidationIgnored)
+	tracer.RejectMessage(newMsg("rejected", "peer-b"), pubsub.RejectValidationFailed)
+	tracer.RejectMessage(newMsg("throttled", "peer-b"), pubsub.RejectValidationQueueFull)
+	tracer.RejectMessage(newMsg("self", "peer-b"), pubsub.RejectSelfOrigin)
+	tracer.DuplicateMessage(newMsg("accepted", "peer-c"))
+	tracer.stop()
+	assert.Equal(t, 0, len(tracer.arrivals))
+
+	files, err := gossiptrace.Files(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	r, err := gossiptrace.NewReader(f)
+	require.NoError(t, err)
+
+	want := []struct {
+		id     string
+		from   peer.ID
+		result gossiptrace.Result
+	}{
+		{id: "accepted", from: "peer-a", result: gossiptrace.ResultAccept},
+		{id: "ignored", from: "peer-b", result: gossiptrace.ResultIgnore},
+		{id: "rejected", from: "peer-b", result: gossiptrace.ResultReject},
+		{id: "throttled", from: "peer-b", result: gossiptrace.ResultThrottled},
+		{id: "accepted", from: "peer-c", result: gossiptrace.ResultDuplicate},
+	}
+	for _, w := range want {
+		rec, err := r.Next()
+		require.NoError(t, err)
+		assert.Equal(t, w.id, rec.MessageID)
+		assert.Equal(t, w.from, rec.From)
+		assert.Equal(t, w.result, rec.Result)
+		assert.Equal(t, topic, rec.Topic)
+		if w.result == gossiptrace.ResultDuplicate {
+			assert.Equal(t, 0, len(rec.Data))
+		} else {
+			assert.DeepEqual(t, []byte(w.id+" data"), rec.Data)
+		}
+	}
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,11 @@
+/*
+Package gossiptrace defines the compact binary format used to record the gossip
+messages seen by a beacon node, so that they can be analyzed or replayed offline.
+
+A trace file starts with a magic string and a format version, followed by the records.
+Each record is prefixed with its uvarint encoded length and holds the arrival time of the
+message, its validation result, topic, sender peer, message ID and data. Topics and peers
+are written in full the first time they appear in a file and referred to by their index
+afterwards, so that every file can be read on its own.
+*/
+package gossiptrace
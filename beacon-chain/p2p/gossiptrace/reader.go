@@ -0,0 +1,123 @@
+package gossiptrace
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+)
+
+// Reader reads the gossip records of a trace file.
+type Reader struct {
+	r      *bufio.Reader
+	topics []string
+	peers  []string
+}
+
+// NewReader reads the header of a trace file and returns a reader of its records.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(magic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, errors.Wrap(err, "could not read trace file header")
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, errInvalidHeader
+	}
+	if header[len(magic)] != version {
+		return nil, errors.Errorf("unsupported trace format version %d", header[len(magic)])
+	}
+	return &Reader{r: br}, nil
+}
+
+// Next returns the next record of the trace file, or io.EOF once all the records were read. A record
+// cut short, as left by a node which did not shut down cleanly, returns io.ErrUnexpectedEOF.
+func (r *Reader) Next() (*Record, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, io.ErrUnexpectedEOF
+	}
+	if size > maxRecordSize {
+		return nil, errors.Wrapf(errInvalidRecord, "record of %d bytes", size)
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(r.r, body); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return r.decode(body)
+}
+
+func (r *Reader) decode(body []byte) (*Record, error) {
+	if len(body) < 9 {
+		return nil, errInvalidRecord
+	}
+	rec := &Record{
+		Arrival: time.Unix(0, int64(binary.BigEndian.Uint64(body[:8]))),
+		Result:  Result(body[8]),
+	}
+	body = body[9:]
+
+	var err error
+	rec.Topic, body, err = readIndexed(body, &r.topics)
+	if err != nil {
+		return nil, err
+	}
+	var from string
+	from, body, err = readIndexed(body, &r.peers)
+	if err != nil {
+		return nil, err
+	}
+	rec.From = peer.ID(from)
+
+	var msgID, data []byte
+	msgID, body, err = readBytes(body)
+	if err != nil {
+		return nil, err
+	}
+	rec.MessageID = string(msgID)
+	data, body, err = readBytes(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 {
+		rec.Data = data
+	}
+	if len(body) != 0 {
+		return nil, errors.Wrap(errInvalidRecord, "trailing bytes")
+	}
+	return rec, nil
+}
+
+// readIndexed reads an index into the table. When the index is the next entry of the table, the value
+// itself follows and is added to the table.
+func readIndexed(b []byte, table *[]string) (string, []byte, error) {
+	idx, n := binary.Uvarint(b)
+	if n <= 0 || idx > uint64(len(*table)) {
+		return "", nil, errInvalidRecord
+	}
+	b = b[n:]
+	if idx < uint64(len(*table)) {
+		return (*table)[idx], b, nil
+	}
+	v, b, err := readBytes(b)
+	if err != nil {
+		return "", nil, err
+	}
+	*table = append(*table, string(v))
+	return string(v), b, nil
+}
+
+func readBytes(b []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(b)
+	if n <= 0 || size > uint64(len(b)-n) {
+		return nil, nil, errInvalidRecord
+	}
+	b = b[n:]
+	return b[:size], b[size:], nil
+}
@@ -0,0 +1,74 @@
+package gossiptrace
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+)
+
+const (
+	// magic starts every trace file.
+	magic = "PGTR"
+	// version of the trace format.
+	version = byte(1)
+	// fileSuffix is the suffix of the trace file names.
+	fileSuffix = ".trace"
+	// maxRecordSize bounds the size of a record read from a trace file, to guard against corrupted files.
+	maxRecordSize = 1 << 24
+)
+
+var (
+	errInvalidHeader = errors.New("invalid trace file header")
+	errInvalidRecord = errors.New("invalid trace record")
+)
+
+// Result is the outcome of the validation of a gossip message.
+type Result uint8
+
+const (
+	// ResultAccept is a message which passed validation and was delivered.
+	ResultAccept Result = iota + 1
+	// ResultReject is a message which failed validation, or was rejected by the router before validation.
+	ResultReject
+	// ResultIgnore is a message which was ignored by the validator.
+	ResultIgnore
+	// ResultThrottled is a message which was dropped because the validation queue was full.
+	ResultThrottled
+	// ResultDuplicate is a message which was already seen, and was not validated again.
+	ResultDuplicate
+)
+
+// String returns the name of the result.
+func (r Result) String() string {
+	switch r {
+	case ResultAccept:
+		return "accept"
+	case ResultReject:
+		return "reject"
+	case ResultIgnore:
+		return "ignore"
+	case ResultThrottled:
+		return "throttled"
+	case ResultDuplicate:
+		return "duplicate"
+	default:
+		return "unknown"
+	}
+}
+
+// Record is a gossip message as seen by the node.
+type Record struct {
+	// Arrival is the time at which the message was received.
+	Arrival time.Time
+	// Topic is the full gossip topic of the message.
+	Topic string
+	// From is the peer which forwarded the message.
+	From peer.ID
+	// Result is the outcome of the validation of the message.
+	Result Result
+	// MessageID is the gossip message ID.
+	MessageID string
+	// Data is the encoded message, as received on the wire. It is empty for duplicates.
+	Data []byte
+}
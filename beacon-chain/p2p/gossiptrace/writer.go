@@ -0,0 +1,188 @@
+package gossiptrace
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+)
+
+// Writer writes gossip records to a directory of trace files. A new file is started once the current
+// one reaches the maximum file size, and the oldest files are deleted to keep at most the maximum
+// number of files. Writer is not safe for concurrent use.
+type Writer struct {
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+	file        *os.File
+	buf         *bufio.Writer
+	size        int64
+	topics      map[string]uint64
+	peers       map[string]uint64
+	scratch     []byte
+}
+
+// NewWriter creates a writer of trace files in the given directory, which is created if needed.
+func NewWriter(dir string, maxFileSize int64, maxFiles int) (*Writer, error) {
+	if maxFileSize <= 0 {
+		return nil, errors.New("maximum trace file size must be positive")
+	}
+	if maxFiles <= 0 {
+		return nil, errors.New("maximum number of trace files must be positive")
+	}
+	if err := file.MkdirAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "could not create trace directory %s", dir)
+	}
+	return &Writer{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}, nil
+}
+
+// Write appends the record to the current trace file, starting a new file if needed.
+func (w *Writer) Write(r *Record) error {
+	if r == nil {
+		return errors.New("nil trace record")
+	}
+	if w.file == nil {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	body := w.scratch[:0]
+	body = binary.BigEndian.AppendUint64(body, uint64(r.Arrival.UnixNano()))
+	body = append(body, byte(r.Result))
+	body = appendIndexed(body, w.topics, r.Topic)
+	body = appendIndexed(body, w.peers, string(r.From))
+	body = appendBytes(body, []byte(r.MessageID))
+	body = appendBytes(body, r.Data)
+	w.scratch = body
+
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(len(body)))
+	if _, err := w.buf.Write(prefix[:n]); err != nil {
+		return errors.Wrap(err, "could not write trace record")
+	}
+	if _, err := w.buf.Write(body); err != nil {
+		return errors.Wrap(err, "could not write trace record")
+	}
+	w.size += int64(n + len(body))
+	if w.size >= w.maxFileSize {
+		return w.closeFile()
+	}
+	return nil
+}
+
+// Flush writes the buffered records to the current trace file.
+func (w *Writer) Flush() error {
+	if w.buf == nil {
+		return nil
+	}
+	return w.buf.Flush()
+}
+
+// Close flushes and closes the current trace file.
+func (w *Writer) Close() error {
+	return w.closeFile()
+}
+
+// rotate starts a new trace file and deletes the oldest ones.
+func (w *Writer) rotate() error {
+	name := filepath.Join(w.dir, fmt.Sprintf("gossip-%s%s", time.Now().UTC().Format("20060102T150405.000000000"), fileSuffix))
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return errors.Wrapf(err, "could not create trace file %s", name)
+	}
+	w.file = f
+	w.buf = bufio.NewWriter(f)
+	w.topics = make(map[string]uint64)
+	w.peers = make(map[string]uint64)
+	if _, err := w.buf.WriteString(magic); err != nil {
+		return errors.Wrap(err, "could not write trace file header")
+	}
+	if err := w.buf.WriteByte(version); err != nil {
+		return errors.Wrap(err, "could not write trace file header")
+	}
+	w.size = int64(len(magic) + 1)
+	return w.prune()
+}
+
+// prune deletes the oldest trace files, keeping at most maxFiles files including the current one.
+func (w *Writer) prune() error {
+	files, err := Files(w.dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > w.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return errors.Wrapf(err, "could not delete trace file %s", files[0])
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+func (w *Writer) closeFile() error {
+	if w.file == nil {
+		return nil
+	}
+	f := w.file
+	w.file = nil
+	err := w.buf.Flush()
+	w.buf = nil
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return errors.Wrap(err, "could not close trace file")
+}
+
+// Files returns the trace files in the given directory, oldest first. If path is a file, it is
+// returned as is.
+func Files(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read trace directory %s", path)
+	}
+	var files []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), fileSuffix) {
+			files = append(files, filepath.Join(path, e.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// appendIndexed appends the index of the value in the table, followed by the value itself if it was
+// not in the table yet.
+func appendIndexed(b []byte, table map[string]uint64, v string) []byte {
+	if idx, ok := table[v]; ok {
+		return binary.AppendUvarint(b, idx)
+	}
+	idx := uint64(len(table))
+	table[v] = idx
+	b = binary.AppendUvarint(b, idx)
+	return appendBytes(b, []byte(v))
+}
+
+func appendBytes(b, v []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
@@ -0,0 +1,126 @@
+package gossiptrace
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func testRecords(n int) []*Record {
+	records := make([]*Record, n)
+	for i := range records {
+		records[i] = &Record{
+			Arrival:   time.Unix(1670000000, int64(i)*1000),
+			Topic:     []string{"/eth2/abababab/beacon_block/ssz_snappy", "/eth2/abababab/beacon_attestation_3/ssz_snappy"}[i%2],
+			From:      peer.ID([]string{"peer-a", "peer-b", "peer-c"}[i%3]),
+			Result:    Result(i%5 + 1),
+			MessageID: string(bytes.Repeat([]byte{byte(i)}, 20)),
+		}
+		if records[i].Result != ResultDuplicate {
+			records[i].Data = bytes.Repeat([]byte{byte(i)}, 100+i)
+		}
+	}
+	return records
+}
+
+func readAll(t *testing.T, files []string) []*Record {
+	var records []*Record
+	for _, name := range files {
+		f, err := os.Open(name)
+		require.NoError(t, err)
+		r, err := NewReader(f)
+		require.NoError(t, err)
+		for {
+			rec, err := r.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			records = append(records, rec)
+		}
+		require.NoError(t, f.Close())
+	}
+	return records
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "trace")
+	w, err := NewWriter(dir, 1<<20, 3)
+	require.NoError(t, err)
+	records := testRecords(50)
+	for _, rec := range records {
+		require.NoError(t, w.Write(rec))
+	}
+	require.NoError(t, w.Close())
+
+	files, err := Files(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	got := readAll(t, files)
+	require.Equal(t, len(records), len(got))
+	for i, rec := range got {
+		assert.Equal(t, true, records[i].Arrival.Equal(rec.Arrival))
+		assert.Equal(t, records[i].Topic, rec.Topic)
+		assert.Equal(t, records[i].From, rec.From)
+		assert.Equal(t, records[i].Result, rec.Result)
+		assert.Equal(t, records[i].MessageID, rec.MessageID)
+		assert.DeepEqual(t, records[i].Data, rec.Data)
+	}
+}
+
+func TestWriter_Rotation(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "trace")
+	w, err := NewWriter(dir, 1000, 3)
+	require.NoError(t, err)
+	records := testRecords(100)
+	for _, rec := range records {
+		require.NoError(t, w.Write(rec))
+	}
+	require.NoError(t, w.Close())
+
+	files, err := Files(dir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(files))
+	// Only the most recent records are kept, and every file can be read on its own.
+	got := readAll(t, files)
+	require.Equal(t, true, len(got) > 0 && len(got) < len(records))
+	for i, rec := range got {
+		want := records[len(records)-len(got)+i]
+		assert.Equal(t, want.MessageID, rec.MessageID)
+		assert.Equal(t, want.Topic, rec.Topic)
+		assert.Equal(t, want.From, rec.From)
+	}
+	got = readAll(t, files[len(files)-1:])
+	assert.Equal(t, records[len(records)-1].MessageID, got[len(got)-1].MessageID)
+}
+
+func TestReader_Truncated(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "trace")
+	w, err := NewWriter(dir, 1<<20, 1)
+	require.NoError(t, err)
+	for _, rec := range testRecords(2) {
+		require.NoError(t, w.Write(rec))
+	}
+	require.NoError(t, w.Close())
+	files, err := Files(dir)
+	require.NoError(t, err)
+	b, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+
+	r, err := NewReader(bytes.NewReader(b[:len(b)-10]))
+	require.NoError(t, err)
+	_, err = r.Next()
+	require.NoError(t, err)
+	_, err = r.Next()
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	_, err = NewReader(bytes.NewReader([]byte("nope!")))
+	assert.ErrorContains(t, errInvalidHeader.Error(), err)
+}
@@ -2,6 +2,8 @@ package p2p
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -12,6 +14,7 @@ import (
 	"github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/metadata"
 	"google.golang.org/protobuf/proto"
@@ -86,6 +89,23 @@ type PeerManager interface {
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
+// PeerBanner manages the bans of peers and IP subnets set by the operator.
+type PeerBanner interface {
+	BanPeer(pid peer.ID, reason string, duration time.Duration) error
+	UnbanPeer(pid peer.ID) (bool, error)
+	BanSubnet(subnet *net.IPNet, reason string, duration time.Duration) error
+	UnbanSubnet(subnet *net.IPNet) (bool, error)
+	BannedPeers() map[peer.ID]*peerdata.Ban
+	BannedSubnets() []*peerdata.SubnetBan
+}
+
+// TrustedPeerManager manages the trusted peers set by the operator.
+type TrustedPeerManager interface {
+	AddTrustedPeer(addr string) (peer.ID, error)
+	RemoveTrustedPeer(pid peer.ID) bool
+	TrustedPeers() []peer.AddrInfo
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, interface{}, string, peer.ID) (network.Stream, error)
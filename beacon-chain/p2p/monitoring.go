@@ -63,6 +63,30 @@ var (
 		Name: "p2p_sync_committee_subnet_attempted_broadcasts",
 		Help: "The number of sync committee that were attempted to be broadcast.",
 	})
+	trustedPeersCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_trusted_peers",
+		Help: "The number of trusted peers.",
+	})
+	trustedPeersConnectedCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_trusted_peers_connected",
+		Help: "The number of trusted peers currently connected.",
+	})
+	trustedPeerDialAttempts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_trusted_peer_dial_attempts",
+		Help: "The number of dials of disconnected trusted peers.",
+	})
+	trustedPeerDialFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_trusted_peer_dial_failures",
+		Help: "The number of failed dials of disconnected trusted peers.",
+	})
+	gossipTraceRecords = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_trace_records",
+		Help: "The number of gossip messages written to the gossip trace.",
+	})
+	gossipTraceDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_trace_dropped",
+		Help: "The number of gossip messages missing from the gossip trace, because the trace writer could not keep up.",
+	})
 )
 
 func (s *Service) updateMetrics() {
@@ -9,6 +9,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/p2p/muxer/mplex"
 	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
@@ -28,21 +29,40 @@ func MultiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
 	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/tcp/%d", ipAddr, port))
 }
 
+// QUICMultiAddressBuilder takes in an ip address string and port to produce a go multiaddr format
+// for the QUIC transport.
+func QUICMultiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
+	parsedIP := net.ParseIP(ipAddr)
+	if parsedIP.To4() == nil && parsedIP.To16() == nil {
+		return nil, errors.Errorf("invalid ip address provided: %s", ipAddr)
+	}
+	if parsedIP.To4() != nil {
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/udp/%d/quic-v1", ipAddr, port))
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/udp/%d/quic-v1", ipAddr, port))
+}
+
 // buildOptions for the libp2p host.
 func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Option {
 	cfg := s.cfg
-	listen, err := MultiAddressBuilder(ip.String(), cfg.TCPPort)
-	if err != nil {
-		log.WithError(err).Fatal("Failed to p2p listen")
-	}
+	listenIP := ip.String()
 	if cfg.LocalIP != "" {
 		if net.ParseIP(cfg.LocalIP) == nil {
 			log.Fatalf("Invalid local ip provided: %s", cfg.LocalIP)
 		}
-		listen, err = MultiAddressBuilder(cfg.LocalIP, cfg.TCPPort)
+		listenIP = cfg.LocalIP
+	}
+	listen, err := MultiAddressBuilder(listenIP, cfg.TCPPort)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to p2p listen")
+	}
+	listenAddrs := []ma.Multiaddr{listen}
+	if cfg.EnableQUIC {
+		quicListen, err := QUICMultiAddressBuilder(listenIP, cfg.QUICPort)
 		if err != nil {
-			log.WithError(err).Fatal("Failed to p2p listen")
+			log.WithError(err).Fatal("Failed to p2p listen over QUIC")
 		}
+		listenAddrs = append(listenAddrs, quicListen)
 	}
 	ifaceKey, err := ecdsaprysm.ConvertToInterfacePrivkey(priKey)
 	if err != nil {
@@ -56,13 +76,17 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 
 	options := []libp2p.Option{
 		privKeyOption(priKey),
-		libp2p.ListenAddrs(listen),
+		libp2p.ListenAddrs(listenAddrs...),
 		libp2p.UserAgent(version.BuildData()),
 		libp2p.ConnectionGater(s),
 		libp2p.Transport(tcp.NewTCPTransport),
 		libp2p.Muxer("/mplex/6.7.0", mplex.DefaultTransport),
 		libp2p.DefaultMuxers,
 	}
+	// The QUIC transport is also needed to dial a relay node which is only reachable over QUIC.
+	if cfg.EnableQUIC || isQUICAddr(cfg.RelayNodeAddr) {
+		options = append(options, libp2p.Transport(libp2pquic.NewTransport))
+	}
 
 	options = append(options, libp2p.Security(noise.ID, noise.New))
 
@@ -83,6 +107,14 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			} else {
 				addrs = append(addrs, external)
 			}
+			if cfg.EnableQUIC {
+				externalQUIC, err := QUICMultiAddressBuilder(cfg.HostAddress, cfg.QUICPort)
+				if err != nil {
+					log.WithError(err).Error("Unable to create external QUIC multiaddress")
+				} else {
+					addrs = append(addrs, externalQUIC)
+				}
+			}
 			return addrs
 		}))
 	}
@@ -94,6 +126,14 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			} else {
 				addrs = append(addrs, external)
 			}
+			if cfg.EnableQUIC {
+				externalQUIC, err := ma.NewMultiaddr(fmt.Sprintf("/dns4/%s/udp/%d/quic-v1", cfg.HostDNS, cfg.QUICPort))
+				if err != nil {
+					log.WithError(err).Error("Unable to create external QUIC multiaddress")
+				} else {
+					addrs = append(addrs, externalQUIC)
+				}
+			}
 			return addrs
 		}))
 	}
@@ -110,10 +150,15 @@ func multiAddressBuilderWithID(ipAddr, protocol string, port uint, id peer.ID) (
 	if id.String() == "" {
 		return nil, errors.New("empty peer id given")
 	}
+	// QUIC runs over UDP, the port is the UDP one.
+	transport := fmt.Sprintf("%s/%d", protocol, port)
+	if protocol == quicV1Protocol {
+		transport = fmt.Sprintf("udp/%d/%s", port, quicV1Protocol)
+	}
 	if parsedIP.To4() != nil {
-		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/%s/%d/p2p/%s", ipAddr, protocol, port, id.String()))
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/%s/p2p/%s", ipAddr, transport, id.String()))
 	}
-	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/%s/%d/p2p/%s", ipAddr, protocol, port, id.String()))
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/%s/p2p/%s", ipAddr, transport, id.String()))
 }
 
 // Adds a private key to the libp2p option if the option was provided.
@@ -79,6 +79,64 @@ func TestIPV6Support(t *testing.T) {
 	}
 }
 
+func TestQUICMultiAddressBuilder(t *testing.T) {
+	addr, err := QUICMultiAddressBuilder("127.0.0.1", 13000)
+	require.NoError(t, err)
+	assert.Equal(t, "/ip4/127.0.0.1/udp/13000/quic-v1", addr.String())
+	addr, err = QUICMultiAddressBuilder("::1", 13000)
+	require.NoError(t, err)
+	assert.Equal(t, "/ip6/::1/udp/13000/quic-v1", addr.String())
+	_, err = QUICMultiAddressBuilder("invalid", 13000)
+	assert.ErrorContains(t, "invalid ip address provided", err)
+}
+
+func TestQUICTransport(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	tests := []struct {
+		name       string
+		cfg        *Config
+		transports int
+		listenQUIC bool
+	}{
+		{
+			name:       "disabled",
+			cfg:        &Config{},
+			transports: 1,
+		},
+		{
+			name:       "enabled",
+			cfg:        &Config{EnableQUIC: true, QUICPort: 2001},
+			transports: 2,
+			listenQUIC: true,
+		},
+		{
+			name:       "relay node over QUIC",
+			cfg:        &Config{RelayNodeAddr: "/ip4/127.0.0.1/udp/6660/quic-v1/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi"},
+			transports: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.TCPPort = 2000
+			tt.cfg.StateNotifier = &mock.MockStateNotifier{}
+			svc := &Service{cfg: tt.cfg}
+			var err error
+			svc.privKey, err = privKey(svc.cfg)
+			require.NoError(t, err)
+			var cfg libp2p.Config
+			require.NoError(t, cfg.Apply(svc.buildOptions(net.ParseIP("127.0.0.1"), svc.privKey)...))
+			assert.Equal(t, tt.transports, len(cfg.Transports))
+			listenQUIC := false
+			for _, addr := range cfg.ListenAddrs {
+				if addr.String() == "/ip4/127.0.0.1/udp/2001/quic-v1" {
+					listenQUIC = true
+				}
+			}
+			assert.Equal(t, tt.listenQUIC, listenQUIC)
+		})
+	}
+}
+
 func TestDefaultMultiplexers(t *testing.T) {
 	var cfg libp2p.Config
 	_ = cfg
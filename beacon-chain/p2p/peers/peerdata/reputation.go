@@ -0,0 +1,39 @@
+package peerdata
+
+import (
+	"net"
+	"time"
+)
+
+// Ban describes why connections to and from a peer or an IP subnet are denied, and until when.
+type Ban struct {
+	Reason  string
+	Created time.Time
+	// Expiry is the time at which the ban is lifted, a zero value bans permanently.
+	Expiry time.Time
+}
+
+// Expired returns true if the ban is no longer in effect at the given time.
+func (b *Ban) Expired(t time.Time) bool {
+	return !b.Expiry.IsZero() && !t.Before(b.Expiry)
+}
+
+// SubnetBan is a ban covering every address of an IP subnet.
+type SubnetBan struct {
+	Subnet *net.IPNet
+	Ban    *Ban
+}
+
+// ScoreRecord is the overall score of a peer sampled at a point in time.
+type ScoreRecord struct {
+	Time  time.Time
+	Score float64
+}
+
+// Reputation is the part of the peer data that is persisted, so that it survives restarts
+// and a misbehaving peer cannot clear its record by reconnecting.
+type Reputation struct {
+	BadResponses int
+	ScoreHistory []*ScoreRecord
+	Ban          *Ban
+}
@@ -60,6 +60,9 @@ type PeerData struct {
 	BadResponses         int
 	ProcessedBlocks      uint64
 	BlockProviderUpdated time.Time
+	ScoreHistory         []*ScoreRecord
+	// Ban is set when the peer is denied connections, either by the operator or by the scorers.
+	Ban *Ban
 	// Gossip Scoring data.
 	TopicScores      map[string]*ethpb.TopicScoreSnapshot
 	GossipScore      float64
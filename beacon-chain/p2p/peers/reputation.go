@@ -0,0 +1,186 @@
+package peers
+
+import (
+	"net"
+	"sort"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+)
+
+// maxScoreHistory is the number of score samples kept for every peer.
+const maxScoreHistory = 64
+
+// BanPeer denies connections to and from the peer until the ban expires.
+// If the peer is unknown, it is added so that the ban is in effect once it tries to connect.
+func (p *Status) BanPeer(pid peer.ID, ban *peerdata.Ban) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.PeerDataGetOrCreate(pid).Ban = ban
+}
+
+// UnbanPeer lifts the ban of the peer, and clears its bad responses so that it is not
+// deemed bad again right away. Returns false if the peer was not banned.
+func (p *Status) UnbanPeer(pid peer.ID) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	if !ok || peerData.Ban == nil {
+		return false
+	}
+	peerData.Ban = nil
+	peerData.BadResponses = 0
+	return true
+}
+
+// IsBanned returns true if the peer is banned, or if its address belongs to a banned subnet.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.isBanned(pid)
+}
+
+// isBanned is the lock-free version of IsBanned.
+func (p *Status) isBanned(pid peer.ID) bool {
+	peerData, ok := p.store.PeerData(pid)
+	if !ok {
+		return false
+	}
+	if peerData.Ban != nil && !peerData.Ban.Expired(prysmTime.Now()) {
+		return true
+	}
+	return peerData.Address != nil && p.isAddrBanned(peerData.Address)
+}
+
+// BanSubnet denies connections to and from every address of the subnet until the ban expires.
+func (p *Status) BanSubnet(ban *peerdata.SubnetBan) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.subnetBans[ban.Subnet.String()] = ban
+}
+
+// UnbanSubnet lifts the ban of the subnet. Returns false if the subnet was not banned.
+func (p *Status) UnbanSubnet(subnet *net.IPNet) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	if _, ok := p.subnetBans[subnet.String()]; !ok {
+		return false
+	}
+	delete(p.subnetBans, subnet.String())
+	return true
+}
+
+// IsAddrBanned returns true if the address belongs to a banned subnet.
+func (p *Status) IsAddrBanned(addr ma.Multiaddr) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.isAddrBanned(addr)
+}
+
+// isAddrBanned is the lock-free version of IsAddrBanned.
+func (p *Status) isAddrBanned(addr ma.Multiaddr) bool {
+	if len(p.subnetBans) == 0 {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	now := prysmTime.Now()
+	for _, ban := range p.subnetBans {
+		if !ban.Ban.Expired(now) && ban.Subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// BannedPeers returns the peers with a ban in effect.
+func (p *Status) BannedPeers() map[peer.ID]*peerdata.Ban {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	now := prysmTime.Now()
+	bans := make(map[peer.ID]*peerdata.Ban)
+	for pid, peerData := range p.store.Peers() {
+		if peerData.Ban != nil && !peerData.Ban.Expired(now) {
+			bans[pid] = peerData.Ban
+		}
+	}
+	return bans
+}
+
+// BannedSubnets returns the subnets with a ban in effect, ordered by subnet.
+func (p *Status) BannedSubnets() []*peerdata.SubnetBan {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	now := prysmTime.Now()
+	bans := make([]*peerdata.SubnetBan, 0, len(p.subnetBans))
+	for _, ban := range p.subnetBans {
+		if !ban.Ban.Expired(now) {
+			bans = append(bans, ban)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Subnet.String() < bans[j].Subnet.String()
+	})
+	return bans
+}
+
+// RecordScores samples the score of every connected peer into its score history.
+func (p *Status) RecordScores() {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	now := prysmTime.Now()
+	for pid, peerData := range p.store.Peers() {
+		if peerData.ConnState != PeerConnected {
+			continue
+		}
+		history := append(peerData.ScoreHistory, &peerdata.ScoreRecord{
+			Time:  now,
+			Score: p.scorers.ScoreNoLock(pid),
+		})
+		if len(history) > maxScoreHistory {
+			history = history[len(history)-maxScoreHistory:]
+		}
+		peerData.ScoreHistory = history
+	}
+}
+
+// Reputations returns the reputation of every peer which has one worth keeping, that is
+// every peer that was scored, responded badly or got banned.
+func (p *Status) Reputations() map[peer.ID]*peerdata.Reputation {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	reputations := make(map[peer.ID]*peerdata.Reputation)
+	for pid, peerData := range p.store.Peers() {
+		if peerData.BadResponses == 0 && len(peerData.ScoreHistory) == 0 && peerData.Ban == nil {
+			continue
+		}
+		reputations[pid] = &peerdata.Reputation{
+			BadResponses: peerData.BadResponses,
+			ScoreHistory: append([]*peerdata.ScoreRecord{}, peerData.ScoreHistory...),
+			Ban:          peerData.Ban,
+		}
+	}
+	return reputations
+}
+
+// SetReputation restores the reputation of a peer, such as the one it had before a restart.
+func (p *Status) SetReputation(pid peer.ID, reputation *peerdata.Reputation) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.BadResponses = reputation.BadResponses
+	peerData.ScoreHistory = reputation.ScoreHistory
+	peerData.Ban = reputation.Ban
+}
@@ -0,0 +1,105 @@
+package peers_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStatus_BanPeer(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	pid := peer.ID("banned")
+	assert.Equal(t, false, p.IsBanned(pid))
+
+	p.BanPeer(pid, &peerdata.Ban{Reason: "spam", Created: time.Now()})
+	assert.Equal(t, true, p.IsBanned(pid))
+	assert.Equal(t, true, p.IsBad(pid))
+	assert.Equal(t, 1, len(p.BannedPeers()))
+	assert.Equal(t, "spam", p.BannedPeers()[pid].Reason)
+
+	p.Scorers().BadResponsesScorer().Increment(pid)
+	assert.Equal(t, true, p.UnbanPeer(pid))
+	assert.Equal(t, false, p.UnbanPeer(pid))
+	assert.Equal(t, false, p.IsBanned(pid))
+	assert.Equal(t, 0, len(p.BannedPeers()))
+	count, err := p.Scorers().BadResponsesScorer().Count(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "Bad responses are not cleared on unban")
+
+	p.BanPeer(pid, &peerdata.Ban{Reason: "spam", Created: time.Now(), Expiry: time.Now().Add(-time.Second)})
+	assert.Equal(t, false, p.IsBanned(pid), "Expired ban is in effect")
+	assert.Equal(t, 0, len(p.BannedPeers()))
+}
+
+func TestStatus_BanSubnet(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	_, subnet, err := net.ParseCIDR("213.202.254.0/24")
+	require.NoError(t, err)
+	inside, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	outside, err := ma.NewMultiaddr("/ip4/52.23.23.253/tcp/13000")
+	require.NoError(t, err)
+	pid := peer.ID("inside")
+	p.Add(nil, pid, inside, network.DirInbound)
+
+	p.BanSubnet(&peerdata.SubnetBan{Subnet: subnet, Ban: &peerdata.Ban{Reason: "sybil", Created: time.Now()}})
+	assert.Equal(t, true, p.IsAddrBanned(inside))
+	assert.Equal(t, false, p.IsAddrBanned(outside))
+	assert.Equal(t, true, p.IsBanned(pid), "Peer in banned subnet is not banned")
+	require.Equal(t, 1, len(p.BannedSubnets()))
+	assert.Equal(t, "213.202.254.0/24", p.BannedSubnets()[0].Subnet.String())
+
+	assert.Equal(t, true, p.UnbanSubnet(subnet))
+	assert.Equal(t, false, p.UnbanSubnet(subnet))
+	assert.Equal(t, false, p.IsAddrBanned(inside))
+	assert.Equal(t, false, p.IsBanned(pid))
+}
+
+func TestStatus_Reputations(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	connected := peer.ID("connected")
+	disconnected := peer.ID("disconnected")
+	p.Add(nil, connected, nil, network.DirOutbound)
+	p.SetConnectionState(connected, peers.PeerConnected)
+	p.Add(nil, disconnected, nil, network.DirOutbound)
+	p.SetConnectionState(disconnected, peers.PeerDisconnected)
+
+	assert.Equal(t, 0, len(p.Reputations()), "Peers without history have a reputation")
+	for i := 0; i < 100; i++ {
+		p.RecordScores()
+	}
+	reputations := p.Reputations()
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, 64, len(reputations[connected].ScoreHistory), "Score history is not bounded")
+
+	restored := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	ban := &peerdata.Ban{Reason: "spam", Created: time.Now()}
+	restored.SetReputation(disconnected, &peerdata.Reputation{BadResponses: 2, Ban: ban})
+	assert.Equal(t, true, restored.IsBanned(disconnected))
+	count, err := restored.Scorers().BadResponsesScorer().Count(disconnected)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.DeepEqual(t, ban, restored.Reputations()[disconnected].Ban)
+}
@@ -78,11 +78,13 @@ const (
 
 // Status is the structure holding the peer status information.
 type Status struct {
-	ctx       context.Context
-	scorers   *scorers.Service
-	store     *peerdata.Store
-	ipTracker map[string]uint64
-	rand      *rand.Rand
+	ctx          context.Context
+	scorers      *scorers.Service
+	store        *peerdata.Store
+	ipTracker    map[string]uint64
+	subnetBans   map[string]*peerdata.SubnetBan
+	trustedPeers map[peer.ID]bool
+	rand         *rand.Rand
 }
 
 // StatusConfig represents peer status service params.
@@ -99,10 +101,12 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 		MaxPeers: maxLimitBuffer + config.PeerLimit,
 	})
 	return &Status{
-		ctx:       ctx,
-		store:     store,
-		scorers:   scorers.NewService(ctx, store, config.ScorerParams),
-		ipTracker: map[string]uint64{},
+		ctx:          ctx,
+		store:        store,
+		scorers:      scorers.NewService(ctx, store, config.ScorerParams),
+		ipTracker:    map[string]uint64{},
+		subnetBans:   map[string]*peerdata.SubnetBan{},
+		trustedPeers: map[peer.ID]bool{},
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
 		rand: rand.NewDeterministicGenerator(),
@@ -213,9 +217,9 @@ func (p *Status) IsAboveInboundLimit() bool {
 	p.store.RLock()
 	defer p.store.RUnlock()
 	totalInbound := 0
-	for _, peerData := range p.store.Peers() {
+	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.trustedPeers[pid] {
 			totalInbound += 1
 		}
 	}
@@ -325,7 +329,8 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 	return prysmTime.Now(), peerdata.ErrPeerUnknown
 }
 
-// IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
+// IsBad states if the peer is to be considered bad (by *any* of the registered scorers), or is banned.
+// Trusted peers are never considered bad.
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
 func (p *Status) IsBad(pid peer.ID) bool {
 	p.store.RLock()
@@ -335,6 +340,13 @@ func (p *Status) IsBad(pid peer.ID) bool {
 
 // isBad is the lock-free version of IsBad.
 func (p *Status) isBad(pid peer.ID) bool {
+	// A ban of the operator takes precedence over the trust of the peer.
+	if p.isBanned(pid) {
+		return true
+	}
+	if p.trustedPeers[pid] {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeerNoLock(pid)
 }
 
@@ -554,7 +566,7 @@ func (p *Status) Prune() {
 		return
 	}
 	notBadPeer := func(pid peer.ID) bool {
-		return !p.isBad(pid)
+		return !p.isBad(pid) && !p.trustedPeers[pid]
 	}
 	type peerResp struct {
 		pid   peer.ID
@@ -602,7 +614,8 @@ func (p *Status) deprecatedPrune() {
 	}
 
 	notBadPeer := func(peerData *peerdata.PeerData) bool {
-		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold
+		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold &&
+			(peerData.Ban == nil || peerData.Ban.Expired(prysmTime.Now()))
 	}
 	type peerResp struct {
 		pid     peer.ID
@@ -611,7 +624,7 @@ func (p *Status) deprecatedPrune() {
 	peersToPrune := make([]*peerResp, 0)
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && !p.trustedPeers[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -751,8 +764,9 @@ func (p *Status) PeersToPrune() []peer.ID {
 	}
 	connLimit := p.ConnectedPeerLimit()
 	inBoundLimit := uint64(p.InboundLimit())
-	activePeers := p.Active()
-	numInboundPeers := uint64(len(p.InboundConnected()))
+	// Trusted peers have reserved slots, they do not count against the limits.
+	activePeers := p.WithoutTrusted(p.Active())
+	numInboundPeers := uint64(len(p.WithoutTrusted(p.InboundConnected())))
 	// Exit early if we are still below our max
 	// limit.
 	if uint64(len(activePeers)) <= connLimit {
@@ -769,7 +783,7 @@ func (p *Status) PeersToPrune() []peer.ID {
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.trustedPeers[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:   pid,
 				score: p.scorers.ScoreNoLock(pid),
@@ -817,8 +831,9 @@ func (p *Status) PeersToPrune() []peer.ID {
 func (p *Status) deprecatedPeersToPrune() []peer.ID {
 	connLimit := p.ConnectedPeerLimit()
 	inBoundLimit := p.InboundLimit()
-	activePeers := p.Active()
-	numInboundPeers := len(p.InboundConnected())
+	// Trusted peers have reserved slots, they do not count against the limits.
+	activePeers := p.WithoutTrusted(p.Active())
+	numInboundPeers := len(p.WithoutTrusted(p.InboundConnected()))
 	// Exit early if we are still below our max
 	// limit.
 	if uint64(len(activePeers)) <= connLimit {
@@ -835,7 +850,7 @@ func (p *Status) deprecatedPeersToPrune() []peer.ID {
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.trustedPeers[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -0,0 +1,59 @@
+package peers
+
+import (
+	"sort"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// AddTrustedPeer marks the peer as trusted. Trusted peers are never deemed bad by the scorers,
+// never pruned, and do not count against the peer limits, so that connections to them are
+// always kept.
+func (p *Status) AddTrustedPeer(pid peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.trustedPeers[pid] = true
+}
+
+// RemoveTrustedPeer makes the peer a regular peer again.
+func (p *Status) RemoveTrustedPeer(pid peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	delete(p.trustedPeers, pid)
+}
+
+// IsTrustedPeer returns true if the peer is trusted.
+func (p *Status) IsTrustedPeer(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.trustedPeers[pid]
+}
+
+// TrustedPeers returns the trusted peers, ordered by peer ID.
+func (p *Status) TrustedPeers() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	pids := make([]peer.ID, 0, len(p.trustedPeers))
+	for pid := range p.trustedPeers {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		return pids[i] < pids[j]
+	})
+	return pids
+}
+
+// WithoutTrusted returns the given peers that are not trusted.
+func (p *Status) WithoutTrusted(pids []peer.ID) []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	untrusted := make([]peer.ID, 0, len(pids))
+	for _, pid := range pids {
+		if !p.trustedPeers[pid] {
+			untrusted = append(untrusted, pid)
+		}
+	}
+	return untrusted
+}
@@ -0,0 +1,102 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v3/config/features"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+)
+
+func TestStatus_TrustedPeer(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	pid := peer.ID("trusted")
+	assert.Equal(t, false, p.IsTrustedPeer(pid))
+
+	p.Scorers().BadResponsesScorer().Increment(pid)
+	assert.Equal(t, true, p.IsBad(pid))
+	p.AddTrustedPeer(pid)
+	assert.Equal(t, true, p.IsTrustedPeer(pid))
+	assert.Equal(t, false, p.IsBad(pid), "Trusted peer is bad")
+	assert.DeepEqual(t, []peer.ID{pid}, p.TrustedPeers())
+	assert.DeepEqual(t, []peer.ID{"other"}, p.WithoutTrusted([]peer.ID{"other", pid}))
+
+	p.BanPeer(pid, &peerdata.Ban{Reason: "spam", Created: time.Now()})
+	assert.Equal(t, true, p.IsBad(pid), "Banned trusted peer is not bad")
+	assert.Equal(t, true, p.UnbanPeer(pid))
+	assert.Equal(t, false, p.IsBad(pid), "Trusted peer is bad")
+	p.Scorers().BadResponsesScorer().Increment(pid)
+
+	p.RemoveTrustedPeer(pid)
+	assert.Equal(t, false, p.IsTrustedPeer(pid))
+	assert.Equal(t, true, p.IsBad(pid))
+	assert.Equal(t, 0, len(p.TrustedPeers()))
+}
+
+func TestStatus_TrustedPeersNotPruned(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	var trusted []peer.ID
+	for i := 0; i < p.MaxPeerLimit()+100; i++ {
+		pid := addPeer(t, p, peers.PeerDisconnected)
+		if i%10 == 0 {
+			p.AddTrustedPeer(pid)
+			trusted = append(trusted, pid)
+		}
+	}
+	p.Prune()
+	for _, pid := range trusted {
+		_, err := p.ConnectionState(pid)
+		assert.NoError(t, err, "Trusted peer was pruned")
+	}
+}
+
+func TestStatus_TrustedPeersReservedSlots(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		resetCfg := features.InitWithReset(&features.Flags{
+			EnablePeerScorer: enabled,
+		})
+		p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		})
+		for i := 0; i < 15; i++ {
+			createPeer(t, p, nil, network.DirOutbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		}
+		var trusted []peer.ID
+		for i := 0; i < 18; i++ {
+			pid := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+			p.AddTrustedPeer(pid)
+			trusted = append(trusted, pid)
+		}
+		// Trusted peers do not count against the limits.
+		assert.Equal(t, false, p.IsAboveInboundLimit())
+		assert.Equal(t, 0, len(p.PeersToPrune()))
+
+		for i := 0; i < 18; i++ {
+			createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		}
+		peersToPrune := p.PeersToPrune()
+		assert.Equal(t, 3, len(peersToPrune))
+		for _, pid := range peersToPrune {
+			assert.Equal(t, false, p.IsTrustedPeer(pid), "Trusted peer selected for pruning")
+		}
+		resetCfg()
+	}
+}
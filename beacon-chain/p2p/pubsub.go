@@ -146,6 +146,9 @@ func (s *Service) pubsubOptions() []pubsub.Option {
 		pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute),
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
 	}
+	if s.gossipTracer != nil {
+		psOpts = append(psOpts, pubsub.WithRawTracer(s.gossipTracer))
+	}
 	return psOpts
 }
 
@@ -0,0 +1,216 @@
+package p2p
+
+import (
+	"net"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+	"github.com/sirupsen/logrus"
+)
+
+// reputationPersistPeriod is how often peer scores are sampled and peer reputations are saved.
+var reputationPersistPeriod = 5 * time.Minute
+
+// reputationRetention is how long the reputation of a peer that is neither banned nor penalized
+// is kept after the peer was last scored.
+const reputationRetention = 7 * 24 * time.Hour
+
+// loadReputations restores the peer reputations and the subnet bans saved in the database,
+// so that misbehaving peers do not get a clean slate when the node restarts.
+func (s *Service) loadReputations() error {
+	if s.cfg.DB == nil {
+		return nil
+	}
+	reputations, err := s.cfg.DB.PeerReputations(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not load peer reputations")
+	}
+	now := prysmTime.Now()
+	loaded := 0
+	for pid, reputation := range reputations {
+		if isStaleReputation(reputation, now) {
+			continue
+		}
+		s.peers.SetReputation(pid, reputation)
+		loaded++
+	}
+	bans, err := s.cfg.DB.SubnetBans(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not load subnet bans")
+	}
+	for _, ban := range bans {
+		if ban.Ban.Expired(now) {
+			if err := s.cfg.DB.DeleteSubnetBan(s.ctx, ban.Subnet); err != nil {
+				return errors.Wrapf(err, "could not delete expired ban of subnet %s", ban.Subnet)
+			}
+			continue
+		}
+		s.peers.BanSubnet(ban)
+	}
+	log.WithFields(logrus.Fields{
+		"peers":         loaded,
+		"bannedPeers":   len(s.peers.BannedPeers()),
+		"bannedSubnets": len(s.peers.BannedSubnets()),
+	}).Info("Loaded peer reputations")
+	return nil
+}
+
+// persistReputations samples the score of the connected peers and saves the peer reputations.
+func (s *Service) persistReputations() {
+	s.peers.RecordScores()
+	if s.cfg.DB == nil {
+		return
+	}
+	if err := s.cfg.DB.SavePeerReputations(s.ctx, s.peers.Reputations()); err != nil {
+		log.WithError(err).Error("Could not save peer reputations")
+	}
+}
+
+// isStaleReputation returns true if the reputation holds no ban in effect nor any penalty,
+// and the peer was not scored within the retention period.
+func isStaleReputation(reputation *peerdata.Reputation, now time.Time) bool {
+	if reputation.Ban != nil && !reputation.Ban.Expired(now) {
+		return false
+	}
+	if reputation.BadResponses > 0 {
+		return false
+	}
+	if len(reputation.ScoreHistory) == 0 {
+		return true
+	}
+	return now.Sub(reputation.ScoreHistory[len(reputation.ScoreHistory)-1].Time) > reputationRetention
+}
+
+// BanPeer denies connections to and from the peer for the given duration, or permanently if the
+// duration is zero, and disconnects from it. The ban is saved so that it survives restarts.
+func (s *Service) BanPeer(pid peer.ID, reason string, duration time.Duration) error {
+	ban := newBan(reason, duration)
+	s.peers.BanPeer(pid, ban)
+	if s.cfg.DB != nil {
+		if err := s.cfg.DB.SavePeerReputations(s.ctx, s.peers.Reputations()); err != nil {
+			return errors.Wrap(err, "could not save peer reputations")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"peer":   pid,
+		"reason": reason,
+		"expiry": ban.Expiry,
+	}).Info("Banned peer")
+	return s.Disconnect(pid)
+}
+
+// UnbanPeer lifts the ban of the peer. Returns false if the peer was not banned.
+func (s *Service) UnbanPeer(pid peer.ID) (bool, error) {
+	if !s.peers.UnbanPeer(pid) {
+		return false, nil
+	}
+	if s.cfg.DB != nil {
+		if err := s.cfg.DB.SavePeerReputations(s.ctx, s.peers.Reputations()); err != nil {
+			return true, errors.Wrap(err, "could not save peer reputations")
+		}
+	}
+	log.WithField("peer", pid).Info("Unbanned peer")
+	return true, nil
+}
+
+// BanSubnet denies connections to and from every address of the IP subnet for the given duration,
+// or permanently if the duration is zero, and disconnects from the peers connected from it.
+// The ban is saved so that it survives restarts.
+func (s *Service) BanSubnet(subnet *net.IPNet, reason string, duration time.Duration) error {
+	ban := &peerdata.SubnetBan{Subnet: subnet, Ban: newBan(reason, duration)}
+	s.peers.BanSubnet(ban)
+	if s.cfg.DB != nil {
+		if err := s.cfg.DB.SaveSubnetBan(s.ctx, ban); err != nil {
+			return errors.Wrap(err, "could not save subnet ban")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"subnet": subnet,
+		"reason": reason,
+		"expiry": ban.Ban.Expiry,
+	}).Info("Banned subnet")
+	for _, conn := range s.host.Network().Conns() {
+		ip, err := manet.ToIP(conn.RemoteMultiaddr())
+		if err != nil || !subnet.Contains(ip) {
+			continue
+		}
+		if err := s.Disconnect(conn.RemotePeer()); err != nil {
+			log.WithError(err).WithField("peer", conn.RemotePeer()).Debug("Could not disconnect from banned peer")
+		}
+	}
+	return nil
+}
+
+// UnbanSubnet lifts the ban of the IP subnet. Returns false if the subnet was not banned.
+func (s *Service) UnbanSubnet(subnet *net.IPNet) (bool, error) {
+	if !s.peers.UnbanSubnet(subnet) {
+		return false, nil
+	}
+	if s.cfg.DB != nil {
+		if err := s.cfg.DB.DeleteSubnetBan(s.ctx, subnet); err != nil {
+			return true, errors.Wrap(err, "could not delete subnet ban")
+		}
+	}
+	log.WithField("subnet", subnet).Info("Unbanned subnet")
+	return true, nil
+}
+
+// BannedPeers returns the peer bans in effect, as restored from and saved to the database.
+func (s *Service) BannedPeers() map[peer.ID]*peerdata.Ban {
+	return s.peers.BannedPeers()
+}
+
+// BannedSubnets returns the subnet bans in effect, as restored from and saved to the database.
+func (s *Service) BannedSubnets() []*peerdata.SubnetBan {
+	return s.peers.BannedSubnets()
+}
+
+// ParseBanTarget parses the target of a ban, which is either a peer or an IP subnet. A single
+// IP address is the subnet made of that address only.
+func ParseBanTarget(peerID, ip, subnet string) (peer.ID, *net.IPNet, error) {
+	set := 0
+	for _, v := range []string{peerID, ip, subnet} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return "", nil, errors.New("exactly one of peer_id, ip and subnet must be provided")
+	}
+	switch {
+	case peerID != "":
+		pid, err := peer.Decode(peerID)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "invalid peer ID %s", peerID)
+		}
+		return pid, nil, nil
+	case ip != "":
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			return "", nil, errors.Errorf("invalid IP address %s", ip)
+		}
+		if v4 := parsed.To4(); v4 != nil {
+			return "", &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return "", &net.IPNet{IP: parsed, Mask: net.CIDRMask(128, 128)}, nil
+	default:
+		_, ipNet, err := net.ParseCIDR(subnet)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "invalid subnet %s", subnet)
+		}
+		return "", ipNet, nil
+	}
+}
+
+func newBan(reason string, duration time.Duration) *peerdata.Ban {
+	now := prysmTime.Now()
+	ban := &peerdata.Ban{Reason: reason, Created: now}
+	if duration > 0 {
+		ban.Expiry = now.Add(duration)
+	}
+	return ban
+}
@@ -0,0 +1,73 @@
+package p2p
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+)
+
+type scoreRecordJson struct {
+	Time  time.Time `json:"time"`
+	Score float64   `json:"score"`
+}
+
+type peerReputationJson struct {
+	PeerID       string             `json:"peer_id"`
+	Address      string             `json:"address,omitempty"`
+	State        string             `json:"state"`
+	Score        float64            `json:"score"`
+	BadResponses int                `json:"bad_responses"`
+	ScoreHistory []*scoreRecordJson `json:"score_history"`
+	Banned       bool               `json:"banned"`
+}
+
+// PeersHandler is a handler to serve the /p2p/peers page in metrics, listing the known peers
+// along with their score, score history and whether they are banned. Bans are listed and managed
+// through the node endpoints of the beacon API.
+func (s *Service) PeersHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	reputations := s.peers.Reputations()
+	pids := s.peers.All()
+	sort.Slice(pids, func(i, j int) bool {
+		return pids[i] < pids[j]
+	})
+	resp := make([]*peerReputationJson, 0, len(pids))
+	for _, pid := range pids {
+		item := &peerReputationJson{
+			PeerID:       pid.String(),
+			Score:        s.peers.Scorers().Score(pid),
+			ScoreHistory: make([]*scoreRecordJson, 0),
+		}
+		if addr, err := s.peers.Address(pid); err == nil && addr != nil {
+			item.Address = addr.String()
+		}
+		if state, err := s.peers.ConnectionState(pid); err == nil {
+			item.State = ethpb.ConnectionState(state).String()
+		}
+		if reputation, ok := reputations[pid]; ok {
+			item.BadResponses = reputation.BadResponses
+			for _, record := range reputation.ScoreHistory {
+				item.ScoreHistory = append(item.ScoreHistory, &scoreRecordJson{Time: record.Time, Score: record.Score})
+			}
+			if reputation.Ban != nil && !reputation.Ban.Expired(prysmTime.Now()) {
+				item.Banned = true
+			}
+		}
+		resp = append(resp, item)
+	}
+	writeJson(w, resp)
+}
+
+func writeJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("Failed to render p2p reputation page")
+	}
+}
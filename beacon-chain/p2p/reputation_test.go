@@ -0,0 +1,140 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	dbutil "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/scorers"
+	mockp2p "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestIsStaleReputation(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		reputation *peerdata.Reputation
+		want       bool
+	}{
+		{
+			name:       "empty",
+			reputation: &peerdata.Reputation{},
+			want:       true,
+		},
+		{
+			name: "recently scored",
+			reputation: &peerdata.Reputation{
+				ScoreHistory: []*peerdata.ScoreRecord{{Time: now.Add(-time.Hour)}},
+			},
+			want: false,
+		},
+		{
+			name: "scored long ago",
+			reputation: &peerdata.Reputation{
+				ScoreHistory: []*peerdata.ScoreRecord{{Time: now.Add(-reputationRetention - time.Hour)}},
+			},
+			want: true,
+		},
+		{
+			name: "penalized",
+			reputation: &peerdata.Reputation{
+				BadResponses: 1,
+				ScoreHistory: []*peerdata.ScoreRecord{{Time: now.Add(-reputationRetention - time.Hour)}},
+			},
+			want: false,
+		},
+		{
+			name:       "banned",
+			reputation: &peerdata.Reputation{Ban: &peerdata.Ban{Created: now.Add(-reputationRetention - time.Hour)}},
+			want:       false,
+		},
+		{
+			name:       "ban expired",
+			reputation: &peerdata.Reputation{Ban: &peerdata.Ban{Expiry: now.Add(-time.Hour)}},
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isStaleReputation(tt.reputation, now))
+		})
+	}
+}
+
+func TestService_BansPersistence(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	newService := func() *Service {
+		return &Service{
+			ctx:  context.Background(),
+			cfg:  &Config{DB: db},
+			host: mockp2p.NewTestP2P(t).BHost,
+			peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+				PeerLimit:    30,
+				ScorerParams: &scorers.Config{},
+			}),
+		}
+	}
+	s := newService()
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	require.NoError(t, s.BanPeer(pid, "spam", 0))
+	_, subnet, err := net.ParseCIDR("212.67.10.0/24")
+	require.NoError(t, err)
+	require.NoError(t, s.BanSubnet(subnet, "sybil", time.Hour))
+
+	// The bans survive a restart.
+	restarted := newService()
+	require.NoError(t, restarted.loadReputations())
+	bannedPeers := restarted.peers.BannedPeers()
+	require.Equal(t, 1, len(bannedPeers))
+	require.NotNil(t, bannedPeers[pid])
+	assert.Equal(t, "spam", bannedPeers[pid].Reason)
+	assert.Equal(t, true, bannedPeers[pid].Expiry.IsZero())
+	bannedSubnets := restarted.peers.BannedSubnets()
+	require.Equal(t, 1, len(bannedSubnets))
+	assert.Equal(t, "212.67.10.0/24", bannedSubnets[0].Subnet.String())
+	assert.Equal(t, false, bannedSubnets[0].Ban.Expiry.IsZero())
+
+	found, err := restarted.UnbanPeer(pid)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	found, err = restarted.UnbanSubnet(subnet)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+
+	restarted = newService()
+	require.NoError(t, restarted.loadReputations())
+	assert.Equal(t, 0, len(restarted.peers.BannedPeers()))
+	assert.Equal(t, 0, len(restarted.peers.BannedSubnets()))
+}
+
+func TestParseBanTarget(t *testing.T) {
+	pid, subnet, err := ParseBanTarget("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", pid.String())
+	assert.Equal(t, (*net.IPNet)(nil), subnet)
+
+	_, subnet, err = ParseBanTarget("", "212.67.11.1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "212.67.11.1/32", subnet.String())
+
+	_, subnet, err = ParseBanTarget("", "", "212.67.10.0/24")
+	require.NoError(t, err)
+	assert.Equal(t, "212.67.10.0/24", subnet.String())
+
+	_, _, err = ParseBanTarget("", "", "")
+	assert.ErrorContains(t, "exactly one of peer_id, ip and subnet must be provided", err)
+	_, _, err = ParseBanTarget("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", "212.67.11.1", "")
+	assert.ErrorContains(t, "exactly one of peer_id, ip and subnet must be provided", err)
+	_, _, err = ParseBanTarget("", "", "212.67.10.0/33")
+	assert.ErrorContains(t, "invalid subnet", err)
+	_, _, err = ParseBanTarget("", "not an ip", "")
+	assert.ErrorContains(t, "invalid IP address", err)
+}
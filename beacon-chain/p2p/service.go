@@ -84,6 +84,9 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	trustedPeers          map[peer.ID]*trustedPeer
+	trustedPeersLock      sync.RWMutex
+	gossipTracer          *gossipTracer
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -101,6 +104,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis:  true,
 		joinedTopics:  make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
+		trustedPeers:  make(map[peer.ID]*trustedPeer),
 	}
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -134,6 +138,15 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 
 	s.host = h
 	s.host.RemoveStreamHandler(identify.IDDelta)
+	if s.cfg.GossipTraceDir != "" {
+		s.gossipTracer, err = newGossipTracer(s.cfg)
+		if err != nil {
+			log.WithError(err).Error("Failed to create gossip tracer")
+			return nil, err
+		}
+		go s.gossipTracer.run(s.ctx)
+		log.WithField("dir", s.cfg.GossipTraceDir).Info("Tracing gossip messages")
+	}
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -162,6 +175,13 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		},
 	})
 
+	for _, addr := range s.cfg.TrustedPeers {
+		if _, err := s.AddTrustedPeer(addr); err != nil {
+			log.WithError(err).Error("Failed to add trusted peer")
+			return nil, err
+		}
+	}
+
 	// Initialize Data maps.
 	types.InitializeDataMaps()
 
@@ -175,6 +195,12 @@ func (s *Service) Start() {
 		return
 	}
 
+	// Restore peer reputations before any connection is made, so that
+	// banned and misbehaving peers are turned away from the start.
+	if err := s.loadReputations(); err != nil {
+		log.WithError(err).Error("Could not load peer reputations")
+	}
+
 	// Waits until the state is initialized via an event feed.
 	// Used for fork-related data when connecting peers.
 	s.awaitStateInitialized()
@@ -230,7 +256,9 @@ func (s *Service) Start() {
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
+	async.RunEvery(s.ctx, trustedPeerRedialPeriod, s.redialTrustedPeers)
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	async.RunEvery(s.ctx, reputationPersistPeriod, s.persistReputations)
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, s.RefreshENR)
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
@@ -262,6 +290,12 @@ func (s *Service) Start() {
 // Stop the p2p service and terminate all peer connections.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if s.started {
+		s.persistReputations()
+	}
+	if s.gossipTracer != nil {
+		s.gossipTracer.stop()
+	}
 	s.started = false
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
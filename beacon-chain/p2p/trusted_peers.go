@@ -0,0 +1,218 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+)
+
+// trustedPeerRedialPeriod is how often the connections to the trusted peers are checked.
+var trustedPeerRedialPeriod = 5 * time.Second
+
+const (
+	// trustedPeerMinBackoff is the delay before redialing a trusted peer after a first failed dial.
+	trustedPeerMinBackoff = 5 * time.Second
+	// trustedPeerMaxBackoff is the longest delay between two dials of a trusted peer.
+	trustedPeerMaxBackoff = 5 * time.Minute
+	// trustedPeerTag is the connection manager tag protecting the connections to trusted peers.
+	trustedPeerTag = "trusted"
+)
+
+// trustedPeer tracks the dials of a trusted peer.
+type trustedPeer struct {
+	info     peer.AddrInfo
+	backoff  time.Duration
+	nextDial time.Time
+}
+
+// AddTrustedPeer adds the peer with the given multiaddr, which must include the peer ID, to the
+// trusted peers. Trusted peers are redialed whenever they are disconnected, are never pruned nor
+// rate limited, and do not count against the peer limits.
+func (s *Service) AddTrustedPeer(addr string) (peer.ID, error) {
+	info, err := MakePeer(addr)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid trusted peer address %s", addr)
+	}
+	if info.ID == s.host.ID() {
+		return "", errors.New("cannot trust the local peer")
+	}
+	s.trustedPeersLock.Lock()
+	if s.trustedPeers == nil {
+		s.trustedPeers = make(map[peer.ID]*trustedPeer)
+	}
+	s.trustedPeers[info.ID] = &trustedPeer{info: *info}
+	s.trustedPeersLock.Unlock()
+
+	s.peers.AddTrustedPeer(info.ID)
+	s.host.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.PermanentAddrTTL)
+	s.host.ConnManager().Protect(info.ID, trustedPeerTag)
+	log.WithField("peer", info.String()).Info("Added trusted peer")
+	return info.ID, nil
+}
+
+// RemoveTrustedPeer makes the trusted peer a regular peer again. The connection to the peer, if any,
+// is kept. Returns false if the peer was not trusted.
+func (s *Service) RemoveTrustedPeer(pid peer.ID) bool {
+	s.trustedPeersLock.Lock()
+	_, ok := s.trustedPeers[pid]
+	delete(s.trustedPeers, pid)
+	s.trustedPeersLock.Unlock()
+	if !ok {
+		return false
+	}
+
+	s.peers.RemoveTrustedPeer(pid)
+	s.host.Peerstore().UpdateAddrs(pid, peerstore.PermanentAddrTTL, peerstore.AddressTTL)
+	s.host.ConnManager().Unprotect(pid, trustedPeerTag)
+	log.WithField("peer", pid).Info("Removed trusted peer")
+	return true
+}
+
+// TrustedPeers returns the address info of the trusted peers, ordered by peer ID.
+func (s *Service) TrustedPeers() []peer.AddrInfo {
+	s.trustedPeersLock.RLock()
+	defer s.trustedPeersLock.RUnlock()
+
+	infos := make([]peer.AddrInfo, 0, len(s.trustedPeers))
+	for _, tp := range s.trustedPeers {
+		infos = append(infos, tp.info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+// isTrustedAddr returns true if the multiaddr has the IP address of a trusted peer. It is used to
+// reserve inbound slots for the trusted peers, whose identity is not known yet when they dial in.
+// The identity is checked with isTrustedPeerAddr once the connection is secured.
+func (s *Service) isTrustedAddr(addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	s.trustedPeersLock.RLock()
+	defer s.trustedPeersLock.RUnlock()
+	for _, tp := range s.trustedPeers {
+		if hasIP(tp.info.Addrs, ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTrustedPeerAddr returns true if the peer is a trusted peer and the multiaddr has one of the
+// IP addresses of that peer.
+func (s *Service) isTrustedPeerAddr(pid peer.ID, addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	s.trustedPeersLock.RLock()
+	defer s.trustedPeersLock.RUnlock()
+	tp, ok := s.trustedPeers[pid]
+	return ok && hasIP(tp.info.Addrs, ip)
+}
+
+func hasIP(addrs []multiaddr.Multiaddr, ip net.IP) bool {
+	for _, addr := range addrs {
+		addrIP, err := manet.ToIP(addr)
+		if err == nil && addrIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// redialTrustedPeers dials the trusted peers that are not connected. Each failed dial doubles the
+// delay before the next one, up to trustedPeerMaxBackoff.
+func (s *Service) redialTrustedPeers() {
+	now := prysmTime.Now()
+	var toDial []peer.AddrInfo
+	connected := 0
+
+	s.trustedPeersLock.Lock()
+	for pid, tp := range s.trustedPeers {
+		if s.host.Network().Connectedness(pid) == network.Connected {
+			connected++
+			tp.backoff = 0
+			continue
+		}
+		if now.Before(tp.nextDial) {
+			continue
+		}
+		tp.backoff = nextTrustedPeerBackoff(tp.backoff)
+		tp.nextDial = now.Add(tp.backoff)
+		toDial = append(toDial, tp.info)
+	}
+	total := len(s.trustedPeers)
+	s.trustedPeersLock.Unlock()
+
+	trustedPeersCount.Set(float64(total))
+	trustedPeersConnectedCount.Set(float64(connected))
+	for _, info := range toDial {
+		go s.dialTrustedPeer(info)
+	}
+}
+
+func (s *Service) dialTrustedPeer(info peer.AddrInfo) {
+	trustedPeerDialAttempts.Inc()
+	ctx, cancel := context.WithTimeout(s.ctx, maxDialTimeout)
+	defer cancel()
+	if err := s.host.Connect(ctx, info); err != nil {
+		trustedPeerDialFailures.Inc()
+		log.WithError(err).WithField("peer", info.ID).Debug("Could not connect with trusted peer")
+		return
+	}
+	log.WithField("peer", info.ID).Debug("Connected with trusted peer")
+}
+
+func nextTrustedPeerBackoff(backoff time.Duration) time.Duration {
+	if backoff < trustedPeerMinBackoff {
+		return trustedPeerMinBackoff
+	}
+	backoff *= 2
+	if backoff > trustedPeerMaxBackoff {
+		return trustedPeerMaxBackoff
+	}
+	return backoff
+}
+
+type trustedPeerJson struct {
+	PeerID    string   `json:"peer_id"`
+	Addresses []string `json:"addresses"`
+	Connected bool     `json:"connected"`
+}
+
+// TrustedPeersHandler is a handler to serve the /p2p/trusted page in metrics, listing the trusted
+// peers. Trusted peers are managed through the node endpoints of the beacon API.
+func (s *Service) TrustedPeersHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	infos := s.TrustedPeers()
+	resp := make([]*trustedPeerJson, 0, len(infos))
+	for _, info := range infos {
+		item := &trustedPeerJson{
+			PeerID:    info.ID.String(),
+			Addresses: make([]string, 0, len(info.Addrs)),
+			Connected: s.host.Network().Connectedness(info.ID) == network.Connected,
+		}
+		for _, addr := range info.Addrs {
+			item.Addresses = append(item.Addresses, addr.String())
+		}
+		resp = append(resp, item)
+	}
+	writeJson(w, resp)
+}
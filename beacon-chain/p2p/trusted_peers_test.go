@@ -0,0 +1,93 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/scorers"
+	mockp2p "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestNextTrustedPeerBackoff(t *testing.T) {
+	backoff := time.Duration(0)
+	for _, want := range []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second} {
+		backoff = nextTrustedPeerBackoff(backoff)
+		assert.Equal(t, want, backoff)
+	}
+	assert.Equal(t, trustedPeerMaxBackoff, nextTrustedPeerBackoff(4*time.Minute))
+	assert.Equal(t, trustedPeerMaxBackoff, nextTrustedPeerBackoff(trustedPeerMaxBackoff))
+}
+
+func TestService_TrustedPeersHandler(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	s := &Service{
+		ctx:  context.Background(),
+		cfg:  &Config{},
+		host: p1.BHost,
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+		trustedPeers: make(map[peer.ID]*trustedPeer),
+	}
+	addr := fmt.Sprintf("%s/p2p/%s", p2.BHost.Addrs()[0], p2.PeerID())
+
+	for _, method := range []string{http.MethodPost, http.MethodDelete} {
+		rec := httptest.NewRecorder()
+		s.TrustedPeersHandler(rec, httptest.NewRequest(method, "/p2p/trusted", bytes.NewBufferString(fmt.Sprintf(`{"addr":"%s"}`, addr))))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code, method)
+	}
+	_, err := s.AddTrustedPeer("/ip4/127.0.0.1/tcp/3000")
+	assert.ErrorContains(t, "invalid trusted peer address", err)
+	pid, err := s.AddTrustedPeer(addr)
+	require.NoError(t, err)
+	assert.Equal(t, p2.PeerID(), pid)
+	assert.Equal(t, true, s.peers.IsTrustedPeer(p2.PeerID()))
+
+	// The trusted peer is dialed, and redialed once disconnected.
+	for i := 0; i < 2; i++ {
+		s.redialTrustedPeers()
+		require.NoError(t, waitForConnectedness(s, p2, network.Connected))
+		require.NoError(t, s.Disconnect(p2.PeerID()))
+		s.trustedPeers[p2.PeerID()].nextDial = time.Time{}
+	}
+	s.redialTrustedPeers()
+	require.NoError(t, waitForConnectedness(s, p2, network.Connected))
+
+	rec := httptest.NewRecorder()
+	s.TrustedPeersHandler(rec, httptest.NewRequest(http.MethodGet, "/p2p/trusted", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp []*trustedPeerJson
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Equal(t, 1, len(resp))
+	assert.Equal(t, p2.PeerID().String(), resp[0].PeerID)
+	assert.DeepEqual(t, []string{p2.BHost.Addrs()[0].String()}, resp[0].Addresses)
+	assert.Equal(t, true, resp[0].Connected)
+
+	assert.Equal(t, true, s.RemoveTrustedPeer(p2.PeerID()))
+	assert.Equal(t, false, s.RemoveTrustedPeer(p2.PeerID()))
+	assert.Equal(t, false, s.peers.IsTrustedPeer(p2.PeerID()))
+	assert.Equal(t, 0, len(s.TrustedPeers()))
+}
+
+func waitForConnectedness(s *Service, p *mockp2p.TestP2P, want network.Connectedness) error {
+	for i := 0; i < 100; i++ {
+		if s.host.Network().Connectedness(p.PeerID()) == want {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Errorf("peer %s is not %s", p.PeerID(), want)
+}
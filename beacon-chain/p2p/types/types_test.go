@@ -42,6 +42,20 @@ func TestErrorResponse_Limit(t *testing.T) {
 func TestRoundTripSerialization(t *testing.T) {
 	roundTripTestBlocksByRootReq(t)
 	roundTripTestErrorMessage(t)
+	roundTripTestLightClientBootstrapReq(t)
+}
+
+func roundTripTestLightClientBootstrapReq(t *testing.T) {
+	req := LightClientBootstrapReq{'a', 'b', 'c'}
+
+	marshalledObj, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, 32, len(marshalledObj))
+	newVal := LightClientBootstrapReq{}
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.DeepEqual(t, req, newVal)
+	assert.ErrorContains(t, "incorrect byte size", newVal.UnmarshalSSZ(marshalledObj[1:]))
 }
 
 func roundTripTestBlocksByRootReq(t *testing.T) {
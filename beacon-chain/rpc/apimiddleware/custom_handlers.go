@@ -416,6 +416,8 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &EventChainReorgJson{}
 			case events.SyncCommitteeContributionTopic:
 				data = &SignedContributionAndProofJson{}
+			case events.DetectedSlashingTopic:
+				data = &EventDetectedSlashingJson{}
 			case "error":
 				data = &EventErrorJson{}
 			default:
@@ -374,6 +374,34 @@ data: {"aggregation_bits":"0x666f6f","data":{"slot":"1","index":"1","beacon_bloc
 	assert.DeepEqual(t, expectedEvent, w.Body.String())
 }
 
+func TestReceiveEvents_DetectedSlashing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *sse.Event)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
+	req = req.WithContext(ctx)
+
+	go func() {
+		msg := &sse.Event{
+			Data:  []byte(`{"attester_slashing":null,"proposer_slashing":null,"validator_indices":["1"],"status":"INCLUDED"}`),
+			Event: []byte(events.DetectedSlashingTopic),
+		}
+		ch <- msg
+		time.Sleep(time.Second)
+		cancel()
+	}()
+
+	errJson := receiveEvents(ch, w, req)
+	assert.Equal(t, true, errJson == nil)
+
+	expectedEvent := `event: detected_slashing
+data: {"attester_slashing":null,"proposer_slashing":null,"validator_indices":["1"],"status":"included"}
+
+`
+	assert.DeepEqual(t, expectedEvent, w.Body.String())
+}
+
 func TestReceiveEvents_EventNotSupported(t *testing.T) {
 	ch := make(chan *sse.Event)
 	w := httptest.NewRecorder()
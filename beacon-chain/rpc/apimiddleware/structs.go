@@ -667,6 +667,14 @@ type FeeRecipientJson struct {
 	FeeRecipient   string `json:"fee_recipient" hex:"true"`
 }
 
+// FeeRecipientWithRelaysJson is a fee recipient together with the builder relays chosen by the validator.
+// It is only understood by Prysm beacon nodes.
+type FeeRecipientWithRelaysJson struct {
+	ValidatorIndex string   `json:"validator_index"`
+	FeeRecipient   string   `json:"fee_recipient" hex:"true"`
+	Relays         []string `json:"relays"`
+}
+
 type AttestationJson struct {
 	AggregationBits string               `json:"aggregation_bits" hex:"true"`
 	Data            *AttestationDataJson `json:"data"`
@@ -1150,6 +1158,13 @@ type EventChainReorgJson struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type EventDetectedSlashingJson struct {
+	AttesterSlashing *AttesterSlashingJson `json:"attester_slashing"`
+	ProposerSlashing *ProposerSlashingJson `json:"proposer_slashing"`
+	ValidatorIndices []string              `json:"validator_indices"`
+	Status           string                `json:"status" enum:"true"`
+}
+
 // ---------------
 // Error handling.
 // ---------------
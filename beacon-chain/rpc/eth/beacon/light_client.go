@@ -0,0 +1,266 @@
+package beacon
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	lightclient "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// maxLightClientUpdates is the maximum number of light client updates returned by a single request.
+const maxLightClientUpdates = 128
+
+// LightClientBootstrapResponse is the response of the light client bootstrap endpoint.
+type LightClientBootstrapResponse struct {
+	Version string                `json:"version"`
+	Data    *LightClientBootstrap `json:"data"`
+}
+
+// LightClientBootstrap is the JSON representation of a light client bootstrap.
+type LightClientBootstrap struct {
+	Header                     *apimiddleware.BeaconBlockHeaderJson `json:"header"`
+	CurrentSyncCommittee       *apimiddleware.SyncCommitteeJson     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string                             `json:"current_sync_committee_branch"`
+}
+
+// LightClientUpdateWithVersion is a light client update along with the fork version of its attested header.
+type LightClientUpdateWithVersion struct {
+	Version string             `json:"version"`
+	Data    *LightClientUpdate `json:"data"`
+}
+
+// LightClientUpdate is the JSON representation of a light client update.
+type LightClientUpdate struct {
+	AttestedHeader          *apimiddleware.BeaconBlockHeaderJson `json:"attested_header"`
+	NextSyncCommittee       *apimiddleware.SyncCommitteeJson     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string                             `json:"next_sync_committee_branch"`
+	FinalizedHeader         *apimiddleware.BeaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch          []string                             `json:"finality_branch"`
+	SyncAggregate           *apimiddleware.SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot           string                               `json:"signature_slot"`
+}
+
+// LightClientFinalityUpdateResponse is the response of the light client finality update endpoint.
+type LightClientFinalityUpdateResponse struct {
+	Version string                     `json:"version"`
+	Data    *LightClientFinalityUpdate `json:"data"`
+}
+
+// LightClientFinalityUpdate is the JSON representation of a light client finality update.
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *apimiddleware.BeaconBlockHeaderJson `json:"attested_header"`
+	FinalizedHeader *apimiddleware.BeaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch  []string                             `json:"finality_branch"`
+	SyncAggregate   *apimiddleware.SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot   string                               `json:"signature_slot"`
+}
+
+// LightClientOptimisticUpdateResponse is the response of the light client optimistic update endpoint.
+type LightClientOptimisticUpdateResponse struct {
+	Version string                       `json:"version"`
+	Data    *LightClientOptimisticUpdate `json:"data"`
+}
+
+// LightClientOptimisticUpdate is the JSON representation of a light client optimistic update.
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *apimiddleware.BeaconBlockHeaderJson `json:"attested_header"`
+	SyncAggregate  *apimiddleware.SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot  string                               `json:"signature_slot"`
+}
+
+// LightClientBootstrap is an HTTP handler for the Beacon API `getLightClientBootstrap` endpoint.
+func (bs *Server) LightClientBootstrap(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.LightClientBootstrap")
+	defer span.End()
+
+	rawRoot := mux.Vars(r)["block_root"]
+	root, err := hexutil.Decode(rawRoot)
+	if err != nil || len(root) != 32 {
+		writeRewardsError(w, http.StatusBadRequest, fmt.Sprintf("Invalid block root %s", rawRoot))
+		return
+	}
+	blk, err := bs.BeaconDB.Block(ctx, bytesutil.ToBytes32(root))
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get block").Error())
+		return
+	}
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		writeRewardsError(w, http.StatusNotFound, fmt.Sprintf("Could not find requested block: %v", err))
+		return
+	}
+	if blk.Version() == version.Phase0 {
+		writeRewardsError(w, http.StatusBadRequest, "Light client bootstraps are not supported for Phase 0 blocks")
+		return
+	}
+	st, err := bs.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(root))
+	if err != nil {
+		writeRewardsError(w, http.StatusNotFound, errors.Wrap(err, "could not get state").Error())
+		return
+	}
+	bootstrap, err := lightclient.NewLightClientBootstrap(ctx, st, blk)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not create light client bootstrap").Error())
+		return
+	}
+
+	writeRewardsJson(w, &LightClientBootstrapResponse{
+		Version: lightClientVersion(bootstrap.Header.Slot),
+		Data: &LightClientBootstrap{
+			Header:                     lightClientHeaderJson(bootstrap.Header),
+			CurrentSyncCommittee:       lightClientSyncCommitteeJson(bootstrap.CurrentSyncCommittee),
+			CurrentSyncCommitteeBranch: lightClientBranchJson(bootstrap.CurrentSyncCommitteeBranch),
+		},
+	})
+}
+
+// LightClientUpdatesByRange is an HTTP handler for the Beacon API `getLightClientUpdatesByRange` endpoint.
+// It returns the best update of every requested sync committee period known to the node.
+func (bs *Server) LightClientUpdatesByRange(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.LightClientUpdatesByRange")
+	defer span.End()
+
+	query := r.URL.Query()
+	startPeriod, err := strconv.ParseUint(query.Get("start_period"), 10, 64)
+	if err != nil {
+		writeRewardsError(w, http.StatusBadRequest, fmt.Sprintf("Invalid start period %s", query.Get("start_period")))
+		return
+	}
+	count, err := strconv.ParseUint(query.Get("count"), 10, 64)
+	if err != nil || count == 0 {
+		writeRewardsError(w, http.StatusBadRequest, fmt.Sprintf("Invalid count %s", query.Get("count")))
+		return
+	}
+	if count > maxLightClientUpdates {
+		count = maxLightClientUpdates
+	}
+	endPeriod := startPeriod + count - 1
+	if endPeriod < startPeriod {
+		writeRewardsError(w, http.StatusBadRequest, "Requested period range overflows")
+		return
+	}
+
+	updates, err := bs.BeaconDB.LightClientUpdates(ctx, startPeriod, endPeriod)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get light client updates").Error())
+		return
+	}
+	resp := make([]*LightClientUpdateWithVersion, len(updates))
+	for i, update := range updates {
+		resp[i] = &LightClientUpdateWithVersion{
+			Version: lightClientVersion(update.AttestedHeader.Slot),
+			Data: &LightClientUpdate{
+				AttestedHeader:          lightClientHeaderJson(update.AttestedHeader),
+				NextSyncCommittee:       lightClientSyncCommitteeJson(update.NextSyncCommittee),
+				NextSyncCommitteeBranch: lightClientBranchJson(update.NextSyncCommitteeBranch),
+				FinalizedHeader:         lightClientHeaderJson(update.FinalizedHeader),
+				FinalityBranch:          lightClientBranchJson(update.FinalityBranch),
+				SyncAggregate:           lightClientSyncAggregateJson(update.SyncAggregate),
+				SignatureSlot:           strconv.FormatUint(uint64(update.SignatureSlot), 10),
+			},
+		}
+	}
+	writeRewardsJson(w, resp)
+}
+
+// LightClientFinalityUpdate is an HTTP handler for the Beacon API `getLightClientFinalityUpdate` endpoint.
+func (bs *Server) LightClientFinalityUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.LightClientFinalityUpdate")
+	defer span.End()
+
+	update := bs.LightClientFetcher.LightClientFinalityUpdate()
+	if update == nil {
+		writeRewardsError(w, http.StatusNotFound, "No light client finality update available")
+		return
+	}
+	writeRewardsJson(w, &LightClientFinalityUpdateResponse{
+		Version: lightClientVersion(update.AttestedHeader.Slot),
+		Data: &LightClientFinalityUpdate{
+			AttestedHeader:  lightClientHeaderJson(update.AttestedHeader),
+			FinalizedHeader: lightClientHeaderJson(update.FinalizedHeader),
+			FinalityBranch:  lightClientBranchJson(update.FinalityBranch),
+			SyncAggregate:   lightClientSyncAggregateJson(update.SyncAggregate),
+			SignatureSlot:   strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// LightClientOptimisticUpdate is an HTTP handler for the Beacon API `getLightClientOptimisticUpdate` endpoint.
+func (bs *Server) LightClientOptimisticUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.LightClientOptimisticUpdate")
+	defer span.End()
+
+	update := bs.LightClientFetcher.LightClientOptimisticUpdate()
+	if update == nil {
+		writeRewardsError(w, http.StatusNotFound, "No light client optimistic update available")
+		return
+	}
+	writeRewardsJson(w, &LightClientOptimisticUpdateResponse{
+		Version: lightClientVersion(update.AttestedHeader.Slot),
+		Data: &LightClientOptimisticUpdate{
+			AttestedHeader: lightClientHeaderJson(update.AttestedHeader),
+			SyncAggregate:  lightClientSyncAggregateJson(update.SyncAggregate),
+			SignatureSlot:  strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// lightClientVersion returns the name of the fork active at the given slot.
+func lightClientVersion(slot types.Slot) string {
+	epoch := slots.ToEpoch(slot)
+	cfg := params.BeaconConfig()
+	switch {
+	case epoch >= cfg.CapellaForkEpoch:
+		return version.String(version.Capella)
+	case epoch >= cfg.BellatrixForkEpoch:
+		return version.String(version.Bellatrix)
+	case epoch >= cfg.AltairForkEpoch:
+		return version.String(version.Altair)
+	default:
+		return version.String(version.Phase0)
+	}
+}
+
+func lightClientHeaderJson(header *ethpb.BeaconBlockHeader) *apimiddleware.BeaconBlockHeaderJson {
+	return &apimiddleware.BeaconBlockHeaderJson{
+		Slot:          strconv.FormatUint(uint64(header.Slot), 10),
+		ProposerIndex: strconv.FormatUint(uint64(header.ProposerIndex), 10),
+		ParentRoot:    hexutil.Encode(header.ParentRoot),
+		StateRoot:     hexutil.Encode(header.StateRoot),
+		BodyRoot:      hexutil.Encode(header.BodyRoot),
+	}
+}
+
+func lightClientSyncCommitteeJson(committee *ethpb.SyncCommittee) *apimiddleware.SyncCommitteeJson {
+	return &apimiddleware.SyncCommitteeJson{
+		Pubkeys:         lightClientBranchJson(committee.Pubkeys),
+		AggregatePubkey: hexutil.Encode(committee.AggregatePubkey),
+	}
+}
+
+func lightClientSyncAggregateJson(aggregate *ethpb.SyncAggregate) *apimiddleware.SyncAggregateJson {
+	return &apimiddleware.SyncAggregateJson{
+		SyncCommitteeBits:      hexutil.Encode(aggregate.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(aggregate.SyncCommitteeSignature),
+	}
+}
+
+func lightClientBranchJson(branch [][]byte) []string {
+	res := make([]string, len(branch))
+	for i, b := range branch {
+		res[i] = hexutil.Encode(b)
+	}
+	return res
+}
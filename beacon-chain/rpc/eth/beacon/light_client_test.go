@@ -0,0 +1,191 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	mockstategen "github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen/mock"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func lightClientTestUpdate(attestedSlot types.Slot) *ethpb.LightClientUpdate {
+	pubKeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubKeys {
+		pubKeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	branch := func(depth int) [][]byte {
+		b := make([][]byte, depth)
+		for i := range b {
+			b[i] = make([]byte, fieldparams.RootLength)
+		}
+		return b
+	}
+	return &ethpb.LightClientUpdate{
+		AttestedHeader: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: attestedSlot}),
+		NextSyncCommittee: &ethpb.SyncCommittee{
+			Pubkeys:         pubKeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: branch(5),
+		FinalizedHeader:         util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{}),
+		FinalityBranch:          branch(6),
+		SyncAggregate: &ethpb.SyncAggregate{
+			SyncCommitteeBits:      bitfield.NewBitvector512(),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		SignatureSlot: attestedSlot + 1,
+	}
+}
+
+func TestServer_LightClientBootstrap(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	stRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk := util.NewBeaconBlockAltair()
+	blk.Block.StateRoot = stRoot[:]
+	util.SaveBlock(t, ctx, beaconDB, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	stateGen := mockstategen.NewMockService()
+	stateGen.StatesByRoot = map[[32]byte]state.BeaconState{root: st}
+	bs := &Server{BeaconDB: beaconDB, StateGenService: stateGen}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/bootstrap/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(root[:])})
+		writer := httptest.NewRecorder()
+		bs.LightClientBootstrap(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &LightClientBootstrapResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "phase0", resp.Version)
+		assert.Equal(t, hexutil.Encode(stRoot[:]), resp.Data.Header.StateRoot)
+		assert.Equal(t, int(params.BeaconConfig().SyncCommitteeSize), len(resp.Data.CurrentSyncCommittee.Pubkeys))
+		assert.Equal(t, 5, len(resp.Data.CurrentSyncCommitteeBranch))
+	})
+	t.Run("invalid root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/bootstrap/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": "0x1234"})
+		writer := httptest.NewRecorder()
+		bs.LightClientBootstrap(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("unknown block", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/bootstrap/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(make([]byte, 32))})
+		writer := httptest.NewRecorder()
+		bs.LightClientBootstrap(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
+
+func TestServer_LightClientUpdatesByRange(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	periodSlots := types.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * uint64(params.BeaconConfig().SlotsPerEpoch))
+	for _, period := range []uint64{1, 2, 4} {
+		require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, period, lightClientTestUpdate(types.Slot(period)*periodSlots)))
+	}
+	bs := &Server{BeaconDB: beaconDB}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/updates?start_period=2&count=5", nil)
+		writer := httptest.NewRecorder()
+		bs.LightClientUpdatesByRange(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		var resp []*LightClientUpdateWithVersion
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+		require.Equal(t, 2, len(resp))
+		assert.Equal(t, "16384", resp[0].Data.AttestedHeader.Slot)
+		assert.Equal(t, "32768", resp[1].Data.AttestedHeader.Slot)
+		assert.Equal(t, "32769", resp[1].Data.SignatureSlot)
+	})
+	t.Run("invalid count", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/updates?start_period=2&count=0", nil)
+		writer := httptest.NewRecorder()
+		bs.LightClientUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("missing start period", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/updates?count=1", nil)
+		writer := httptest.NewRecorder()
+		bs.LightClientUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestServer_LightClientFinalityUpdate(t *testing.T) {
+	chainService := &mock.ChainService{}
+	bs := &Server{LightClientFetcher: chainService}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/finality_update", nil)
+	writer := httptest.NewRecorder()
+	bs.LightClientFinalityUpdate(writer, request)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	update := lightClientTestUpdate(10)
+	chainService.FinalityUpdate = &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+	writer = httptest.NewRecorder()
+	bs.LightClientFinalityUpdate(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	resp := &LightClientFinalityUpdateResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "10", resp.Data.AttestedHeader.Slot)
+	assert.Equal(t, "0", resp.Data.FinalizedHeader.Slot)
+	assert.Equal(t, 6, len(resp.Data.FinalityBranch))
+	assert.Equal(t, "11", resp.Data.SignatureSlot)
+}
+
+func TestServer_LightClientOptimisticUpdate(t *testing.T) {
+	chainService := &mock.ChainService{}
+	bs := &Server{LightClientFetcher: chainService}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/optimistic_update", nil)
+	writer := httptest.NewRecorder()
+	bs.LightClientOptimisticUpdate(writer, request)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	update := lightClientTestUpdate(10)
+	chainService.OptimisticUpdate = &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+	writer = httptest.NewRecorder()
+	bs.LightClientOptimisticUpdate(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	resp := &LightClientOptimisticUpdateResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "10", resp.Data.AttestedHeader.Slot)
+	assert.Equal(t, hexutil.Encode(update.SyncAggregate.SyncCommitteeSignature), resp.Data.SyncAggregate.SyncCommitteeSignature)
+}
@@ -0,0 +1,569 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/gateway/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/altair"
+	coreblocks "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/epoch/precompute"
+	corehelpers "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// BlockRewardsResponse is the response of the block rewards endpoint.
+type BlockRewardsResponse struct {
+	Data                *BlockRewards `json:"data"`
+	ExecutionOptimistic bool          `json:"execution_optimistic"`
+	Finalized           bool          `json:"finalized"`
+}
+
+// BlockRewards contains the rewards earned by the proposer of a block, broken down by block operation.
+type BlockRewards struct {
+	ProposerIndex     string `json:"proposer_index"`
+	Total             string `json:"total"`
+	Attestations      string `json:"attestations"`
+	SyncAggregate     string `json:"sync_aggregate"`
+	ProposerSlashings string `json:"proposer_slashings"`
+	AttesterSlashings string `json:"attester_slashings"`
+}
+
+// AttestationRewardsResponse is the response of the attestation rewards endpoint.
+type AttestationRewardsResponse struct {
+	Data                *AttestationRewards `json:"data"`
+	ExecutionOptimistic bool                `json:"execution_optimistic"`
+	Finalized           bool                `json:"finalized"`
+}
+
+// AttestationRewards contains the attestation rewards of the requested validators, along with the rewards
+// a validator with perfect attestation performance would have earned for every possible effective balance.
+type AttestationRewards struct {
+	IdealRewards []*IdealAttestationReward `json:"ideal_rewards"`
+	TotalRewards []*TotalAttestationReward `json:"total_rewards"`
+}
+
+// IdealAttestationReward is the attestation reward of a perfectly performing validator with the given effective balance.
+type IdealAttestationReward struct {
+	EffectiveBalance string `json:"effective_balance"`
+	Head             string `json:"head"`
+	Target           string `json:"target"`
+	Source           string `json:"source"`
+}
+
+// TotalAttestationReward is the attestation reward of a single validator. Penalties are reported as negative values.
+type TotalAttestationReward struct {
+	ValidatorIndex string `json:"validator_index"`
+	Head           string `json:"head"`
+	Target         string `json:"target"`
+	Source         string `json:"source"`
+	Inactivity     string `json:"inactivity"`
+}
+
+// SyncCommitteeRewardsResponse is the response of the sync committee rewards endpoint.
+type SyncCommitteeRewardsResponse struct {
+	Data                []*SyncCommitteeReward `json:"data"`
+	ExecutionOptimistic bool                   `json:"execution_optimistic"`
+	Finalized           bool                   `json:"finalized"`
+}
+
+// SyncCommitteeReward is the reward of a single sync committee member for a block. Penalties are reported as negative values.
+type SyncCommitteeReward struct {
+	ValidatorIndex string `json:"validator_index"`
+	Reward         string `json:"reward"`
+}
+
+// BlockRewards is an HTTP handler for the Beacon API `getBlockRewards` endpoint.
+// It replays the state up to the block's pre-state and applies the block operations one by one,
+// in order to break down the proposer reward by operation.
+func (bs *Server) BlockRewards(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.BlockRewards")
+	defer span.End()
+
+	blk, ok := bs.rewardsBlock(ctx, w, mux.Vars(r)["block_id"])
+	if !ok {
+		return
+	}
+	st, err := bs.preBlockState(ctx, blk)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get pre-block state").Error())
+		return
+	}
+
+	proposerIndex := blk.Block().ProposerIndex()
+	initBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get proposer balance").Error())
+		return
+	}
+	st, err = coreblocks.ProcessProposerSlashings(ctx, st, blk.Block().Body().ProposerSlashings(), validators.SlashValidator)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not process proposer slashings").Error())
+		return
+	}
+	proposerSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get proposer balance").Error())
+		return
+	}
+	st, err = coreblocks.ProcessAttesterSlashings(ctx, st, blk.Block().Body().AttesterSlashings(), validators.SlashValidator)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not process attester slashings").Error())
+		return
+	}
+	attesterSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get proposer balance").Error())
+		return
+	}
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not process attestations").Error())
+		return
+	}
+	attestationsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get proposer balance").Error())
+		return
+	}
+
+	// The proposer may also be a sync committee member, so the sync aggregate reward is computed
+	// from the participation bits instead of the proposer's balance difference.
+	syncAggregate, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get sync aggregate").Error())
+		return
+	}
+	activeBalance, err := corehelpers.TotalActiveBalance(st)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get total active balance").Error())
+		return
+	}
+	proposerSyncReward, _, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get sync rewards").Error())
+		return
+	}
+	syncAggregateReward := proposerSyncReward * syncAggregate.SyncCommitteeBits.Count()
+
+	proposerSlashingsReward := balanceIncrease(initBalance, proposerSlashingsBalance)
+	attesterSlashingsReward := balanceIncrease(proposerSlashingsBalance, attesterSlashingsBalance)
+	attestationsReward := balanceIncrease(attesterSlashingsBalance, attestationsBalance)
+
+	blkRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get block root").Error())
+		return
+	}
+	isOptimistic, err := bs.OptimisticModeFetcher.IsOptimisticForRoot(ctx, blkRoot)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not check if block is optimistic").Error())
+		return
+	}
+
+	writeRewardsJson(w, &BlockRewardsResponse{
+		Data: &BlockRewards{
+			ProposerIndex:     strconv.FormatUint(uint64(proposerIndex), 10),
+			Total:             strconv.FormatUint(proposerSlashingsReward+attesterSlashingsReward+attestationsReward+syncAggregateReward, 10),
+			Attestations:      strconv.FormatUint(attestationsReward, 10),
+			SyncAggregate:     strconv.FormatUint(syncAggregateReward, 10),
+			ProposerSlashings: strconv.FormatUint(proposerSlashingsReward, 10),
+			AttesterSlashings: strconv.FormatUint(attesterSlashingsReward, 10),
+		},
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           bs.FinalizationFetcher.IsFinalized(ctx, blkRoot),
+	})
+}
+
+// AttestationRewards is an HTTP handler for the Beacon API `getAttestationsRewards` endpoint.
+// The rewards for an epoch are applied during the processing of the following epoch, so they are computed
+// from the state at the last slot of the following epoch, using the same precomputed balances as the state transition.
+func (bs *Server) AttestationRewards(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.AttestationRewards")
+	defer span.End()
+
+	rawEpoch := mux.Vars(r)["epoch"]
+	e, err := strconv.ParseUint(rawEpoch, 10, 64)
+	if err != nil {
+		writeRewardsError(w, http.StatusBadRequest, fmt.Sprintf("Invalid epoch %s: %v", rawEpoch, err))
+		return
+	}
+	epoch := types.Epoch(e)
+	if epoch < params.BeaconConfig().AltairForkEpoch {
+		writeRewardsError(w, http.StatusBadRequest, "Attestation rewards are not supported for Phase 0")
+		return
+	}
+	rewardsSlot, err := slots.EpochEnd(epoch + 1)
+	if err != nil {
+		writeRewardsError(w, http.StatusBadRequest, fmt.Sprintf("Invalid epoch %d: %v", epoch, err))
+		return
+	}
+	if rewardsSlot > bs.GenesisTimeFetcher.CurrentSlot() {
+		writeRewardsError(w, http.StatusNotFound, fmt.Sprintf("Attestation rewards for epoch %d are not available yet", epoch))
+		return
+	}
+
+	st, err := bs.StateFetcher.StateBySlot(ctx, rewardsSlot)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrapf(err, "could not get state for slot %d", rewardsSlot).Error())
+		return
+	}
+	isOptimistic, err := helpers.IsOptimistic(ctx, st, bs.OptimisticModeFetcher)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not check if state is optimistic").Error())
+		return
+	}
+	validatorIds, err := requestedValidatorIds(r)
+	if err != nil {
+		writeRewardsError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var valIndices []types.ValidatorIndex
+	if len(validatorIds) == 0 {
+		valIndices = make([]types.ValidatorIndex, st.NumValidators())
+		for i := range valIndices {
+			valIndices[i] = types.ValidatorIndex(i)
+		}
+	} else {
+		valIndices, err = validatorIndicesByIds(st, validatorIds)
+		if err != nil {
+			writeRewardsError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	vals, bal, err := altair.InitializePrecomputeValidators(ctx, st)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not initialize precompute validators").Error())
+		return
+	}
+	vals, bal, err = altair.ProcessEpochParticipation(ctx, st, bal, vals)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not process epoch participation").Error())
+		return
+	}
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bal)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not process justification").Error())
+		return
+	}
+	st, vals, err = altair.ProcessInactivityScores(ctx, st, vals)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not process inactivity updates").Error())
+		return
+	}
+	deltas, err := altair.AttestationsDelta(st, bal, vals)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get attestations delta").Error())
+		return
+	}
+	idealRewards, err := idealAttestationRewards(st, bal)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get ideal attestation rewards").Error())
+		return
+	}
+
+	totalRewards := make([]*TotalAttestationReward, len(valIndices))
+	for i, idx := range valIndices {
+		d := deltas[idx]
+		totalRewards[i] = &TotalAttestationReward{
+			ValidatorIndex: strconv.FormatUint(uint64(idx), 10),
+			Head:           strconv.FormatUint(d.HeadReward, 10),
+			Target:         formatRewardDelta(d.TargetReward, d.TargetPenalty),
+			Source:         formatRewardDelta(d.SourceReward, d.SourcePenalty),
+			Inactivity:     formatRewardDelta(0, d.InactivityPenalty),
+		}
+	}
+
+	writeRewardsJson(w, &AttestationRewardsResponse{
+		Data: &AttestationRewards{
+			IdealRewards: idealRewards,
+			TotalRewards: totalRewards,
+		},
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           bs.FinalizationFetcher.FinalizedCheckpt().Epoch > epoch+1,
+	})
+}
+
+// SyncCommitteeRewards is an HTTP handler for the Beacon API `getSyncCommitteeRewards` endpoint.
+func (bs *Server) SyncCommitteeRewards(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SyncCommitteeRewards")
+	defer span.End()
+
+	blk, ok := bs.rewardsBlock(ctx, w, mux.Vars(r)["block_id"])
+	if !ok {
+		return
+	}
+	st, err := bs.preBlockState(ctx, blk)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get pre-block state").Error())
+		return
+	}
+	validatorIds, err := requestedValidatorIds(r)
+	if err != nil {
+		writeRewardsError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	syncAggregate, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get sync aggregate").Error())
+		return
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get current sync committee").Error())
+		return
+	}
+	activeBalance, err := corehelpers.TotalActiveBalance(st)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get total active balance").Error())
+		return
+	}
+	_, participantReward, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get sync rewards").Error())
+		return
+	}
+
+	// A validator can appear several times in the sync committee, in which case its rewards are summed up.
+	committeeRewards := make(map[types.ValidatorIndex]int64)
+	committeeIndices := make([]types.ValidatorIndex, 0, len(committee.Pubkeys))
+	for i, pubKey := range committee.Pubkeys {
+		valIdx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			writeRewardsError(w, http.StatusInternalServerError, fmt.Sprintf("Could not find validator index for public key %#x", pubKey))
+			return
+		}
+		if _, seen := committeeRewards[valIdx]; !seen {
+			committeeIndices = append(committeeIndices, valIdx)
+		}
+		if syncAggregate.SyncCommitteeBits.BitAt(uint64(i)) {
+			committeeRewards[valIdx] += int64(participantReward)
+		} else {
+			committeeRewards[valIdx] -= int64(participantReward)
+		}
+	}
+
+	// When no validators are requested, the rewards of the whole sync committee are returned.
+	valIndices := committeeIndices
+	if len(validatorIds) > 0 {
+		valIndices, err = validatorIndicesByIds(st, validatorIds)
+		if err != nil {
+			writeRewardsError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	data := make([]*SyncCommitteeReward, 0, len(valIndices))
+	for _, idx := range valIndices {
+		reward, ok := committeeRewards[idx]
+		if !ok {
+			continue
+		}
+		data = append(data, &SyncCommitteeReward{
+			ValidatorIndex: strconv.FormatUint(uint64(idx), 10),
+			Reward:         strconv.FormatInt(reward, 10),
+		})
+	}
+
+	blkRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not get block root").Error())
+		return
+	}
+	isOptimistic, err := bs.OptimisticModeFetcher.IsOptimisticForRoot(ctx, blkRoot)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not check if block is optimistic").Error())
+		return
+	}
+
+	writeRewardsJson(w, &SyncCommitteeRewardsResponse{
+		Data:                data,
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           bs.FinalizationFetcher.IsFinalized(ctx, blkRoot),
+	})
+}
+
+// rewardsBlock retrieves the block for which rewards are requested, writing the appropriate error response
+// when the block can't be found or when it is a phase 0 block.
+func (bs *Server) rewardsBlock(ctx context.Context, w http.ResponseWriter, blockId string) (interfaces.SignedBeaconBlock, bool) {
+	rawBlockId := []byte(blockId)
+	if strings.HasPrefix(blockId, "0x") {
+		root, err := hexutil.Decode(blockId)
+		if err != nil {
+			writeRewardsError(w, http.StatusBadRequest, fmt.Sprintf("Invalid block ID %s: %v", blockId, err))
+			return nil, false
+		}
+		rawBlockId = root
+	}
+
+	blk, err := bs.blockFromBlockID(ctx, rawBlockId)
+	if invalidBlockIdErr, ok := err.(*blockIdParseError); ok {
+		writeRewardsError(w, http.StatusBadRequest, fmt.Sprintf("Invalid block ID: %v", invalidBlockIdErr))
+		return nil, false
+	}
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, fmt.Sprintf("Could not get block from block ID: %v", err))
+		return nil, false
+	}
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		writeRewardsError(w, http.StatusNotFound, fmt.Sprintf("Could not find requested block: %v", err))
+		return nil, false
+	}
+	if blk.Version() == version.Phase0 {
+		writeRewardsError(w, http.StatusBadRequest, "Rewards are not supported for Phase 0 blocks")
+		return nil, false
+	}
+	if blk.Block().Slot() == 0 {
+		writeRewardsError(w, http.StatusBadRequest, "Rewards are not supported for the genesis block")
+		return nil, false
+	}
+	return blk, true
+}
+
+// preBlockState replays the chain up to the slot of the given block, without applying the block itself.
+func (bs *Server) preBlockState(ctx context.Context, blk interfaces.SignedBeaconBlock) (state.BeaconState, error) {
+	slot := blk.Block().Slot()
+	st, err := bs.StateFetcher.StateBySlot(ctx, slot-1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state for slot %d", slot-1)
+	}
+	st, err = transition.ProcessSlots(ctx, st, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+	}
+	return st, nil
+}
+
+// idealAttestationRewards computes the rewards of a validator voting correctly for the source, target and head,
+// for every possible effective balance.
+func idealAttestationRewards(st state.BeaconState, bal *precompute.Balance) ([]*IdealAttestationReward, error) {
+	cfg := params.BeaconConfig()
+	increments := cfg.MaxEffectiveBalance / cfg.EffectiveBalanceIncrement
+	idealVals := make([]*precompute.Validator, increments)
+	for i := range idealVals {
+		idealVals[i] = &precompute.Validator{
+			IsActivePrevEpoch:            true,
+			IsPrevEpochSourceAttester:    true,
+			IsPrevEpochTargetAttester:    true,
+			IsPrevEpochHeadAttester:      true,
+			CurrentEpochEffectiveBalance: uint64(i+1) * cfg.EffectiveBalanceIncrement,
+		}
+	}
+	deltas, err := altair.AttestationsDelta(st, bal, idealVals)
+	if err != nil {
+		return nil, err
+	}
+	idealRewards := make([]*IdealAttestationReward, len(deltas))
+	for i, d := range deltas {
+		idealRewards[i] = &IdealAttestationReward{
+			EffectiveBalance: strconv.FormatUint(idealVals[i].CurrentEpochEffectiveBalance, 10),
+			Head:             strconv.FormatUint(d.HeadReward, 10),
+			Target:           strconv.FormatUint(d.TargetReward, 10),
+			Source:           strconv.FormatUint(d.SourceReward, 10),
+		}
+	}
+	return idealRewards, nil
+}
+
+// requestedValidatorIds decodes the list of validator IDs from the request body. An empty body means no filter.
+func requestedValidatorIds(r *http.Request) ([]string, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read request body")
+	}
+	if len(body) == 0 {
+		return nil, nil
+	}
+	var validatorIds []string
+	if err := json.Unmarshal(body, &validatorIds); err != nil {
+		return nil, errors.Wrap(err, "could not decode validator IDs")
+	}
+	return validatorIds, nil
+}
+
+// validatorIndicesByIds converts validator IDs, which may be validator indices or hex encoded public keys, to validator indices.
+func validatorIndicesByIds(st state.BeaconState, validatorIds []string) ([]types.ValidatorIndex, error) {
+	numVals := uint64(st.NumValidators())
+	indices := make([]types.ValidatorIndex, 0, len(validatorIds))
+	for _, id := range validatorIds {
+		if strings.HasPrefix(id, "0x") {
+			pubKey, err := hexutil.Decode(id)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not decode validator public key %s", id)
+			}
+			if len(pubKey) != params.BeaconConfig().BLSPubkeyLength {
+				return nil, fmt.Errorf("invalid validator public key length %d", len(pubKey))
+			}
+			idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+			if !ok {
+				return nil, fmt.Errorf("unknown validator public key %s", id)
+			}
+			indices = append(indices, idx)
+			continue
+		}
+		idx, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse validator index %s", id)
+		}
+		if idx >= numVals {
+			return nil, fmt.Errorf("validator index %d is too large, there are %d validators", idx, numVals)
+		}
+		indices = append(indices, types.ValidatorIndex(idx))
+	}
+	return indices, nil
+}
+
+func balanceIncrease(before, after uint64) uint64 {
+	if after < before {
+		return 0
+	}
+	return after - before
+}
+
+func formatRewardDelta(reward, penalty uint64) string {
+	if penalty > reward {
+		return "-" + strconv.FormatUint(penalty-reward, 10)
+	}
+	return strconv.FormatUint(reward-penalty, 10)
+}
+
+func writeRewardsJson(w http.ResponseWriter, v interface{}) {
+	j, err := json.Marshal(v)
+	if err != nil {
+		writeRewardsError(w, http.StatusInternalServerError, errors.Wrap(err, "could not marshal response").Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(j)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(j); err != nil {
+		log.WithError(err).Error("Could not write response message")
+	}
+}
+
+func writeRewardsError(w http.ResponseWriter, code int, message string) {
+	apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{Message: message, Code: code}, nil)
+}
@@ -0,0 +1,271 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v3/api/gateway/apimiddleware"
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+func rewardsTestBlock(t *testing.T, participation bool) (state.BeaconState, *mock.ChainService) {
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	committee, err := altair.NextSyncCommittee(context.Background(), st)
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentSyncCommittee(committee))
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = 1
+	b.Block.ProposerIndex = 12
+	for i := uint64(0); i < b.Block.Body.SyncAggregate.SyncCommitteeBits.Len(); i++ {
+		b.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(i, participation)
+	}
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return st, &mock.ChainService{Block: blk, Optimistic: true}
+}
+
+func TestServer_BlockRewards(t *testing.T) {
+	st, chainService := rewardsTestBlock(t, true)
+	bs := &Server{
+		ChainInfoFetcher:      chainService,
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		StateFetcher:          &testutil.MockFetcher{StatesBySlot: map[types.Slot]state.BeaconState{0: st.Copy()}},
+	}
+
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	proposerReward, _, err := altair.SyncRewards(activeBalance)
+	require.NoError(t, err)
+	syncAggregateReward := proposerReward * params.BeaconConfig().SyncCommitteeSize
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/rewards/blocks/head", nil)
+	request = mux.SetURLVars(request, map[string]string{"block_id": "head"})
+	writer := httptest.NewRecorder()
+	bs.BlockRewards(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	resp := &BlockRewardsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "12", resp.Data.ProposerIndex)
+	assert.Equal(t, strconv.FormatUint(syncAggregateReward, 10), resp.Data.SyncAggregate)
+	assert.Equal(t, strconv.FormatUint(syncAggregateReward, 10), resp.Data.Total)
+	assert.Equal(t, "0", resp.Data.Attestations)
+	assert.Equal(t, "0", resp.Data.ProposerSlashings)
+	assert.Equal(t, "0", resp.Data.AttesterSlashings)
+	assert.Equal(t, true, resp.ExecutionOptimistic)
+	assert.Equal(t, false, resp.Finalized)
+}
+
+func TestServer_BlockRewards_Errors(t *testing.T) {
+	t.Run("phase 0 block", func(t *testing.T) {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = 1
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		bs := &Server{ChainInfoFetcher: &mock.ChainService{Block: blk}}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/rewards/blocks/head", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_id": "head"})
+		writer := httptest.NewRecorder()
+		bs.BlockRewards(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &apimiddleware.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "not supported for Phase 0", e.Message)
+	})
+	t.Run("invalid block ID", func(t *testing.T) {
+		bs := &Server{}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/rewards/blocks/foo", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_id": "foo"})
+		writer := httptest.NewRecorder()
+		bs.BlockRewards(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestServer_SyncCommitteeRewards(t *testing.T) {
+	for _, participation := range []bool{true, false} {
+		t.Run(fmt.Sprintf("participation %t", participation), func(t *testing.T) {
+			st, chainService := rewardsTestBlock(t, participation)
+			bs := &Server{
+				ChainInfoFetcher:      chainService,
+				OptimisticModeFetcher: chainService,
+				FinalizationFetcher:   chainService,
+				StateFetcher:          &testutil.MockFetcher{StatesBySlot: map[types.Slot]state.BeaconState{0: st.Copy()}},
+			}
+
+			activeBalance, err := helpers.TotalActiveBalance(st)
+			require.NoError(t, err)
+			_, participantReward, err := altair.SyncRewards(activeBalance)
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/rewards/sync_committee/head", nil)
+			request = mux.SetURLVars(request, map[string]string{"block_id": "head"})
+			writer := httptest.NewRecorder()
+			bs.SyncCommitteeRewards(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+
+			resp := &SyncCommitteeRewardsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			total := int64(0)
+			for _, r := range resp.Data {
+				reward, err := strconv.ParseInt(r.Reward, 10, 64)
+				require.NoError(t, err)
+				assert.Equal(t, participation, reward > 0)
+				total += reward
+			}
+			expected := int64(participantReward * params.BeaconConfig().SyncCommitteeSize)
+			if !participation {
+				expected = -expected
+			}
+			assert.Equal(t, expected, total)
+		})
+	}
+}
+
+func TestServer_SyncCommitteeRewards_RequestedValidators(t *testing.T) {
+	st, chainService := rewardsTestBlock(t, true)
+	bs := &Server{
+		ChainInfoFetcher:      chainService,
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		StateFetcher:          &testutil.MockFetcher{StatesBySlot: map[types.Slot]state.BeaconState{0: st.Copy()}},
+	}
+
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	pubKey := hexutil.Encode(committee.Pubkeys[0])
+	body, err := json.Marshal([]string{pubKey})
+	require.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/rewards/sync_committee/head", bytes.NewReader(body))
+	request = mux.SetURLVars(request, map[string]string{"block_id": "head"})
+	writer := httptest.NewRecorder()
+	bs.SyncCommitteeRewards(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	resp := &SyncCommitteeRewardsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(committee.Pubkeys[0]))
+	require.Equal(t, true, ok)
+	assert.Equal(t, strconv.FormatUint(uint64(idx), 10), resp.Data[0].ValidatorIndex)
+}
+
+func TestServer_AttestationRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	rewardsSlot, err := slots.EpochEnd(1)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(rewardsSlot))
+	participation := make([]byte, st.NumValidators())
+	for i := 1; i < len(participation); i++ {
+		participation[i] = 1<<cfg.TimelySourceFlagIndex | 1<<cfg.TimelyTargetFlagIndex | 1<<cfg.TimelyHeadFlagIndex
+	}
+	require.NoError(t, st.SetPreviousParticipationBits(participation))
+
+	currentSlot := rewardsSlot
+	chainService := &mock.ChainService{Slot: &currentSlot, FinalizedCheckPoint: st.FinalizedCheckpoint()}
+	bs := &Server{
+		GenesisTimeFetcher:    chainService,
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		StateFetcher:          &testutil.MockFetcher{StatesBySlot: map[types.Slot]state.BeaconState{rewardsSlot: st}},
+	}
+
+	t.Run("all validators", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/rewards/attestations/0", nil)
+		request = mux.SetURLVars(request, map[string]string{"epoch": "0"})
+		writer := httptest.NewRecorder()
+		bs.AttestationRewards(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &AttestationRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 64, len(resp.Data.TotalRewards))
+		require.Equal(t, int(cfg.MaxEffectiveBalance/cfg.EffectiveBalanceIncrement), len(resp.Data.IdealRewards))
+
+		// Validator 0 did not attest, so it is penalized for the source and the target.
+		missed := resp.Data.TotalRewards[0]
+		assert.Equal(t, "0", missed.ValidatorIndex)
+		assert.Equal(t, "0", missed.Head)
+		assert.Equal(t, true, strings.HasPrefix(missed.Source, "-"))
+		assert.Equal(t, true, strings.HasPrefix(missed.Target, "-"))
+		assert.Equal(t, "0", missed.Inactivity)
+
+		// Other validators attested perfectly with the maximum effective balance, so they earn the ideal reward.
+		ideal := resp.Data.IdealRewards[len(resp.Data.IdealRewards)-1]
+		assert.Equal(t, strconv.FormatUint(cfg.MaxEffectiveBalance, 10), ideal.EffectiveBalance)
+		perfect := resp.Data.TotalRewards[1]
+		assert.Equal(t, ideal.Head, perfect.Head)
+		assert.Equal(t, ideal.Source, perfect.Source)
+		assert.Equal(t, ideal.Target, perfect.Target)
+		assert.NotEqual(t, "0", perfect.Head)
+	})
+	t.Run("requested validators", func(t *testing.T) {
+		pubKey := st.PubkeyAtIndex(5)
+		body, err := json.Marshal([]string{"3", hexutil.Encode(pubKey[:])})
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/rewards/attestations/0", bytes.NewReader(body))
+		request = mux.SetURLVars(request, map[string]string{"epoch": "0"})
+		writer := httptest.NewRecorder()
+		bs.AttestationRewards(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &AttestationRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data.TotalRewards))
+		assert.Equal(t, "3", resp.Data.TotalRewards[0].ValidatorIndex)
+		assert.Equal(t, "5", resp.Data.TotalRewards[1].ValidatorIndex)
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		body, err := json.Marshal([]string{"64"})
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/rewards/attestations/0", bytes.NewReader(body))
+		request = mux.SetURLVars(request, map[string]string{"epoch": "0"})
+		writer := httptest.NewRecorder()
+		bs.AttestationRewards(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("epoch not finished", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/rewards/attestations/1", nil)
+		request = mux.SetURLVars(request, map[string]string{"epoch": "1"})
+		writer := httptest.NewRecorder()
+		bs.AttestationRewards(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid epoch", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/rewards/attestations/foo", nil)
+		request = mux.SetURLVars(request, map[string]string{"epoch": "foo"})
+		writer := httptest.NewRecorder()
+		bs.AttestationRewards(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
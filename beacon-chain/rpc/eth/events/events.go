@@ -33,6 +33,8 @@ const (
 	ChainReorgTopic = "chain_reorg"
 	// SyncCommitteeContributionTopic represents a new sync committee contribution event topic.
 	SyncCommitteeContributionTopic = "contribution_and_proof"
+	// DetectedSlashingTopic represents a new slashing detected by the slasher event topic.
+	DetectedSlashingTopic = "detected_slashing"
 )
 
 var casesHandled = map[string]bool{
@@ -43,6 +45,7 @@ var casesHandled = map[string]bool{
 	FinalizedCheckpointTopic:       true,
 	ChainReorgTopic:                true,
 	SyncCommitteeContributionTopic: true,
+	DetectedSlashingTopic:          true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -178,6 +181,29 @@ func handleBlockOperationEvents(
 		}
 		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
 		return streamData(stream, SyncCommitteeContributionTopic, v2Data)
+	case operation.SlashingDetected:
+		if _, ok := requestedTopics[DetectedSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.SlashingDetectedData)
+		if !ok || slashingData.Slashing == nil {
+			return nil
+		}
+		slashingStatus, err := migration.V1Alpha1SlashingStatusToV1(slashingData.Slashing.Status)
+		if err != nil {
+			return err
+		}
+		v1Data := &ethpb.EventDetectedSlashing{
+			ValidatorIndices: slashingData.Slashing.ValidatorIndices,
+			Status:           slashingStatus,
+		}
+		if slashingData.Slashing.AttesterSlashing != nil {
+			v1Data.AttesterSlashing = migration.V1Alpha1AttSlashingToV1(slashingData.Slashing.AttesterSlashing)
+		}
+		if slashingData.Slashing.ProposerSlashing != nil {
+			v1Data.ProposerSlashing = migration.V1Alpha1ProposerSlashingToV1(slashingData.Slashing.ProposerSlashing)
+		}
+		return streamData(stream, DetectedSlashingTopic, v1Data)
 	default:
 		return nil
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/v3/proto/migration"
 	eth "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
@@ -238,6 +239,47 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(DetectedSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &eth.SlashingEvent{
+			ProposerSlashing: &eth.ProposerSlashing{
+				Header_1: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{}),
+				Header_2: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{}),
+			},
+			ValidatorIndices: []types.ValidatorIndex{1},
+			Status:           eth.SlashingEvent_INCLUDED,
+		}
+		wantedSlashing := &ethpb.EventDetectedSlashing{
+			ProposerSlashing: migration.V1Alpha1ProposerSlashingToV1(wantedSlashingV1alpha1.ProposerSlashing),
+			ValidatorIndices: []types.ValidatorIndex{1},
+			Status:           ethpb.EventDetectedSlashing_INCLUDED,
+		}
+		genericResponse, err := anypb.New(wantedSlashing)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: DetectedSlashingTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{DetectedSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.SlashingDetected,
+				Data: &operation.SlashingDetectedData{
+					Slashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_StateEvents(t *testing.T) {
@@ -270,6 +270,14 @@ func (ns *Server) GetSyncStatus(ctx context.Context, _ *emptypb.Empty) (*ethpb.S
 		return nil, status.Errorf(codes.Internal, "Could not check optimistic status: %v", err)
 	}
 
+	if ns.BackfillStatusFetcher != nil {
+		// Reporting the backfill progress is best effort, failing to set the headers should not cause the call to fail.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(
+			grpcutil.BackfillSlotMetadataKey, strconv.FormatUint(uint64(ns.BackfillStatusFetcher.EndGap()), 10),
+			grpcutil.BackfillOriginSlotMetadataKey, strconv.FormatUint(uint64(ns.BackfillStatusFetcher.OriginSlot()), 10),
+		))
+	}
+
 	headSlot := ns.HeadFetcher.HeadSlot()
 	return &ethpb.SyncingResponse{
 		Data: &ethpb.SyncInfo{
@@ -294,6 +302,14 @@ func (ns *Server) GetHealth(ctx context.Context, _ *emptypb.Empty) (*emptypb.Emp
 	ctx, span := trace.StartSpan(ctx, "node.GetHealth")
 	defer span.End()
 
+	if ns.PruneStatusFetcher != nil {
+		pruneStatus := ns.PruneStatusFetcher.PruneStatus()
+		// Reporting the pruning progress is best effort, failing to set the headers should not cause the call to fail.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(
+			grpcutil.PrunedSlotMetadataKey, strconv.FormatUint(uint64(pruneStatus.PrunedSlot), 10),
+			grpcutil.PruneTargetSlotMetadataKey, strconv.FormatUint(uint64(pruneStatus.TargetSlot), 10),
+		))
+	}
 	if ns.SyncChecker.Synced() {
 		return &emptypb.Empty{}, nil
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	grpcutil "github.com/prysmaticlabs/prysm/v3/api/grpc"
 	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers"
 	mockp2p "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
@@ -37,6 +38,18 @@ import (
 
 type dummyIdentity enode.ID
 
+type mockPruneStatusFetcher pruner.Status
+
+func (m *mockPruneStatusFetcher) PruneStatus() pruner.Status { return pruner.Status(*m) }
+
+type mockBackfillStatusFetcher struct {
+	endGap     types.Slot
+	originSlot types.Slot
+}
+
+func (m *mockBackfillStatusFetcher) EndGap() types.Slot     { return m.endGap }
+func (m *mockBackfillStatusFetcher) OriginSlot() types.Slot { return m.originSlot }
+
 func (_ dummyIdentity) Verify(_ *enr.Record, _ []byte) error { return nil }
 func (id dummyIdentity) NodeAddr(_ *enr.Record) []byte       { return id[:] }
 
@@ -72,6 +85,21 @@ func TestGetHealth(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetHealth_PruneStatus(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &grpcruntime.ServerTransportStream{})
+	s := &Server{
+		SyncChecker:        &syncmock.Sync{IsInitialized: true, IsSynced: true},
+		PruneStatusFetcher: &mockPruneStatusFetcher{PrunedSlot: 64, TargetSlot: 128},
+	}
+
+	_, err := s.GetHealth(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	stream, ok := grpc.ServerTransportStreamFromContext(ctx).(*grpcruntime.ServerTransportStream)
+	require.Equal(t, true, ok, "type assertion failed")
+	assert.Equal(t, "64", stream.Header()[strings.ToLower(grpcutil.PrunedSlotMetadataKey)][0])
+	assert.Equal(t, "128", stream.Header()[strings.ToLower(grpcutil.PruneTargetSlotMetadataKey)][0])
+}
+
 func TestGetIdentity(t *testing.T) {
 	ctx := context.Background()
 	p2pAddr, err := ma.NewMultiaddr("/ip4/7.7.7.7/udp/30303")
@@ -185,6 +213,25 @@ func TestSyncStatus(t *testing.T) {
 	assert.Equal(t, true, resp.Data.IsOptimistic)
 }
 
+func TestSyncStatus_BackfillStatus(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &grpcruntime.ServerTransportStream{})
+	chainService := &mock.ChainService{Slot: new(types.Slot)}
+	s := &Server{
+		HeadFetcher:           chainService,
+		GenesisTimeFetcher:    chainService,
+		OptimisticModeFetcher: chainService,
+		SyncChecker:           &syncmock.Sync{},
+		BackfillStatusFetcher: &mockBackfillStatusFetcher{endGap: 64, originSlot: 128},
+	}
+
+	_, err := s.GetSyncStatus(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	stream, ok := grpc.ServerTransportStreamFromContext(ctx).(*grpcruntime.ServerTransportStream)
+	require.Equal(t, true, ok, "type assertion failed")
+	assert.Equal(t, "64", stream.Header()[strings.ToLower(grpcutil.BackfillSlotMetadataKey)][0])
+	assert.Equal(t, "128", stream.Header()[strings.ToLower(grpcutil.BackfillOriginSlotMetadataKey)][0])
+}
+
 func TestGetPeer(t *testing.T) {
 	const rawId = "16Uiu2HAkvyYtoQXZNTsthjgLHjEnv7kvwzEmjvsJjWXpbhtqpSUN"
 	ctx := context.Background()
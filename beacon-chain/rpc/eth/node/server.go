@@ -6,8 +6,10 @@ package node
 import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync/backfill"
 	"google.golang.org/grpc"
 )
 
@@ -24,4 +26,6 @@ type Server struct {
 	MetadataProvider      p2p.MetadataProvider
 	GenesisTimeFetcher    blockchain.TimeFetcher
 	HeadFetcher           blockchain.HeadFetcher
+	PruneStatusFetcher    pruner.StatusFetcher
+	BackfillStatusFetcher backfill.StatusFetcher
 }
@@ -0,0 +1,44 @@
+package node
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// BackfillStatusJson is the progress of the backfill of the history below the checkpoint sync origin.
+type BackfillStatusJson struct {
+	BackfillSlot string `json:"backfill_slot"`
+	OriginSlot   string `json:"origin_slot"`
+}
+
+// BackfillStatusResponseJson is the response of the backfill status endpoint.
+type BackfillStatusResponseJson struct {
+	Data *BackfillStatusJson `json:"data"`
+}
+
+// GetBackfillStatus returns the lowest slot of the history backfilled by the node, and the slot of the
+// checkpoint sync origin the backfill started from.
+func (ns *Server) GetBackfillStatus(w http.ResponseWriter, _ *http.Request) {
+	if ns.BackfillStatusFetcher == nil {
+		writeNodeError(w, http.StatusNotFound, "backfill is not enabled")
+		return
+	}
+	resp := &BackfillStatusResponseJson{
+		Data: &BackfillStatusJson{
+			BackfillSlot: strconv.FormatUint(uint64(ns.BackfillStatusFetcher.EndGap()), 10),
+			OriginSlot:   strconv.FormatUint(uint64(ns.BackfillStatusFetcher.OriginSlot()), 10),
+		},
+	}
+	j, err := json.Marshal(resp)
+	if err != nil {
+		writeNodeError(w, http.StatusInternalServerError, errors.Wrap(err, "could not marshal response").Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(j)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(j)
+}
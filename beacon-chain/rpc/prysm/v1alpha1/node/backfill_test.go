@@ -0,0 +1,38 @@
+package node
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+type mockBackfillStatusFetcher struct {
+	endGap     types.Slot
+	originSlot types.Slot
+}
+
+func (m *mockBackfillStatusFetcher) EndGap() types.Slot     { return m.endGap }
+func (m *mockBackfillStatusFetcher) OriginSlot() types.Slot { return m.originSlot }
+
+func TestServer_GetBackfillStatus(t *testing.T) {
+	request := func(ns *Server) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/backfill", nil)
+		w := httptest.NewRecorder()
+		ns.GetBackfillStatus(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusNotFound, request(&Server{}).Code)
+
+	w := request(&Server{BackfillStatusFetcher: &mockBackfillStatusFetcher{endGap: 64, originSlot: 128}})
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &BackfillStatusResponseJson{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, "64", resp.Data.BackfillSlot)
+	assert.Equal(t, "128", resp.Data.OriginSlot)
+}
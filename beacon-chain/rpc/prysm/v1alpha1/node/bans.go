@@ -0,0 +1,162 @@
+package node
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/gateway/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
+)
+
+// banRequestJson is the body of a ban request. Exactly one of the peer ID, the IP address and
+// the subnet must be set. The reason and the duration are only used when banning, and an empty
+// duration bans permanently.
+type banRequestJson struct {
+	PeerID   string `json:"peer_id"`
+	IP       string `json:"ip"`
+	Subnet   string `json:"subnet"`
+	Reason   string `json:"reason"`
+	Duration string `json:"duration"`
+}
+
+// banJson is a ban in effect, on either a peer or a subnet.
+type banJson struct {
+	PeerID  string     `json:"peer_id,omitempty"`
+	Subnet  string     `json:"subnet,omitempty"`
+	Reason  string     `json:"reason"`
+	Created time.Time  `json:"created"`
+	Expiry  *time.Time `json:"expiry,omitempty"`
+}
+
+type bansJson struct {
+	Peers   []*banJson `json:"peers"`
+	Subnets []*banJson `json:"subnets"`
+}
+
+type listBansResponseJson struct {
+	Data *bansJson `json:"data"`
+}
+
+// ListBans lists the peer and subnet bans in effect.
+func (ns *Server) ListBans(w http.ResponseWriter, _ *http.Request) {
+	resp := &bansJson{Peers: make([]*banJson, 0), Subnets: make([]*banJson, 0)}
+	for pid, ban := range ns.PeerBanner.BannedPeers() {
+		item := banToJson(ban)
+		item.PeerID = pid.String()
+		resp.Peers = append(resp.Peers, item)
+	}
+	sort.Slice(resp.Peers, func(i, j int) bool {
+		return resp.Peers[i].PeerID < resp.Peers[j].PeerID
+	})
+	for _, ban := range ns.PeerBanner.BannedSubnets() {
+		item := banToJson(ban.Ban)
+		item.Subnet = ban.Subnet.String()
+		resp.Subnets = append(resp.Subnets, item)
+	}
+	sort.Slice(resp.Subnets, func(i, j int) bool {
+		return resp.Subnets[i].Subnet < resp.Subnets[j].Subnet
+	})
+	writeNodeJson(w, &listBansResponseJson{Data: resp})
+}
+
+// BanPeer bans a peer, an IP address or a subnet until the ban expires or is lifted.
+func (ns *Server) BanPeer(w http.ResponseWriter, r *http.Request) {
+	req, err := decodeBanRequest(r)
+	if err != nil {
+		writeNodeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	pid, subnet, err := p2p.ParseBanTarget(req.PeerID, req.IP, req.Subnet)
+	if err != nil {
+		writeNodeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var duration time.Duration
+	if req.Duration != "" {
+		duration, err = time.ParseDuration(req.Duration)
+		if err != nil || duration < 0 {
+			writeNodeError(w, http.StatusBadRequest, "invalid ban duration "+req.Duration)
+			return
+		}
+	}
+	if subnet != nil {
+		err = ns.PeerBanner.BanSubnet(subnet, req.Reason, duration)
+	} else {
+		err = ns.PeerBanner.BanPeer(pid, req.Reason, duration)
+	}
+	if err != nil {
+		writeNodeError(w, http.StatusInternalServerError, errors.Wrap(err, "could not ban").Error())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// UnbanPeer lifts the ban of a peer, an IP address or a subnet.
+func (ns *Server) UnbanPeer(w http.ResponseWriter, r *http.Request) {
+	req, err := decodeBanRequest(r)
+	if err != nil {
+		writeNodeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	pid, subnet, err := p2p.ParseBanTarget(req.PeerID, req.IP, req.Subnet)
+	if err != nil {
+		writeNodeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var found bool
+	if subnet != nil {
+		found, err = ns.PeerBanner.UnbanSubnet(subnet)
+	} else {
+		found, err = ns.PeerBanner.UnbanPeer(pid)
+	}
+	if err != nil {
+		writeNodeError(w, http.StatusInternalServerError, errors.Wrap(err, "could not lift ban").Error())
+		return
+	}
+	if !found {
+		writeNodeError(w, http.StatusNotFound, "no ban found")
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeBanRequest(r *http.Request) (*banRequestJson, error) {
+	req := &banRequestJson{}
+	if r.Body == nil {
+		return nil, errors.New("missing request body")
+	}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, errors.Wrap(err, "could not decode request body")
+	}
+	return req, nil
+}
+
+func banToJson(ban *peerdata.Ban) *banJson {
+	item := &banJson{Reason: ban.Reason, Created: ban.Created}
+	if !ban.Expiry.IsZero() {
+		expiry := ban.Expiry
+		item.Expiry = &expiry
+	}
+	return item
+}
+
+func writeNodeJson(w http.ResponseWriter, v interface{}) {
+	j, err := json.Marshal(v)
+	if err != nil {
+		writeNodeError(w, http.StatusInternalServerError, errors.Wrap(err, "could not marshal response").Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(j)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(j)
+}
+
+func writeNodeError(w http.ResponseWriter, code int, message string) {
+	apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{Message: message, Code: code}, nil)
+}
@@ -0,0 +1,161 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+var mockBanTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type mockPeerBanner struct {
+	peers   map[peer.ID]*peerdata.Ban
+	subnets map[string]*peerdata.SubnetBan
+}
+
+func newMockPeerBanner() *mockPeerBanner {
+	return &mockPeerBanner{peers: make(map[peer.ID]*peerdata.Ban), subnets: make(map[string]*peerdata.SubnetBan)}
+}
+
+func mockBan(reason string, duration time.Duration) *peerdata.Ban {
+	ban := &peerdata.Ban{Reason: reason, Created: mockBanTime}
+	if duration > 0 {
+		ban.Expiry = mockBanTime.Add(duration)
+	}
+	return ban
+}
+
+func (m *mockPeerBanner) BanPeer(pid peer.ID, reason string, duration time.Duration) error {
+	m.peers[pid] = mockBan(reason, duration)
+	return nil
+}
+
+func (m *mockPeerBanner) UnbanPeer(pid peer.ID) (bool, error) {
+	_, ok := m.peers[pid]
+	delete(m.peers, pid)
+	return ok, nil
+}
+
+func (m *mockPeerBanner) BanSubnet(subnet *net.IPNet, reason string, duration time.Duration) error {
+	m.subnets[subnet.String()] = &peerdata.SubnetBan{Subnet: subnet, Ban: mockBan(reason, duration)}
+	return nil
+}
+
+func (m *mockPeerBanner) UnbanSubnet(subnet *net.IPNet) (bool, error) {
+	_, ok := m.subnets[subnet.String()]
+	delete(m.subnets, subnet.String())
+	return ok, nil
+}
+
+func (m *mockPeerBanner) BannedPeers() map[peer.ID]*peerdata.Ban {
+	return m.peers
+}
+
+func (m *mockPeerBanner) BannedSubnets() []*peerdata.SubnetBan {
+	bans := make([]*peerdata.SubnetBan, 0, len(m.subnets))
+	for _, ban := range m.subnets {
+		bans = append(bans, ban)
+	}
+	return bans
+}
+
+func TestServer_BanPeer(t *testing.T) {
+	banner := newMockPeerBanner()
+	ns := &Server{PeerBanner: banner}
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	ban := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/bans", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		ns.BanPeer(w, req)
+		return w
+	}
+
+	w := ban(`{"peer_id":"` + pid.String() + `","reason":"spam"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	peerBan, ok := banner.peers[pid]
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, peerBan.Expiry.IsZero())
+
+	w = ban(`{"ip":"192.168.0.1","reason":"sybil","duration":"1h"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	subnetBan, ok := banner.subnets["192.168.0.1/32"]
+	require.Equal(t, true, ok)
+	assert.Equal(t, mockBanTime.Add(time.Hour), subnetBan.Ban.Expiry)
+
+	w = ban(`{"subnet":"10.0.0.0/8","ip":"10.0.0.1"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = ban(`{"subnet":"10.0.0.0/8","duration":"-1h"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = ban(`not json`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_ListBans(t *testing.T) {
+	banner := newMockPeerBanner()
+	ns := &Server{PeerBanner: banner}
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	_, subnet, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+
+	list := func() *listBansResponseJson {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/bans", nil)
+		w := httptest.NewRecorder()
+		ns.ListBans(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &listBansResponseJson{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		return resp
+	}
+
+	resp := list()
+	assert.Equal(t, 0, len(resp.Data.Peers))
+	assert.Equal(t, 0, len(resp.Data.Subnets))
+
+	require.NoError(t, banner.BanPeer(pid, "spam", 0))
+	require.NoError(t, banner.BanSubnet(subnet, "sybil", time.Hour))
+	resp = list()
+	require.Equal(t, 1, len(resp.Data.Peers))
+	assert.Equal(t, pid.String(), resp.Data.Peers[0].PeerID)
+	assert.Equal(t, "spam", resp.Data.Peers[0].Reason)
+	assert.Equal(t, true, resp.Data.Peers[0].Expiry == nil)
+	require.Equal(t, 1, len(resp.Data.Subnets))
+	assert.Equal(t, "10.0.0.0/8", resp.Data.Subnets[0].Subnet)
+	assert.Equal(t, "sybil", resp.Data.Subnets[0].Reason)
+	require.NotNil(t, resp.Data.Subnets[0].Expiry)
+	assert.Equal(t, true, mockBanTime.Add(time.Hour).Equal(*resp.Data.Subnets[0].Expiry))
+}
+
+func TestServer_UnbanPeer(t *testing.T) {
+	banner := newMockPeerBanner()
+	ns := &Server{PeerBanner: banner}
+	_, subnet, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	require.NoError(t, banner.BanSubnet(subnet, "sybil", 0))
+
+	unban := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodDelete, "http://example.com/prysm/v1/node/bans", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		ns.UnbanPeer(w, req)
+		return w
+	}
+
+	w := unban(`{"subnet":"10.0.0.0/8"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 0, len(banner.subnets))
+	w = unban(`{"subnet":"10.0.0.0/8"}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = unban(`{}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,91 @@
+package node
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers"
+)
+
+// trustedPeerRequestJson is the body of a trusted peer request. The multiaddr, which must include
+// the peer ID, is used when adding a trusted peer and the peer ID when removing one.
+type trustedPeerRequestJson struct {
+	Addr   string `json:"addr"`
+	PeerID string `json:"peer_id"`
+}
+
+// trustedPeerJson is a trusted peer along with whether the node is connected to it.
+type trustedPeerJson struct {
+	PeerID    string   `json:"peer_id"`
+	Addresses []string `json:"addresses"`
+	Connected bool     `json:"connected"`
+}
+
+type listTrustedPeersResponseJson struct {
+	Data []*trustedPeerJson `json:"data"`
+}
+
+// ListTrustedPeers lists the trusted peers, ordered by peer ID.
+func (ns *Server) ListTrustedPeers(w http.ResponseWriter, _ *http.Request) {
+	infos := ns.TrustedPeerManager.TrustedPeers()
+	resp := &listTrustedPeersResponseJson{Data: make([]*trustedPeerJson, 0, len(infos))}
+	for _, info := range infos {
+		state, err := ns.PeersFetcher.Peers().ConnectionState(info.ID)
+		item := &trustedPeerJson{
+			PeerID:    info.ID.String(),
+			Addresses: make([]string, 0, len(info.Addrs)),
+			Connected: err == nil && state == peers.PeerConnected,
+		}
+		for _, addr := range info.Addrs {
+			item.Addresses = append(item.Addresses, addr.String())
+		}
+		resp.Data = append(resp.Data, item)
+	}
+	writeNodeJson(w, resp)
+}
+
+// AddTrustedPeer adds the peer with the given multiaddr to the trusted peers.
+func (ns *Server) AddTrustedPeer(w http.ResponseWriter, r *http.Request) {
+	req, err := decodeTrustedPeerRequest(r)
+	if err != nil {
+		writeNodeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := ns.TrustedPeerManager.AddTrustedPeer(req.Addr); err != nil {
+		writeNodeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// RemoveTrustedPeer makes the trusted peer with the given peer ID a regular peer again.
+func (ns *Server) RemoveTrustedPeer(w http.ResponseWriter, r *http.Request) {
+	req, err := decodeTrustedPeerRequest(r)
+	if err != nil {
+		writeNodeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	pid, err := peer.Decode(req.PeerID)
+	if err != nil {
+		writeNodeError(w, http.StatusBadRequest, errors.Wrapf(err, "invalid peer ID %s", req.PeerID).Error())
+		return
+	}
+	if !ns.TrustedPeerManager.RemoveTrustedPeer(pid) {
+		writeNodeError(w, http.StatusNotFound, "no trusted peer found")
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeTrustedPeerRequest(r *http.Request) (*trustedPeerRequestJson, error) {
+	req := &trustedPeerRequestJson{}
+	if r.Body == nil {
+		return nil, errors.New("missing request body")
+	}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, errors.Wrap(err, "could not decode request body")
+	}
+	return req, nil
+}
@@ -0,0 +1,112 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
+	mockP2p "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+type mockTrustedPeerManager struct {
+	trusted map[peer.ID]*peer.AddrInfo
+}
+
+func (m *mockTrustedPeerManager) AddTrustedPeer(addr string) (peer.ID, error) {
+	info, err := p2p.MakePeer(addr)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid trusted peer address %s", addr)
+	}
+	m.trusted[info.ID] = info
+	return info.ID, nil
+}
+
+func (m *mockTrustedPeerManager) RemoveTrustedPeer(pid peer.ID) bool {
+	_, ok := m.trusted[pid]
+	delete(m.trusted, pid)
+	return ok
+}
+
+func (m *mockTrustedPeerManager) TrustedPeers() []peer.AddrInfo {
+	infos := make([]peer.AddrInfo, 0, len(m.trusted))
+	for _, info := range m.trusted {
+		infos = append(infos, *info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+func TestServer_TrustedPeers(t *testing.T) {
+	manager := &mockTrustedPeerManager{trusted: make(map[peer.ID]*peer.AddrInfo)}
+	ns := &Server{TrustedPeerManager: manager}
+	pid := "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+
+	request := func(method, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "http://example.com/prysm/v1/node/trusted_peers", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		if method == http.MethodPost {
+			ns.AddTrustedPeer(w, req)
+		} else {
+			ns.RemoveTrustedPeer(w, req)
+		}
+		return w
+	}
+
+	for _, body := range []string{`not json`, `{"addr":"not a multiaddr"}`, `{"addr":"/ip4/127.0.0.1/tcp/3000"}`} {
+		assert.Equal(t, http.StatusBadRequest, request(http.MethodPost, body).Code, body)
+	}
+	w := request(http.MethodPost, `{"addr":"/ip4/127.0.0.1/tcp/3000/p2p/`+pid+`"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, len(manager.trusted))
+
+	assert.Equal(t, http.StatusBadRequest, request(http.MethodDelete, `{"peer_id":"invalid"}`).Code)
+	assert.Equal(t, http.StatusOK, request(http.MethodDelete, `{"peer_id":"`+pid+`"}`).Code)
+	assert.Equal(t, http.StatusNotFound, request(http.MethodDelete, `{"peer_id":"`+pid+`"}`).Code)
+	assert.Equal(t, 0, len(manager.trusted))
+}
+
+func TestServer_ListTrustedPeers(t *testing.T) {
+	manager := &mockTrustedPeerManager{trusted: make(map[peer.ID]*peer.AddrInfo)}
+	ns := &Server{TrustedPeerManager: manager, PeersFetcher: &mockP2p.MockPeersProvider{}}
+	// The mock peers provider is connected to the first peer only.
+	connected := "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+	disconnected := "16Uiu2HAmRrhnqEfybLYimCiAYer2AtZKDGamQrL1VwRCyeh2YiFc"
+
+	list := func() *listTrustedPeersResponseJson {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/trusted_peers", nil)
+		w := httptest.NewRecorder()
+		ns.ListTrustedPeers(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &listTrustedPeersResponseJson{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		return resp
+	}
+
+	assert.Equal(t, 0, len(list().Data))
+
+	_, err := manager.AddTrustedPeer("/ip4/127.0.0.1/tcp/3000/p2p/" + connected)
+	require.NoError(t, err)
+	_, err = manager.AddTrustedPeer("/ip4/127.0.0.2/tcp/3000/p2p/" + disconnected)
+	require.NoError(t, err)
+	resp := list()
+	require.Equal(t, 2, len(resp.Data))
+	byID := make(map[string]*trustedPeerJson)
+	for _, item := range resp.Data {
+		byID[item.PeerID] = item
+	}
+	require.NotNil(t, byID[connected])
+	assert.Equal(t, true, byID[connected].Connected)
+	assert.DeepEqual(t, []string{"/ip4/127.0.0.1/tcp/3000"}, byID[connected].Addresses)
+	require.NotNil(t, byID[disconnected])
+	assert.Equal(t, false, byID[disconnected].Connected)
+}
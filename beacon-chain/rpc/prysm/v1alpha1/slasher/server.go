@@ -3,10 +3,15 @@
 package slasher
 
 import (
+	"context"
+
+	opfeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
 	slasherservice "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher"
 )
 
 // Server defines a server implementation of the gRPC slasher service.
 type Server struct {
-	SlashingChecker slasherservice.SlashingChecker
+	Ctx               context.Context
+	SlashingChecker   slasherservice.SlashingChecker
+	OperationNotifier opfeed.Notifier
 }
@@ -0,0 +1,61 @@
+package slasher
+
+import (
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// StreamAttesterSlashings sends the attester slashings detected by the slasher over a gRPC stream
+// as soon as they are detected, along with the slashed validators and whether they are already
+// slashed on chain.
+func (s *Server) StreamAttesterSlashings(_ *emptypb.Empty, stream ethpb.Slasher_StreamAttesterSlashingsServer) error {
+	return s.streamSlashings(stream, func(ev *ethpb.SlashingEvent) bool {
+		return ev.AttesterSlashing != nil
+	})
+}
+
+// StreamProposerSlashings sends the proposer slashings detected by the slasher over a gRPC stream
+// as soon as they are detected, along with the slashed validator and whether it is already
+// slashed on chain.
+func (s *Server) StreamProposerSlashings(_ *emptypb.Empty, stream ethpb.Slasher_StreamProposerSlashingsServer) error {
+	return s.streamSlashings(stream, func(ev *ethpb.SlashingEvent) bool {
+		return ev.ProposerSlashing != nil
+	})
+}
+
+type slashingEventSender interface {
+	Send(*ethpb.SlashingEvent) error
+	grpc.ServerStream
+}
+
+func (s *Server) streamSlashings(stream slashingEventSender, match func(*ethpb.SlashingEvent) bool) error {
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-opChannel:
+			if ev.Type != operation.SlashingDetected {
+				continue
+			}
+			data, ok := ev.Data.(*operation.SlashingDetectedData)
+			if !ok || data.Slashing == nil || !match(data.Slashing) {
+				continue
+			}
+			if err := stream.Send(data.Slashing); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-opSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-s.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	chainMock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/mock"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestServer_StreamAttesterSlashings(t *testing.T) {
+	exitRoutine := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	server := &Server{
+		Ctx:               ctx,
+		OperationNotifier: (&chainMock.ChainService{}).OperationNotifier(),
+	}
+
+	proposerSlashing := &ethpb.SlashingEvent{
+		ProposerSlashing: &ethpb.ProposerSlashing{},
+		ValidatorIndices: []types.ValidatorIndex{1},
+	}
+	attesterSlashing := &ethpb.SlashingEvent{
+		AttesterSlashing: &ethpb.AttesterSlashing{},
+		ValidatorIndices: []types.ValidatorIndex{2, 3},
+		Status:           ethpb.SlashingEvent_INCLUDED,
+	}
+
+	mockStream := mock.NewMockSlasher_StreamAttesterSlashingsServer(ctrl)
+	mockStream.EXPECT().Send(attesterSlashing).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	go func(tt *testing.T) {
+		assert.NoError(tt, server.StreamAttesterSlashings(&emptypb.Empty{}, mockStream), "Could not call RPC method")
+	}(t)
+	// Proposer slashings are not sent over the attester slashings stream.
+	for _, ev := range []*ethpb.SlashingEvent{proposerSlashing, attesterSlashing} {
+		// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the operation feed).
+		for sent := 0; sent == 0; {
+			sent = server.OperationNotifier.OperationFeed().Send(&feed.Event{
+				Type: operation.SlashingDetected,
+				Data: &operation.SlashingDetectedData{Slashing: ev},
+			})
+		}
+	}
+	<-exitRoutine
+}
+
+func TestServer_StreamProposerSlashings(t *testing.T) {
+	exitRoutine := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	server := &Server{
+		Ctx:               ctx,
+		OperationNotifier: (&chainMock.ChainService{}).OperationNotifier(),
+	}
+
+	attesterSlashing := &ethpb.SlashingEvent{
+		AttesterSlashing: &ethpb.AttesterSlashing{},
+		ValidatorIndices: []types.ValidatorIndex{2, 3},
+	}
+	proposerSlashing := &ethpb.SlashingEvent{
+		ProposerSlashing: &ethpb.ProposerSlashing{},
+		ValidatorIndices: []types.ValidatorIndex{1},
+		Status:           ethpb.SlashingEvent_INCLUDED,
+	}
+
+	mockStream := mock.NewMockSlasher_StreamProposerSlashingsServer(ctrl)
+	mockStream.EXPECT().Send(proposerSlashing).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	go func(tt *testing.T) {
+		assert.NoError(tt, server.StreamProposerSlashings(&emptypb.Empty{}, mockStream), "Could not call RPC method")
+	}(t)
+	// Attester slashings are not sent over the proposer slashings stream.
+	for _, ev := range []*ethpb.SlashingEvent{attesterSlashing, proposerSlashing} {
+		// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the operation feed).
+		for sent := 0; sent == 0; {
+			sent = server.OperationNotifier.OperationFeed().Send(&feed.Event{
+				Type: operation.SlashingDetected,
+				Data: &operation.SlashingDetectedData{Slashing: ev},
+			})
+		}
+	}
+	<-exitRoutine
+}
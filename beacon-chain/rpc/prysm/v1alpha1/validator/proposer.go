@@ -75,7 +75,7 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 	return vs.proposeGenericBeaconBlock(ctx, blk)
 }
 
-// PrepareBeaconProposer caches and updates the fee recipient for the given proposer.
+// PrepareBeaconProposer caches and updates the fee recipient and builder relays for the given proposer.
 func (vs *Server) PrepareBeaconProposer(
 	ctx context.Context, request *ethpb.PrepareBeaconProposerRequest,
 ) (*emptypb.Empty, error) {
@@ -84,6 +84,25 @@ func (vs *Server) PrepareBeaconProposer(
 	var feeRecipients []common.Address
 	var validatorIndices []types.ValidatorIndex
 
+	for _, r := range request.Recipients {
+		recipient := hexutil.Encode(r.FeeRecipient)
+		if !common.IsHexAddress(recipient) {
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid fee recipient address: %v", recipient))
+		}
+	}
+
+	if vs.BlockBuilder != nil && vs.BlockBuilder.Configured() {
+		for _, r := range request.Recipients {
+			// Validators which do not choose relays keep the relays they chose before.
+			if len(r.Relays) == 0 {
+				continue
+			}
+			if err := vs.BlockBuilder.SetValidatorRelays(ctx, r.ValidatorIndex, r.Relays); err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not set validator relays: %v", err)
+			}
+		}
+	}
+
 	newRecipients := make([]*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer, 0, len(request.Recipients))
 	for _, r := range request.Recipients {
 		f, err := vs.BeaconDB.FeeRecipientByValidatorID(ctx, r.ValidatorIndex)
@@ -103,10 +122,6 @@ func (vs *Server) PrepareBeaconProposer(
 	}
 
 	for _, recipientContainer := range newRecipients {
-		recipient := hexutil.Encode(recipientContainer.FeeRecipient)
-		if !common.IsHexAddress(recipient) {
-			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid fee recipient address: %v", recipient))
-		}
 		feeRecipients = append(feeRecipients, common.BytesToAddress(recipientContainer.FeeRecipient))
 		validatorIndices = append(validatorIndices, recipientContainer.ValidatorIndex)
 	}
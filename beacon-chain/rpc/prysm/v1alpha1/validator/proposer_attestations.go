@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/features"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
@@ -69,9 +70,24 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	if err != nil {
 		return nil, err
 	}
-	sorted, err := deduped.sortByProfitability()
-	if err != nil {
-		return nil, err
+	var sorted proposerAtts
+	sortedByReward := false
+	if features.Get().EnableRewardBasedAttestationPacking && latestState.Version() >= version.Altair {
+		var reward uint64
+		sorted, reward, err = deduped.sortByReward(ctx, latestState)
+		if err != nil {
+			// The block is still proposed, with the attestations packed as without the feature.
+			log.WithError(err).Warn("Could not sort attestations by reward, sorting them by profitability")
+		} else {
+			sortedByReward = true
+			packedAttestationsRewardGauge.Set(float64(reward))
+		}
+	}
+	if !sortedByReward {
+		sorted, err = deduped.sortByProfitability()
+		if err != nil {
+			return nil, err
+		}
 	}
 	atts = sorted.limitToMaxAttestations()
 	return atts, nil
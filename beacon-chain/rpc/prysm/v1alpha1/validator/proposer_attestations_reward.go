@@ -0,0 +1,223 @@
+package validator
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+var packedAttestationsRewardGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "packed_attestations_expected_proposer_reward_gwei",
+	Help: "The expected proposer reward in Gwei of the attestations packed in the last block proposal, " +
+		"when attestations are packed by reward.",
+})
+
+// attCandidate is an attestation evaluated for inclusion by reward, along with the attesting
+// indices, the participation flags it sets and the last evaluated proposer reward numerator.
+type attCandidate struct {
+	att       *ethpb.Attestation
+	indices   []uint64
+	flags     map[uint8]bool
+	current   bool
+	numerator uint64
+}
+
+// attCandidates is a max heap of candidates by proposer reward numerator.
+type attCandidates []*attCandidate
+
+func (c attCandidates) Len() int           { return len(c) }
+func (c attCandidates) Less(i, j int) bool { return c[i].numerator > c[j].numerator }
+func (c attCandidates) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+func (c *attCandidates) Push(x interface{}) {
+	*c = append(*c, x.(*attCandidate))
+}
+
+func (c *attCandidates) Pop() interface{} {
+	old := *c
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*c = old[:n-1]
+	return x
+}
+
+// attRewardTracker keeps track of the participation flags set by the state and the attestations
+// selected so far, so the reward an attestation adds on top of them can be computed.
+type attRewardTracker struct {
+	st                    state.ReadOnlyBeaconState
+	totalBalance          uint64
+	currentParticipation  []byte
+	previousParticipation []byte
+	baseRewards           map[uint64]uint64
+	weights               map[uint8]uint64
+}
+
+func newAttRewardTracker(st state.BeaconState) (*attRewardTracker, error) {
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get total active balance")
+	}
+	currentParticipation, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current epoch participation")
+	}
+	previousParticipation, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get previous epoch participation")
+	}
+	cfg := params.BeaconConfig()
+	return &attRewardTracker{
+		st:                    st,
+		totalBalance:          totalBalance,
+		currentParticipation:  currentParticipation,
+		previousParticipation: previousParticipation,
+		baseRewards:           make(map[uint64]uint64),
+		weights: map[uint8]uint64{
+			cfg.TimelySourceFlagIndex: cfg.TimelySourceWeight,
+			cfg.TimelyTargetFlagIndex: cfg.TimelyTargetWeight,
+			cfg.TimelyHeadFlagIndex:   cfg.TimelyHeadWeight,
+		},
+	}, nil
+}
+
+// rewardNumerator returns the proposer reward numerator the candidate adds on top of the tracked
+// participation. The participation is updated with the flags of the candidate when apply is set.
+func (t *attRewardTracker) rewardNumerator(c *attCandidate, apply bool) (uint64, error) {
+	participation := t.previousParticipation
+	if c.current {
+		participation = t.currentParticipation
+	}
+	numerator := uint64(0)
+	for _, index := range c.indices {
+		if index >= uint64(len(participation)) {
+			return 0, errors.Errorf("index %d exceeds participation length %d", index, len(participation))
+		}
+		for flag, weight := range t.weights {
+			if !c.flags[flag] {
+				continue
+			}
+			has, err := altair.HasValidatorFlag(participation[index], flag)
+			if err != nil {
+				return 0, err
+			}
+			if has {
+				continue
+			}
+			br, ok := t.baseRewards[index]
+			if !ok {
+				br, err = altair.BaseRewardWithTotalBalance(t.st, types.ValidatorIndex(index), t.totalBalance)
+				if err != nil {
+					return 0, err
+				}
+				t.baseRewards[index] = br
+			}
+			numerator += br * weight
+			if apply {
+				participation[index], err = altair.AddValidatorFlag(participation[index], flag)
+				if err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	return numerator, nil
+}
+
+// sortByReward orders attestations by the proposer reward they add on top of the participation
+// already recorded in the state, greedily selecting the attestation with the highest marginal
+// reward until the block is full. Attestations which add no reward are appended at the end,
+// ordered by profitability. It also returns the proposer reward of the selected attestations in Gwei.
+func (a proposerAtts) sortByReward(ctx context.Context, st state.BeaconState) (proposerAtts, uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.sortByReward")
+	defer span.End()
+
+	if st.Version() < version.Altair {
+		return nil, 0, errors.New("attestations can only be packed by reward from altair onwards")
+	}
+	tracker, err := newAttRewardTracker(st)
+	if err != nil {
+		return nil, 0, err
+	}
+	currentEpoch := time.CurrentEpoch(st)
+	candidates := make(attCandidates, 0, len(a))
+	for _, att := range a {
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "could not get beacon committee")
+		}
+		indices, err := attestation.AttestingIndices(att.AggregationBits, committee)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "could not get attesting indices")
+		}
+		flags, err := altair.AttestationParticipationFlagIndices(st, att.Data, st.Slot()-att.Data.Slot)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "could not get participation flag indices")
+		}
+		c := &attCandidate{
+			att:     att,
+			indices: indices,
+			flags:   flags,
+			current: att.Data.Target.Epoch == currentEpoch,
+		}
+		c.numerator, err = tracker.rewardNumerator(c, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		candidates = append(candidates, c)
+	}
+	heap.Init(&candidates)
+
+	// The reward an attestation adds never increases as other attestations get selected, so a
+	// candidate whose re-evaluated reward is still the highest one can be selected right away.
+	cfg := params.BeaconConfig()
+	selected := make(proposerAtts, 0, cfg.MaxAttestations)
+	var leftover proposerAtts
+	numerator := uint64(0)
+	for candidates.Len() > 0 && uint64(len(selected)) < cfg.MaxAttestations {
+		if ctx.Err() != nil {
+			return nil, 0, ctx.Err()
+		}
+		c := heap.Pop(&candidates).(*attCandidate)
+		n, err := tracker.rewardNumerator(c, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		if n == 0 {
+			leftover = append(leftover, c.att)
+			continue
+		}
+		if candidates.Len() > 0 && n < candidates[0].numerator {
+			c.numerator = n
+			heap.Push(&candidates, c)
+			continue
+		}
+		if _, err := tracker.rewardNumerator(c, true); err != nil {
+			return nil, 0, err
+		}
+		numerator += n
+		selected = append(selected, c.att)
+	}
+	for _, c := range candidates {
+		leftover = append(leftover, c.att)
+	}
+	leftover, err = leftover.sortByProfitability()
+	if err != nil {
+		return nil, 0, err
+	}
+	d := (cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight
+	return append(selected, leftover...), numerator / d, nil
+}
@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestProposer_ProposerAtts_sortByReward(t *testing.T) {
+	ctx := context.Background()
+	cfg := params.BeaconConfig()
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(1))
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) >= 8)
+
+	// The 5th to 7th committee members already got all flags, the 8th only the timely source flag.
+	participation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	for _, i := range committee[4:7] {
+		participation[i] = 0b111
+	}
+	participation[committee[7]] = 1 << cfg.TimelySourceFlagIndex
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+
+	targetRoot, err := helpers.BlockRoot(st, 0)
+	require.NoError(t, err)
+	headRoot, err := helpers.BlockRootAtSlot(st, 0)
+	require.NoError(t, err)
+	newAtt := func(members ...int) *ethpb.Attestation {
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		for _, m := range members {
+			bits.SetBitAt(uint64(m), true)
+		}
+		return util.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bits,
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: headRoot,
+				Source:          st.CurrentJustifiedCheckpoint(),
+				Target:          &ethpb.Checkpoint{Root: targetRoot},
+			},
+		})
+	}
+	a := newAtt(0, 1, 2, 3)
+	b := newAtt(4, 5, 6, 7)
+	c := newAtt(0, 1, 2)
+	d := newAtt(4, 5)
+
+	sorted, reward, err := proposerAtts{d, c, b, a}.sortByReward(ctx, st)
+	require.NoError(t, err)
+	// Attestations which add no reward come last, ordered by bit count.
+	assert.DeepEqual(t, proposerAtts{a, b, c, d}, sorted)
+
+	numerator := uint64(0)
+	for _, i := range committee[:4] {
+		br, err := altair.BaseReward(st, i)
+		require.NoError(t, err)
+		numerator += br * (cfg.TimelySourceWeight + cfg.TimelyTargetWeight + cfg.TimelyHeadWeight)
+	}
+	br, err := altair.BaseReward(st, committee[7])
+	require.NoError(t, err)
+	numerator += br * (cfg.TimelyTargetWeight + cfg.TimelyHeadWeight)
+	denominator := (cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight
+	assert.Equal(t, numerator/denominator, reward)
+
+	// The state participation is left untouched.
+	got, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	assert.DeepEqual(t, participation, got)
+}
+
+func TestProposer_ProposerAtts_sortByReward_Phase0(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	_, _, err := proposerAtts{}.sortByReward(context.Background(), st)
+	require.ErrorContains(t, "altair", err)
+}
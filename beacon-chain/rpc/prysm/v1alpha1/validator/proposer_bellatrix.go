@@ -4,18 +4,22 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v3/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
-	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition/interop"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	consensusblocks "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/hash"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v3/encoding/ssz"
 	enginev1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
@@ -41,18 +45,12 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 		return nil, err
 	}
 
+	var payload interfaces.ExecutionData
+	useBuilder := false
 	if !req.SkipMevBoost {
 		registered, err := vs.validatorRegistered(ctx, altairBlk.ProposerIndex)
 		if registered && err == nil {
-			builderReady, b, err := vs.GetAndBuildBlindBlock(ctx, altairBlk)
-			if err != nil {
-				// In the event of an error, the node should fall back to default execution engine for building block.
-				log.WithError(err).Error("Failed to build a block from external builder, falling " +
-					"back to local execution client")
-				builderGetPayloadMissCount.Inc()
-			} else if builderReady {
-				return b, nil
-			}
+			useBuilder = true
 		} else if err != nil {
 			log.WithError(err).WithFields(logrus.Fields{
 				"slot":           req.Slot,
@@ -60,161 +58,401 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 			}).Error("Could not determine validator has registered. Defaulting to local execution client")
 		}
 	}
-	payload, err := vs.getExecutionPayload(ctx, req.Slot, altairBlk.ProposerIndex, bytesutil.ToBytes32(altairBlk.ParentRoot))
-	if err != nil {
-		return nil, err
+	if useBuilder {
+		b, localPayload, err := vs.getBuilderBlockOrLocalPayload(ctx, altairBlk)
+		if err != nil {
+			return nil, err
+		}
+		if b != nil {
+			return b, nil
+		}
+		payload = localPayload
+	} else {
+		payload, _, err = vs.getExecutionPayload(ctx, req.Slot, altairBlk.ProposerIndex, bytesutil.ToBytes32(altairBlk.ParentRoot))
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	blk := &ethpb.BeaconBlockBellatrix{
-		Slot:          altairBlk.Slot,
-		ProposerIndex: altairBlk.ProposerIndex,
-		ParentRoot:    altairBlk.ParentRoot,
-		StateRoot:     params.BeaconConfig().ZeroHash[:],
-		Body: &ethpb.BeaconBlockBodyBellatrix{
-			RandaoReveal:      altairBlk.Body.RandaoReveal,
-			Eth1Data:          altairBlk.Body.Eth1Data,
-			Graffiti:          altairBlk.Body.Graffiti,
-			ProposerSlashings: altairBlk.Body.ProposerSlashings,
-			AttesterSlashings: altairBlk.Body.AttesterSlashings,
-			Attestations:      altairBlk.Body.Attestations,
-			Deposits:          altairBlk.Body.Deposits,
-			VoluntaryExits:    altairBlk.Body.VoluntaryExits,
-			SyncAggregate:     altairBlk.Body.SyncAggregate,
-			ExecutionPayload:  payload,
-		},
-	}
-	// Compute state root with the newly constructed block.
-	wsb, err := consensusblocks.NewSignedBeaconBlock(
-		&ethpb.SignedBeaconBlockBellatrix{Block: blk, Signature: make([]byte, 96)},
-	)
-	if err != nil {
-		return nil, err
+	return vs.buildLocalBlock(ctx, altairBlk, payload)
+}
+
+// buildLocalBlock constructs the block given the input altair block and the execution payload of the local execution
+// client. It returns a Bellatrix or Capella generic beacon block for signing, depending on the payload version.
+func (vs *Server) buildLocalBlock(ctx context.Context, b *ethpb.BeaconBlockAltair, p interfaces.ExecutionData) (*ethpb.GenericBeaconBlock, error) {
+	if p == nil || p.IsNil() {
+		return nil, errors.New("nil payload")
 	}
-	stateRoot, err := vs.computeStateRoot(ctx, wsb)
-	if err != nil {
-		interop.WriteBlockToDisk(wsb, true /*failed*/)
-		return nil, fmt.Errorf("could not compute state root: %v", err)
+
+	switch payload := p.Proto().(type) {
+	case *enginev1.ExecutionPayload:
+		blk := &ethpb.BeaconBlockBellatrix{
+			Slot:          b.Slot,
+			ProposerIndex: b.ProposerIndex,
+			ParentRoot:    b.ParentRoot,
+			StateRoot:     params.BeaconConfig().ZeroHash[:],
+			Body: &ethpb.BeaconBlockBodyBellatrix{
+				RandaoReveal:      b.Body.RandaoReveal,
+				Eth1Data:          b.Body.Eth1Data,
+				Graffiti:          b.Body.Graffiti,
+				ProposerSlashings: b.Body.ProposerSlashings,
+				AttesterSlashings: b.Body.AttesterSlashings,
+				Attestations:      b.Body.Attestations,
+				Deposits:          b.Body.Deposits,
+				VoluntaryExits:    b.Body.VoluntaryExits,
+				SyncAggregate:     b.Body.SyncAggregate,
+				ExecutionPayload:  payload,
+			},
+		}
+		// Compute state root with the newly constructed block.
+		wsb, err := consensusblocks.NewSignedBeaconBlock(
+			&ethpb.SignedBeaconBlockBellatrix{Block: blk, Signature: make([]byte, 96)},
+		)
+		if err != nil {
+			return nil, err
+		}
+		stateRoot, err := vs.computeStateRoot(ctx, wsb)
+		if err != nil {
+			interop.WriteBlockToDisk(wsb, true /*failed*/)
+			return nil, fmt.Errorf("could not compute state root: %v", err)
+		}
+		blk.StateRoot = stateRoot
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}}, nil
+	case *enginev1.ExecutionPayloadCapella:
+		changes, err := vs.blsToExecChanges(ctx)
+		if err != nil {
+			return nil, err
+		}
+		blk := &ethpb.BeaconBlockCapella{
+			Slot:          b.Slot,
+			ProposerIndex: b.ProposerIndex,
+			ParentRoot:    b.ParentRoot,
+			StateRoot:     params.BeaconConfig().ZeroHash[:],
+			Body: &ethpb.BeaconBlockBodyCapella{
+				RandaoReveal:          b.Body.RandaoReveal,
+				Eth1Data:              b.Body.Eth1Data,
+				Graffiti:              b.Body.Graffiti,
+				ProposerSlashings:     b.Body.ProposerSlashings,
+				AttesterSlashings:     b.Body.AttesterSlashings,
+				Attestations:          b.Body.Attestations,
+				Deposits:              b.Body.Deposits,
+				VoluntaryExits:        b.Body.VoluntaryExits,
+				SyncAggregate:         b.Body.SyncAggregate,
+				ExecutionPayload:      payload,
+				BlsToExecutionChanges: changes,
+			},
+		}
+		// Compute state root with the newly constructed block.
+		wsb, err := consensusblocks.NewSignedBeaconBlock(
+			&ethpb.SignedBeaconBlockCapella{Block: blk, Signature: make([]byte, 96)},
+		)
+		if err != nil {
+			return nil, err
+		}
+		stateRoot, err := vs.computeStateRoot(ctx, wsb)
+		if err != nil {
+			interop.WriteBlockToDisk(wsb, true /*failed*/)
+			return nil, fmt.Errorf("could not compute state root: %v", err)
+		}
+		blk.StateRoot = stateRoot
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Capella{Capella: blk}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported payload type %T", payload)
 	}
-	blk.StateRoot = stateRoot
-	return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}}, nil
 }
 
-// This function retrieves the payload header given the slot number and the validator index.
-// It's a no-op if the latest head block is not versioned bellatrix.
-func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot types.Slot, idx types.ValidatorIndex) (*enginev1.ExecutionPayloadHeader, error) {
+// localPayload is the outcome of requesting an execution payload from the local execution client.
+type localPayload struct {
+	payload interfaces.ExecutionData
+	value   *big.Int
+	err     error
+}
+
+// getBuilderBlockOrLocalPayload requests a header from the builder and an execution payload from the local
+// execution client in parallel, then evaluates the builder bid against the local block value. It returns the
+// blinded builder block if the builder bid wins, otherwise the local execution payload.
+func (vs *Server) getBuilderBlockOrLocalPayload(ctx context.Context, altairBlk *ethpb.BeaconBlockAltair) (*ethpb.GenericBeaconBlock, interfaces.ExecutionData, error) {
+	localC := make(chan localPayload, 1)
+	go func() {
+		p, v, err := vs.getExecutionPayload(ctx, altairBlk.Slot, altairBlk.ProposerIndex, bytesutil.ToBytes32(altairBlk.ParentRoot))
+		localC <- localPayload{payload: p, value: v, err: err}
+	}()
+	builderReady, b, bidValue, builderErr := vs.getAndBuildBlindBlock(ctx, altairBlk)
+	local := <-localC
+
+	switch {
+	case builderErr != nil:
+		// In the event of an error, the node should fall back to default execution engine for building block.
+		log.WithError(builderErr).Error("Failed to build a block from external builder, falling " +
+			"back to local execution client")
+		builderGetPayloadMissCount.Inc()
+		builderBidDecisions.WithLabelValues(builderBidDecisionLocal, "builder_error").Inc()
+	case !builderReady:
+		log.WithField("slot", altairBlk.Slot).Info("Builder is not ready, proposing the local execution payload")
+		builderBidDecisions.WithLabelValues(builderBidDecisionLocal, "builder_not_ready").Inc()
+	case local.err != nil:
+		log.WithError(local.err).Error("Failed to get payload from local execution client, " +
+			"proposing the builder block")
+		builderBidDecisions.WithLabelValues(builderBidDecisionBuilder, "local_error").Inc()
+		return b, nil, nil
+	case builderBidWins(altairBlk.Slot, bidValue, local.value):
+		return b, nil, nil
+	}
+	if local.err != nil {
+		return nil, nil, local.err
+	}
+	return nil, local.payload, nil
+}
+
+// This function retrieves the payload header given the slot number and the validator index, along with
+// the value of the builder bid in wei. It's a no-op if the latest head block is not versioned bellatrix or later.
+func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot types.Slot, idx types.ValidatorIndex) (interfaces.ExecutionData, *big.Int, error) {
 	b, err := vs.HeadFetcher.HeadBlock(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if blocks.IsPreBellatrixVersion(b.Version()) {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	h, err := b.Block().Body().Execution()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	pk, err := vs.HeadFetcher.HeadValidatorIndexToPublicKey(ctx, idx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	bid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk)
+	signedBid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk, func(bid builder.SignedBid) error {
+		return vs.checkBuilderBid(ctx, slot, idx, h, bid)
+	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, nil, errors.New("builder returned nil bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get bid header")
+	}
+	v := bytesutil.LittleEndianBytesToBigInt(bid.Value())
+
+	log.WithFields(logrus.Fields{
+		"value":         v.String(),
+		"builderPubKey": fmt.Sprintf("%#x", bid.Pubkey()),
+		"blockHash":     fmt.Sprintf("%#x", header.BlockHash()),
+	}).Info("Received header with bid")
+	return header, v, nil
+}
+
+// checkBuilderBid checks that a builder bid can be proposed on top of the parent payload by the validator with
+// the given index at the given slot. The signature of the bid is verified by the builder service beforehand.
+func (vs *Server) checkBuilderBid(
+	ctx context.Context, slot types.Slot, idx types.ValidatorIndex, parent interfaces.ExecutionData, signedBid builder.SignedBid,
+) error {
+	if signedBid == nil || signedBid.IsNil() {
+		return errors.New("builder returned nil bid")
+	}
+	expectedVersion := version.Bellatrix
+	if slots.ToEpoch(slot) >= params.BeaconConfig().CapellaForkEpoch {
+		expectedVersion = version.Capella
+	}
+	if signedBid.Version() != expectedVersion {
+		return fmt.Errorf("builder returned %s bid for a %s slot", version.String(signedBid.Version()), version.String(expectedVersion))
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return errors.Wrap(err, "could not get bid")
 	}
-	if bid == nil || bid.Message == nil {
-		return nil, errors.New("builder returned nil bid")
+	if bid == nil || bid.IsNil() {
+		return errors.New("builder returned nil bid")
 	}
 
-	v := bytesutil.LittleEndianBytesToBigInt(bid.Message.Value)
+	v := bytesutil.LittleEndianBytesToBigInt(bid.Value())
 	if v.String() == "0" {
-		return nil, errors.New("builder returned header with 0 bid amount")
+		return errors.New("builder returned header with 0 bid amount")
 	}
 
+	header, err := bid.Header()
+	if err != nil {
+		return errors.Wrap(err, "could not get bid header")
+	}
+	txRoot, err := header.TransactionsRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get transaction root")
+	}
 	emptyRoot, err := ssz.TransactionsRoot([][]byte{})
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	if bytesutil.ToBytes32(bid.Message.Header.TransactionsRoot) == emptyRoot {
-		return nil, errors.New("builder returned header with an empty tx root")
+	if bytesutil.ToBytes32(txRoot) == emptyRoot {
+		return errors.New("builder returned header with an empty tx root")
 	}
 
-	if !bytes.Equal(bid.Message.Header.ParentHash, h.BlockHash()) {
-		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", bid.Message.Header.ParentHash, h.BlockHash())
+	if !bytes.Equal(header.ParentHash(), parent.BlockHash()) {
+		return fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parent.BlockHash())
 	}
 
 	t, err := slots.ToTime(uint64(vs.TimeFetcher.GenesisTime().Unix()), slot)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if bid.Message.Header.Timestamp != uint64(t.Unix()) {
-		return nil, fmt.Errorf("incorrect timestamp %d != %d", bid.Message.Header.Timestamp, uint64(t.Unix()))
+	if header.Timestamp() != uint64(t.Unix()) {
+		return fmt.Errorf("incorrect timestamp %d != %d", header.Timestamp(), uint64(t.Unix()))
 	}
 
-	if err := validateBuilderSignature(bid); err != nil {
-		return nil, errors.Wrap(err, "could not validate builder signature")
+	if err := vs.validateBuilderGasLimit(ctx, idx, parent.GasLimit(), header.GasLimit()); err != nil {
+		return errors.Wrap(err, "could not validate builder gas limit")
 	}
 
-	log.WithFields(logrus.Fields{
-		"value":         v.String(),
-		"builderPubKey": fmt.Sprintf("%#x", bid.Message.Pubkey),
-		"blockHash":     fmt.Sprintf("%#x", bid.Message.Header.BlockHash),
-	}).Info("Received header with bid")
-	return bid.Message.Header, nil
+	if signedBid.Version() >= version.Capella {
+		if err := vs.validateBuilderWithdrawals(ctx, slot, header); err != nil {
+			return errors.Wrap(err, "could not validate builder withdrawals")
+		}
+	}
+	return nil
+}
+
+// validateBuilderWithdrawals checks that the withdrawals root of a builder header matches the withdrawals
+// expected by the head state at the proposal slot. A relay must not be able to change the withdrawals
+// the proposer signs over.
+func (vs *Server) validateBuilderWithdrawals(ctx context.Context, slot types.Slot, header interfaces.ExecutionData) error {
+	st, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	st, err = transition.ProcessSlotsIfPossible(ctx, st, slot)
+	if err != nil {
+		return err
+	}
+	expected, err := st.ExpectedWithdrawals()
+	if err != nil {
+		return errors.Wrap(err, "could not get expected withdrawals")
+	}
+	expectedRoot, err := ssz.WithdrawalSliceRoot(hash.CustomSHA256Hasher(), expected, fieldparams.MaxWithdrawalsPerPayload)
+	if err != nil {
+		return errors.Wrap(err, "could not compute expected withdrawals root")
+	}
+	root, err := header.WithdrawalsRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get withdrawals root")
+	}
+	if !bytes.Equal(root, expectedRoot[:]) {
+		return fmt.Errorf("incorrect withdrawals root %#x != %#x", root, expectedRoot)
+	}
+	return nil
 }
 
 // This function constructs the builder block given the input altair block and the header. It returns a generic beacon block for signing
-func (vs *Server) buildBlindBlock(ctx context.Context, b *ethpb.BeaconBlockAltair, h *enginev1.ExecutionPayloadHeader) (*ethpb.GenericBeaconBlock, error) {
+func (vs *Server) buildBlindBlock(ctx context.Context, b *ethpb.BeaconBlockAltair, h interfaces.ExecutionData) (*ethpb.GenericBeaconBlock, error) {
 	if b == nil || b.Body == nil {
 		return nil, errors.New("nil block")
 	}
-	if h == nil {
+	if h == nil || h.IsNil() {
 		return nil, errors.New("nil header")
 	}
 
-	blk := &ethpb.BlindedBeaconBlockBellatrix{
-		Slot:          b.Slot,
-		ProposerIndex: b.ProposerIndex,
-		ParentRoot:    b.ParentRoot,
-		StateRoot:     params.BeaconConfig().ZeroHash[:],
-		Body: &ethpb.BlindedBeaconBlockBodyBellatrix{
-			RandaoReveal:           b.Body.RandaoReveal,
-			Eth1Data:               b.Body.Eth1Data,
-			Graffiti:               b.Body.Graffiti,
-			ProposerSlashings:      b.Body.ProposerSlashings,
-			AttesterSlashings:      b.Body.AttesterSlashings,
-			Attestations:           b.Body.Attestations,
-			Deposits:               b.Body.Deposits,
-			VoluntaryExits:         b.Body.VoluntaryExits,
-			SyncAggregate:          b.Body.SyncAggregate,
-			ExecutionPayloadHeader: h,
-		},
-	}
-	wsb, err := consensusblocks.NewSignedBeaconBlock(
-		&ethpb.SignedBlindedBeaconBlockBellatrix{Block: blk, Signature: make([]byte, 96)},
-	)
+	switch header := h.Proto().(type) {
+	case *enginev1.ExecutionPayloadHeader:
+		blk := &ethpb.BlindedBeaconBlockBellatrix{
+			Slot:          b.Slot,
+			ProposerIndex: b.ProposerIndex,
+			ParentRoot:    b.ParentRoot,
+			StateRoot:     params.BeaconConfig().ZeroHash[:],
+			Body: &ethpb.BlindedBeaconBlockBodyBellatrix{
+				RandaoReveal:           b.Body.RandaoReveal,
+				Eth1Data:               b.Body.Eth1Data,
+				Graffiti:               b.Body.Graffiti,
+				ProposerSlashings:      b.Body.ProposerSlashings,
+				AttesterSlashings:      b.Body.AttesterSlashings,
+				Attestations:           b.Body.Attestations,
+				Deposits:               b.Body.Deposits,
+				VoluntaryExits:         b.Body.VoluntaryExits,
+				SyncAggregate:          b.Body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+			},
+		}
+		wsb, err := consensusblocks.NewSignedBeaconBlock(
+			&ethpb.SignedBlindedBeaconBlockBellatrix{Block: blk, Signature: make([]byte, 96)},
+		)
+		if err != nil {
+			return nil, err
+		}
+		stateRoot, err := vs.computeStateRoot(ctx, wsb)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute state root")
+		}
+		blk.StateRoot = stateRoot
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: blk}}, nil
+	case *enginev1.ExecutionPayloadHeaderCapella:
+		changes, err := vs.blsToExecChanges(ctx)
+		if err != nil {
+			return nil, err
+		}
+		blk := &ethpb.BlindedBeaconBlockCapella{
+			Slot:          b.Slot,
+			ProposerIndex: b.ProposerIndex,
+			ParentRoot:    b.ParentRoot,
+			StateRoot:     params.BeaconConfig().ZeroHash[:],
+			Body: &ethpb.BlindedBeaconBlockBodyCapella{
+				RandaoReveal:           b.Body.RandaoReveal,
+				Eth1Data:               b.Body.Eth1Data,
+				Graffiti:               b.Body.Graffiti,
+				ProposerSlashings:      b.Body.ProposerSlashings,
+				AttesterSlashings:      b.Body.AttesterSlashings,
+				Attestations:           b.Body.Attestations,
+				Deposits:               b.Body.Deposits,
+				VoluntaryExits:         b.Body.VoluntaryExits,
+				SyncAggregate:          b.Body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+				BlsToExecutionChanges:  changes,
+			},
+		}
+		wsb, err := consensusblocks.NewSignedBeaconBlock(
+			&ethpb.SignedBlindedBeaconBlockCapella{Block: blk, Signature: make([]byte, 96)},
+		)
+		if err != nil {
+			return nil, err
+		}
+		stateRoot, err := vs.computeStateRoot(ctx, wsb)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute state root")
+		}
+		blk.StateRoot = stateRoot
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedCapella{BlindedCapella: blk}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported header type %T", header)
+	}
+}
+
+// blsToExecChanges returns the BLS to execution changes of the pool which can be included on top of the head state.
+func (vs *Server) blsToExecChanges(ctx context.Context) ([]*ethpb.SignedBLSToExecutionChange, error) {
+	head, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "could not get head state")
 	}
-	stateRoot, err := vs.computeStateRoot(ctx, wsb)
+	changes, err := vs.BLSChangesPool.BLSToExecChangesForInclusion(head)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not compute state root")
+		return nil, errors.Wrap(err, "could not get BLS to execution changes")
 	}
-	blk.StateRoot = stateRoot
-	return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: blk}}, nil
+	return changes, nil
 }
 
 // This function retrieves the full payload block using the input blind block. This input must be versioned as
-// bellatrix blind block. The output block will contain the full payload. The original header block
+// bellatrix or capella blind block. The output block will contain the full payload. The original header block
 // will be returned the block builder is not configured.
 func (vs *Server) unblindBuilderBlock(ctx context.Context, b interfaces.SignedBeaconBlock) (interfaces.SignedBeaconBlock, error) {
 	if err := consensusblocks.BeaconBlockIsNil(b); err != nil {
 		return nil, err
 	}
 
-	// No-op if the input block is not version blind and bellatrix.
-	if b.Version() != version.Bellatrix || !b.IsBlinded() {
+	// No-op if the input block is not a blinded, execution-enabled block.
+	if blocks.IsPreBellatrixVersion(b.Version()) || !b.IsBlinded() {
 		return b, nil
 	}
 	// No-op nothing if the builder has not been configured.
@@ -222,54 +460,21 @@ func (vs *Server) unblindBuilderBlock(ctx context.Context, b interfaces.SignedBe
 		return b, nil
 	}
 
-	agg, err := b.Block().Body().SyncAggregate()
-	if err != nil {
-		return nil, err
-	}
 	h, err := b.Block().Body().Execution()
 	if err != nil {
 		return nil, err
 	}
-	header, ok := h.Proto().(*enginev1.ExecutionPayloadHeader)
-	if !ok {
-		return nil, errors.New("execution data must be execution payload header")
-	}
-	parentRoot := b.Block().ParentRoot()
-	stateRoot := b.Block().StateRoot()
-	randaoReveal := b.Block().Body().RandaoReveal()
-	graffiti := b.Block().Body().Graffiti()
-	sig := b.Signature()
-	sb := &ethpb.SignedBlindedBeaconBlockBellatrix{
-		Block: &ethpb.BlindedBeaconBlockBellatrix{
-			Slot:          b.Block().Slot(),
-			ProposerIndex: b.Block().ProposerIndex(),
-			ParentRoot:    parentRoot[:],
-			StateRoot:     stateRoot[:],
-			Body: &ethpb.BlindedBeaconBlockBodyBellatrix{
-				RandaoReveal:           randaoReveal[:],
-				Eth1Data:               b.Block().Body().Eth1Data(),
-				Graffiti:               graffiti[:],
-				ProposerSlashings:      b.Block().Body().ProposerSlashings(),
-				AttesterSlashings:      b.Block().Body().AttesterSlashings(),
-				Attestations:           b.Block().Body().Attestations(),
-				Deposits:               b.Block().Body().Deposits(),
-				VoluntaryExits:         b.Block().Body().VoluntaryExits(),
-				SyncAggregate:          agg,
-				ExecutionPayloadHeader: header,
-			},
-		},
-		Signature: sig[:],
-	}
-
-	payload, err := vs.BlockBuilder.SubmitBlindedBlock(ctx, sb)
+	payload, err := vs.BlockBuilder.SubmitBlindedBlock(ctx, b)
 	if err != nil {
 		return nil, err
 	}
-	headerRoot, err := header.HashTreeRoot()
+	if payload == nil || payload.IsNil() {
+		return nil, errors.New("builder returned nil payload")
+	}
+	headerRoot, err := h.HashTreeRoot()
 	if err != nil {
 		return nil, err
 	}
-
 	payloadRoot, err := payload.HashTreeRoot()
 	if err != nil {
 		return nil, err
@@ -279,28 +484,11 @@ func (vs *Server) unblindBuilderBlock(ctx context.Context, b interfaces.SignedBe
 			"%#x != %#x", headerRoot, payloadRoot)
 	}
 
-	bb := &ethpb.SignedBeaconBlockBellatrix{
-		Block: &ethpb.BeaconBlockBellatrix{
-			Slot:          sb.Block.Slot,
-			ProposerIndex: sb.Block.ProposerIndex,
-			ParentRoot:    sb.Block.ParentRoot,
-			StateRoot:     sb.Block.StateRoot,
-			Body: &ethpb.BeaconBlockBodyBellatrix{
-				RandaoReveal:      sb.Block.Body.RandaoReveal,
-				Eth1Data:          sb.Block.Body.Eth1Data,
-				Graffiti:          sb.Block.Body.Graffiti,
-				ProposerSlashings: sb.Block.Body.ProposerSlashings,
-				AttesterSlashings: sb.Block.Body.AttesterSlashings,
-				Attestations:      sb.Block.Body.Attestations,
-				Deposits:          sb.Block.Body.Deposits,
-				VoluntaryExits:    sb.Block.Body.VoluntaryExits,
-				SyncAggregate:     agg,
-				ExecutionPayload:  payload,
-			},
-		},
-		Signature: sb.Signature,
+	wb, err := consensusblocks.BuildSignedBeaconBlockFromExecutionPayload(b, payload.Proto())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not build full block from payload")
 	}
-	wb, err := consensusblocks.NewSignedBeaconBlock(bb)
+	txs, err := payload.Transactions()
 	if err != nil {
 		return nil, err
 	}
@@ -308,9 +496,9 @@ func (vs *Server) unblindBuilderBlock(ctx context.Context, b interfaces.SignedBe
 	log.WithFields(logrus.Fields{
 		"blockHash":    fmt.Sprintf("%#x", h.BlockHash()),
 		"feeRecipient": fmt.Sprintf("%#x", h.FeeRecipient()),
-		"gasUsed":      h.GasUsed,
+		"gasUsed":      h.GasUsed(),
 		"slot":         b.Block().Slot(),
-		"txs":          len(payload.Transactions),
+		"txs":          len(txs),
 	}).Info("Retrieved full payload from builder")
 
 	return wb, nil
@@ -338,56 +526,51 @@ func (vs *Server) readyForBuilder(ctx context.Context) (bool, error) {
 // If the status is false that means builder the header block is disallowed.
 // This routine is time limited by `blockBuilderTimeout`.
 func (vs *Server) GetAndBuildBlindBlock(ctx context.Context, b *ethpb.BeaconBlockAltair) (bool, *ethpb.GenericBeaconBlock, error) {
+	ready, gb, _, err := vs.getAndBuildBlindBlock(ctx, b)
+	return ready, gb, err
+}
+
+// getAndBuildBlindBlock is GetAndBuildBlindBlock that also returns the value of the builder bid in wei.
+func (vs *Server) getAndBuildBlindBlock(ctx context.Context, b *ethpb.BeaconBlockAltair) (bool, *ethpb.GenericBeaconBlock, *big.Int, error) {
 	// No op. Builder is not defined. User did not specify a user URL. We should use local EE.
 	if vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() {
-		return false, nil, nil
+		return false, nil, nil, nil
 	}
 	ctx, cancel := context.WithTimeout(ctx, blockBuilderTimeout)
 	defer cancel()
 	// Does the protocol allow for builder at this current moment. Builder is only allowed post merge after finalization.
 	ready, err := vs.readyForBuilder(ctx)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not determine if builder is ready")
+		return false, nil, nil, errors.Wrap(err, "could not determine if builder is ready")
 	}
 	if !ready {
-		return false, nil, nil
+		return false, nil, nil, nil
 	}
 
 	circuitBreak, err := vs.circuitBreakBuilder(b.Slot)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not determine if builder circuit breaker condition")
+		return false, nil, nil, errors.Wrap(err, "could not determine if builder circuit breaker condition")
 	}
 	if circuitBreak {
-		return false, nil, nil
+		return false, nil, nil, nil
 	}
 
-	h, err := vs.getPayloadHeaderFromBuilder(ctx, b.Slot, b.ProposerIndex)
+	h, bidValue, err := vs.getPayloadHeaderFromBuilder(ctx, b.Slot, b.ProposerIndex)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not get payload header")
+		return false, nil, nil, errors.Wrap(err, "could not get payload header")
+	}
+	if h == nil || h.IsNil() {
+		return false, nil, nil, errors.New("builder returned nil header")
 	}
 	log.WithFields(logrus.Fields{
-		"blockHash":    fmt.Sprintf("%#x", h.BlockHash),
-		"feeRecipient": fmt.Sprintf("%#x", h.FeeRecipient),
-		"gasUsed":      h.GasUsed,
+		"blockHash":    fmt.Sprintf("%#x", h.BlockHash()),
+		"feeRecipient": fmt.Sprintf("%#x", h.FeeRecipient()),
+		"gasUsed":      h.GasUsed(),
 		"slot":         b.Slot,
 	}).Info("Retrieved header from builder")
 	gb, err := vs.buildBlindBlock(ctx, b, h)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "could not combine altair block with payload header")
-	}
-	return true, gb, nil
-}
-
-// Validates builder signature and returns an error if the signature is invalid.
-func validateBuilderSignature(bid *ethpb.SignedBuilderBid) error {
-	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
-		nil, /* fork version */
-		nil /* genesis val root */)
-	if err != nil {
-		return err
-	}
-	if bid == nil || bid.Message == nil {
-		return errors.New("nil builder bid")
+		return false, nil, nil, errors.Wrap(err, "could not combine altair block with payload header")
 	}
-	return signing.VerifySigningRoot(bid.Message, bid.Message.Pubkey, bid.Signature, d)
+	return true, gb, bidValue, nil
 }
@@ -19,6 +19,7 @@ import (
 	mockExecution "github.com/prysmaticlabs/prysm/v3/beacon-chain/execution/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/attestations"
+	blstoexecmock "github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/blstoexec/mock"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/voluntaryexits"
@@ -30,6 +31,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/hash"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v3/encoding/ssz"
 	v1 "github.com/prysmaticlabs/prysm/v3/proto/engine/v1"
@@ -38,6 +40,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/testing/util"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestServer_buildHeaderBlock(t *testing.T) {
@@ -79,17 +82,41 @@ func TestServer_buildHeaderBlock(t *testing.T) {
 		TransactionsRoot: make([]byte, fieldparams.RootLength),
 		ExtraData:        make([]byte, 0),
 	}
-	got, err := vs.buildBlindBlock(ctx, b1.Block, h)
+	wh, err := blocks.WrappedExecutionPayloadHeader(h)
+	require.NoError(t, err)
+	got, err := vs.buildBlindBlock(ctx, b1.Block, wh)
 	require.NoError(t, err)
 	require.DeepEqual(t, h, got.GetBlindedBellatrix().Body.ExecutionPayloadHeader)
 
-	_, err = vs.buildBlindBlock(ctx, nil, h)
+	_, err = vs.buildBlindBlock(ctx, nil, wh)
 	require.ErrorContains(t, "nil block", err)
 
 	_, err = vs.buildBlindBlock(ctx, b1.Block, nil)
 	require.ErrorContains(t, "nil header", err)
 }
 
+func TestServer_blsToExecChanges(t *testing.T) {
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	changes := []*ethpb.SignedBLSToExecutionChange{
+		{
+			Message: &ethpb.BLSToExecutionChange{
+				ValidatorIndex:     1,
+				FromBlsPubkey:      make([]byte, fieldparams.BLSPubkeyLength),
+				ToExecutionAddress: make([]byte, fieldparams.FeeRecipientLength),
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+	}
+	vs := &Server{
+		HeadFetcher:    &blockchainTest.ChainService{State: st},
+		BLSChangesPool: &blstoexecmock.PoolMock{Changes: changes},
+	}
+	got, err := vs.blsToExecChanges(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, changes, got)
+}
+
 func TestServer_getPayloadHeader(t *testing.T) {
 	emptyRoot, err := ssz.TransactionsRoot([][]byte{})
 	require.NoError(t, err)
@@ -125,13 +152,51 @@ func TestServer_getPayloadHeader(t *testing.T) {
 	}
 
 	require.NoError(t, err)
+	capellaHeadState, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	expectedWithdrawals, err := capellaHeadState.ExpectedWithdrawals()
+	require.NoError(t, err)
+	withdrawalsRoot, err := ssz.WithdrawalSliceRoot(hash.CustomSHA256Hasher(), expectedWithdrawals, fieldparams.MaxWithdrawalsPerPayload)
+	require.NoError(t, err)
+	capellaBid := func(withdrawalsRoot []byte) *ethpb.SignedBuilderBidCapella {
+		bid := &ethpb.BuilderBidCapella{
+			Header: &v1.ExecutionPayloadHeaderCapella{
+				FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+				StateRoot:        make([]byte, fieldparams.RootLength),
+				ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+				LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+				PrevRandao:       make([]byte, fieldparams.RootLength),
+				BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+				BlockHash:        make([]byte, fieldparams.RootLength),
+				TransactionsRoot: bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+				ParentHash:       params.BeaconConfig().ZeroHash[:],
+				Timestamp:        uint64(ti.Unix()),
+				WithdrawalsRoot:  withdrawalsRoot,
+			},
+			Pubkey: sk.PublicKey().Marshal(),
+			Value:  bytesutil.PadTo([]byte{1, 2, 3}, 32),
+		}
+		sr, err := signing.ComputeSigningRoot(bid, domain)
+		require.NoError(t, err)
+		return &ethpb.SignedBuilderBidCapella{
+			Message:   bid,
+			Signature: sk.Sign(sr[:]).Marshal(),
+		}
+	}
+	goodCapellaBid := capellaBid(withdrawalsRoot[:])
+	gasLimitHeader, ok := proto.Clone(bid.Header).(*v1.ExecutionPayloadHeader)
+	require.Equal(t, true, ok)
+	gasLimitHeader.GasLimit = 123
+
 	tests := []struct {
 		name           string
 		head           interfaces.SignedBeaconBlock
 		mock           *builderTest.MockBuilderService
 		fetcher        *blockchainTest.ChainService
+		capella        bool
+		registration   *ethpb.ValidatorRegistrationV1
 		err            string
-		returnedHeader *v1.ExecutionPayloadHeader
+		returnedHeader proto.Message
 	}{
 		{
 			name: "head is not bellatrix ready",
@@ -212,20 +277,109 @@ func TestServer_getPayloadHeader(t *testing.T) {
 					return wb
 				}(),
 			},
+			registration:   &ethpb.ValidatorRegistrationV1{GasLimit: 30000000},
 			returnedHeader: bid.Header,
 		},
+		{
+			name: "incorrect gas limit",
+			mock: &builderTest.MockBuilderService{
+				Bid: &ethpb.SignedBuilderBid{
+					Message: &ethpb.BuilderBid{
+						Header: gasLimitHeader,
+						Pubkey: bid.Pubkey,
+						Value:  bid.Value,
+					},
+					Signature: sBid.Signature,
+				},
+			},
+			fetcher: &blockchainTest.ChainService{
+				Block: func() interfaces.SignedBeaconBlock {
+					wb, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockBellatrix())
+					require.NoError(t, err)
+					return wb
+				}(),
+			},
+			registration: &ethpb.ValidatorRegistrationV1{GasLimit: 30000000},
+			err:          "incorrect gas limit 123 != 0",
+		},
+		{
+			name: "capella bid before capella",
+			mock: &builderTest.MockBuilderService{
+				BidCapella: goodCapellaBid,
+			},
+			fetcher: &blockchainTest.ChainService{
+				Block: func() interfaces.SignedBeaconBlock {
+					wb, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockBellatrix())
+					require.NoError(t, err)
+					return wb
+				}(),
+			},
+			err: "builder returned capella bid for a bellatrix slot",
+		},
+		{
+			name:    "capella bid with incorrect withdrawals",
+			capella: true,
+			mock: &builderTest.MockBuilderService{
+				BidCapella: capellaBid(bytesutil.PadTo([]byte{1}, fieldparams.RootLength)),
+			},
+			fetcher: &blockchainTest.ChainService{
+				Block: func() interfaces.SignedBeaconBlock {
+					wb, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+					require.NoError(t, err)
+					return wb
+				}(),
+				State: capellaHeadState,
+			},
+			err: "incorrect withdrawals root",
+		},
+		{
+			name:    "can get capella header",
+			capella: true,
+			mock: &builderTest.MockBuilderService{
+				BidCapella: goodCapellaBid,
+			},
+			fetcher: &blockchainTest.ChainService{
+				Block: func() interfaces.SignedBeaconBlock {
+					wb, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+					require.NoError(t, err)
+					return wb
+				}(),
+				State: capellaHeadState,
+			},
+			returnedHeader: goodCapellaBid.Message.Header,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			vs := &Server{BlockBuilder: tc.mock, HeadFetcher: tc.fetcher, TimeFetcher: &blockchainTest.ChainService{
+			if tc.capella {
+				params.SetupTestConfigCleanup(t)
+				cfg := params.BeaconConfig().Copy()
+				cfg.CapellaForkEpoch = 0
+				params.OverrideBeaconConfig(cfg)
+			}
+			beaconDB := dbTest.SetupDB(t)
+			if tc.registration != nil {
+				reg := &ethpb.ValidatorRegistrationV1{
+					FeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
+					GasLimit:     tc.registration.GasLimit,
+					Pubkey:       make([]byte, fieldparams.BLSPubkeyLength),
+				}
+				require.NoError(t, beaconDB.SaveRegistrationsByValidatorIDs(context.Background(), []types.ValidatorIndex{0}, []*ethpb.ValidatorRegistrationV1{reg}))
+			}
+			vs := &Server{BeaconDB: beaconDB, BlockBuilder: tc.mock, HeadFetcher: tc.fetcher, TimeFetcher: &blockchainTest.ChainService{
 				Genesis: time.Now(),
 			}}
-			h, err := vs.getPayloadHeaderFromBuilder(context.Background(), 0, 0)
+			h, v, err := vs.getPayloadHeaderFromBuilder(context.Background(), 0, 0)
 			if tc.err != "" {
 				require.ErrorContains(t, tc.err, err)
 			} else {
 				require.NoError(t, err)
-				require.DeepEqual(t, tc.returnedHeader, h)
+				if tc.returnedHeader == nil {
+					require.Equal(t, nil, h)
+				} else {
+					require.DeepEqual(t, tc.returnedHeader, h.Proto())
+					require.Equal(t, "197121", v.String())
+				}
 			}
 		})
 	}
@@ -234,6 +388,25 @@ func TestServer_getPayloadHeader(t *testing.T) {
 func TestServer_getBuilderBlock(t *testing.T) {
 	p := emptyPayload()
 	p.GasLimit = 123
+	pCapella, err := blocks.WrappedExecutionPayloadCapella(&v1.ExecutionPayloadCapella{
+		ParentHash:    make([]byte, fieldparams.RootLength),
+		FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:    make([]byte, fieldparams.RootLength),
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		BlockHash:     make([]byte, fieldparams.RootLength),
+		Transactions:  make([][]byte, 0),
+		Withdrawals: []*v1.Withdrawal{{
+			Index:          1,
+			ValidatorIndex: 2,
+			Address:        make([]byte, fieldparams.FeeRecipientLength),
+			Amount:         3,
+		}},
+		GasLimit: 123,
+	})
+	require.NoError(t, err)
 
 	tests := []struct {
 		name        string
@@ -355,6 +528,33 @@ func TestServer_getBuilderBlock(t *testing.T) {
 				return wb
 			}(),
 		},
+		{
+			name: "can get capella payload",
+			blk: func() interfaces.SignedBeaconBlock {
+				b := util.NewBlindedBeaconBlockCapella()
+				b.Block.Slot = 1
+				b.Block.ProposerIndex = 2
+				header, err := blocks.PayloadToHeaderCapella(pCapella)
+				require.NoError(t, err)
+				b.Block.Body.ExecutionPayloadHeader = header
+				wb, err := blocks.NewSignedBeaconBlock(b)
+				require.NoError(t, err)
+				return wb
+			}(),
+			mock: &builderTest.MockBuilderService{
+				HasConfigured:  true,
+				PayloadCapella: pCapella.Proto().(*v1.ExecutionPayloadCapella),
+			},
+			returnedBlk: func() interfaces.SignedBeaconBlock {
+				b := util.NewBeaconBlockCapella()
+				b.Block.Slot = 1
+				b.Block.ProposerIndex = 2
+				b.Block.Body.ExecutionPayload = pCapella.Proto().(*v1.ExecutionPayloadCapella)
+				wb, err := blocks.NewSignedBeaconBlock(b)
+				require.NoError(t, err)
+				return wb
+			}(),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -884,37 +1084,3 @@ func TestServer_GetBellatrixBeaconBlock_BuilderCase(t *testing.T) {
 	require.LogsContain(t, hook, "Computed state root")
 	require.DeepEqual(t, h, bellatrixBlk.BlindedBellatrix.Body.ExecutionPayloadHeader) // Payload header should equal.
 }
-
-func TestServer_validateBuilderSignature(t *testing.T) {
-	sk, err := bls.RandKey()
-	require.NoError(t, err)
-	bid := &ethpb.BuilderBid{
-		Header: &v1.ExecutionPayloadHeader{
-			ParentHash:       make([]byte, fieldparams.RootLength),
-			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
-			StateRoot:        make([]byte, fieldparams.RootLength),
-			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
-			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
-			PrevRandao:       make([]byte, fieldparams.RootLength),
-			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
-			BlockHash:        make([]byte, fieldparams.RootLength),
-			TransactionsRoot: make([]byte, fieldparams.RootLength),
-			BlockNumber:      1,
-		},
-		Pubkey: sk.PublicKey().Marshal(),
-		Value:  bytesutil.PadTo([]byte{1, 2, 3}, 32),
-	}
-	d := params.BeaconConfig().DomainApplicationBuilder
-	domain, err := signing.ComputeDomain(d, nil, nil)
-	require.NoError(t, err)
-	sr, err := signing.ComputeSigningRoot(bid, domain)
-	require.NoError(t, err)
-	sBid := &ethpb.SignedBuilderBid{
-		Message:   bid,
-		Signature: sk.Sign(sr[:]).Marshal(),
-	}
-	require.NoError(t, validateBuilderSignature(sBid))
-
-	sBid.Message.Value = make([]byte, 32)
-	require.ErrorIs(t, validateBuilderSignature(sBid), signing.ErrSigFailedToVerify)
-}
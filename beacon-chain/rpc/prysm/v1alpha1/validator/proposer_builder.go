@@ -2,14 +2,32 @@ package validator
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/sirupsen/logrus"
 )
 
+// builderBidDecisions tracks the outcome of evaluating builder bids against the local execution payload.
+var builderBidDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "builder_bid_decisions_total",
+	Help: "The number of builder bids evaluated against the local execution payload, by chosen payload source and reason",
+}, []string{"decision", "reason"})
+
+const (
+	builderBidDecisionBuilder = "builder"
+	builderBidDecisionLocal   = "local"
+)
+
+// gasLimitBoundDivisor bounds how much an execution block may change its gas limit relative to its parent.
+const gasLimitBoundDivisor = 1024
+
 // Returns true if builder (ie outsourcing block construction) can be used. Both conditions have to meet:
 // - Validator has registered to use builder (ie called registerBuilder API end point)
 // - Circuit breaker has not been activated (ie the liveness of the chain is healthy)
@@ -87,3 +105,82 @@ func (vs *Server) circuitBreakBuilder(s types.Slot) (bool, error) {
 
 	return false, nil
 }
+
+// builderBidWins returns true if a builder bid of `bidValue` should be proposed over the local execution payload
+// worth `localValue`, both in wei. The bid has to be at least `MinBuilderBid` and has to exceed the local value
+// boosted by `LocalBlockValueBoost` percent. A nil local value means the execution client did not report one, in
+// which case the local payload is preferred if a boost is configured, and the local value counts as zero otherwise.
+func builderBidWins(slot types.Slot, bidValue, localValue *big.Int) bool {
+	cfg := params.BeaconConfig()
+	local := big.NewInt(0)
+	if localValue != nil {
+		local = localValue
+	}
+	minBid := new(big.Int).Mul(new(big.Int).SetUint64(cfg.MinBuilderBid), big.NewInt(1e9))
+	boostedLocal := new(big.Int).Mul(local, new(big.Int).SetUint64(100+cfg.LocalBlockValueBoost))
+	scaledBid := new(big.Int).Mul(bidValue, big.NewInt(100))
+
+	decision, reason := builderBidDecisionBuilder, "higher_value"
+	switch {
+	case bidValue.Cmp(minBid) < 0:
+		decision, reason = builderBidDecisionLocal, "below_min_bid"
+	case localValue == nil && cfg.LocalBlockValueBoost > 0:
+		decision, reason = builderBidDecisionLocal, "local_value_unknown"
+	case scaledBid.Cmp(boostedLocal) <= 0:
+		decision, reason = builderBidDecisionLocal, "local_value_higher"
+	case localValue == nil:
+		reason = "local_value_unknown"
+	}
+	builderBidDecisions.WithLabelValues(decision, reason).Inc()
+	log.WithFields(logrus.Fields{
+		"slot":         slot,
+		"builderValue": bidValue.String(),
+		"localValue":   local.String(),
+		"localBoost":   cfg.LocalBlockValueBoost,
+		"minBid":       minBid.String(),
+		"decision":     decision,
+		"reason":       reason,
+	}).Info("Evaluated builder bid against local execution payload")
+	return decision == builderBidDecisionBuilder
+}
+
+// validateBuilderGasLimit checks the gas limit of a builder header against the gas limit the proposer registered
+// with. An execution block can only move its gas limit by a bounded step from its parent, so the header is expected
+// to step from the parent gas limit towards the registered target. Validators without a registration are not checked.
+func (vs *Server) validateBuilderGasLimit(ctx context.Context, idx types.ValidatorIndex, parentGasLimit, gasLimit uint64) error {
+	if vs.BeaconDB == nil {
+		return errors.New("nil beacon db")
+	}
+	reg, err := vs.BeaconDB.RegistrationByValidatorID(ctx, idx)
+	switch {
+	case errors.Is(err, kv.ErrNotFoundFeeRecipient):
+		return nil
+	case err != nil:
+		return errors.Wrap(err, "could not get validator registration")
+	}
+	want := expectedGasLimit(parentGasLimit, reg.GasLimit)
+	if gasLimit != want {
+		return fmt.Errorf("incorrect gas limit %d != %d for registered gas limit %d", gasLimit, want, reg.GasLimit)
+	}
+	return nil
+}
+
+// expectedGasLimit returns the gas limit of a block built on a parent with `parentGasLimit` that aims for
+// `targetGasLimit`, moving at most the maximum allowed step per block.
+func expectedGasLimit(parentGasLimit, targetGasLimit uint64) uint64 {
+	maxStep := parentGasLimit / gasLimitBoundDivisor
+	if maxStep > 0 {
+		maxStep--
+	}
+	switch {
+	case targetGasLimit > parentGasLimit:
+		if targetGasLimit-parentGasLimit > maxStep {
+			return parentGasLimit + maxStep
+		}
+	case targetGasLimit < parentGasLimit:
+		if parentGasLimit-targetGasLimit > maxStep {
+			return parentGasLimit - maxStep
+		}
+	}
+	return targetGasLimit
+}
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"context"
+	"math/big"
 	"testing"
 	"time"
 
@@ -124,6 +125,82 @@ func TestServer_canUseBuilder(t *testing.T) {
 	require.Equal(t, true, reg)
 }
 
+func TestServer_builderBidWins(t *testing.T) {
+	gwei := func(v int64) *big.Int {
+		return new(big.Int).Mul(big.NewInt(v), big.NewInt(1e9))
+	}
+	tests := []struct {
+		name       string
+		boost      uint64
+		minBid     uint64
+		bid        *big.Int
+		local      *big.Int
+		wantWins   bool
+		wantReason string
+	}{
+		{name: "builder higher", bid: gwei(2), local: gwei(1), wantWins: true, wantReason: "higher_value"},
+		{name: "local higher", bid: gwei(1), local: gwei(2), wantWins: false, wantReason: "local_value_higher"},
+		{name: "equal values keep local", bid: gwei(1), local: gwei(1), wantWins: false, wantReason: "local_value_higher"},
+		{name: "unknown local value", bid: gwei(1), wantWins: true, wantReason: "local_value_unknown"},
+		{name: "unknown local value with boost keeps local", boost: 10, bid: gwei(1), wantWins: false, wantReason: "local_value_unknown"},
+		{name: "boost keeps local", boost: 10, bid: gwei(105), local: gwei(100), wantWins: false, wantReason: "local_value_higher"},
+		{name: "bid beats boost", boost: 10, bid: gwei(111), local: gwei(100), wantWins: true, wantReason: "higher_value"},
+		{name: "below min bid", minBid: 5, bid: gwei(4), local: gwei(1), wantWins: false, wantReason: "below_min_bid"},
+		{name: "at min bid", minBid: 5, bid: gwei(5), local: gwei(1), wantWins: true, wantReason: "higher_value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			params.SetupTestConfigCleanup(t)
+			cfg := params.BeaconConfig().Copy()
+			cfg.LocalBlockValueBoost = tt.boost
+			cfg.MinBuilderBid = tt.minBid
+			params.OverrideBeaconConfig(cfg)
+
+			require.Equal(t, tt.wantWins, builderBidWins(1, tt.bid, tt.local))
+			require.LogsContain(t, hook, "Evaluated builder bid against local execution payload")
+			require.LogsContain(t, hook, "reason="+tt.wantReason)
+		})
+	}
+}
+
+func TestServer_validateBuilderGasLimit(t *testing.T) {
+	ctx := context.Background()
+	vs := &Server{BeaconDB: dbTest.SetupDB(t)}
+
+	// Validators without a registration are not checked.
+	require.NoError(t, vs.validateBuilderGasLimit(ctx, 0, 30000000, 1))
+
+	f := bytesutil.PadTo([]byte{}, fieldparams.FeeRecipientLength)
+	p := bytesutil.PadTo([]byte{}, fieldparams.BLSPubkeyLength)
+	require.NoError(t, vs.BeaconDB.SaveRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{0},
+		[]*ethpb.ValidatorRegistrationV1{{FeeRecipient: f, Pubkey: p, GasLimit: 30000000}}))
+	require.NoError(t, vs.validateBuilderGasLimit(ctx, 0, 30000000, 30000000))
+	require.NoError(t, vs.validateBuilderGasLimit(ctx, 0, 29000000, 29028319))
+	require.ErrorContains(t, "incorrect gas limit 30000000 != 29028319", vs.validateBuilderGasLimit(ctx, 0, 29000000, 30000000))
+}
+
+func Test_expectedGasLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		parent uint64
+		target uint64
+		want   uint64
+	}{
+		{name: "at target", parent: 30000000, target: 30000000, want: 30000000},
+		{name: "increase capped", parent: 29000000, target: 30000000, want: 29028319},
+		{name: "decrease capped", parent: 30000000, target: 20000000, want: 29970705},
+		{name: "increase within step", parent: 30000000, target: 30001000, want: 30001000},
+		{name: "decrease within step", parent: 30000000, target: 29999000, want: 29999000},
+		{name: "zero parent", parent: 0, target: 30000000, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, expectedGasLimit(tt.parent, tt.target))
+		})
+	}
+}
+
 func createState(
 	slot types.Slot,
 	blockRoot [32]byte,
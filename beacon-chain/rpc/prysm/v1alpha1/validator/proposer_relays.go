@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/gateway/apimiddleware"
+	rpcmiddleware "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PrepareBeaconProposerWithRelays is the REST counterpart of PrepareBeaconProposer. Unlike the standard
+// prepare_beacon_proposer endpoint, it carries the builder relays chosen by each validator.
+func (vs *Server) PrepareBeaconProposerWithRelays(w http.ResponseWriter, r *http.Request) {
+	if r.Body == nil {
+		writeValidatorError(w, http.StatusBadRequest, "missing request body")
+		return
+	}
+	var jsonRecipients []*rpcmiddleware.FeeRecipientWithRelaysJson
+	if err := json.NewDecoder(r.Body).Decode(&jsonRecipients); err != nil {
+		writeValidatorError(w, http.StatusBadRequest, errors.Wrap(err, "could not decode request body").Error())
+		return
+	}
+	req := &ethpb.PrepareBeaconProposerRequest{
+		Recipients: make([]*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer, len(jsonRecipients)),
+	}
+	for i, recipient := range jsonRecipients {
+		if recipient == nil {
+			writeValidatorError(w, http.StatusBadRequest, "missing recipient")
+			return
+		}
+		index, err := strconv.ParseUint(recipient.ValidatorIndex, 10, 64)
+		if err != nil {
+			writeValidatorError(w, http.StatusBadRequest, "invalid validator index "+recipient.ValidatorIndex)
+			return
+		}
+		feeRecipient, err := hexutil.Decode(recipient.FeeRecipient)
+		if err != nil {
+			writeValidatorError(w, http.StatusBadRequest, "invalid fee recipient "+recipient.FeeRecipient)
+			return
+		}
+		req.Recipients[i] = &ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			ValidatorIndex: types.ValidatorIndex(index),
+			FeeRecipient:   feeRecipient,
+			Relays:         recipient.Relays,
+		}
+	}
+	if _, err := vs.PrepareBeaconProposer(r.Context(), req); err != nil {
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.InvalidArgument {
+			code = http.StatusBadRequest
+		}
+		writeValidatorError(w, code, status.Convert(err).Message())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeValidatorError(w http.ResponseWriter, code int, message string) {
+	apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{Message: message, Code: code}, nil)
+}
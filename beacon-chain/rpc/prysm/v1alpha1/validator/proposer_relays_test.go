@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	builderTest "github.com/prysmaticlabs/prysm/v3/beacon-chain/builder/testing"
+	dbutil "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestServer_PrepareBeaconProposerWithRelays(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	bb := &builderTest.MockBuilderService{HasConfigured: true}
+	vs := &Server{BeaconDB: db, BlockBuilder: bb}
+	prepare := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validator/prepare_beacon_proposer", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		vs.PrepareBeaconProposerWithRelays(w, req)
+		return w
+	}
+
+	w := prepare(`[` +
+		`{"validator_index":"1","fee_recipient":"0xca008b199c03a2a2f6bc2ed52d6404c4d8510b35","relays":["https://relay-a.example.com"]},` +
+		`{"validator_index":"2","fee_recipient":"0x8145d80111309e4621ed7632319664ac440b0198"}` +
+		`]`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.DeepEqual(t, map[types.ValidatorIndex][]string{1: {"https://relay-a.example.com"}}, bb.ValidatorRelays)
+	address, err := db.FeeRecipientByValidatorID(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0xca008b199c03a2a2f6bc2ed52d6404c4d8510b35"), address)
+
+	w = prepare(`[{"validator_index":"foo","fee_recipient":"0xca008b199c03a2a2f6bc2ed52d6404c4d8510b35"}]`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.StringContains(t, "invalid validator index foo", w.Body.String())
+
+	w = prepare(`[{"validator_index":"1","fee_recipient":"0xca00"}]`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.StringContains(t, "Invalid fee recipient address", w.Body.String())
+
+	w = prepare(`{`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.StringContains(t, "could not decode request body", w.Body.String())
+}
@@ -2445,6 +2445,45 @@ func TestProposer_PrepareBeaconProposerOverlapping(t *testing.T) {
 	require.LogsDoNotContain(t, hook, "Updated fee recipient addresses for validator indices")
 }
 
+func TestProposer_PrepareBeaconProposer_Relays(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+	bb := &builderTest.MockBuilderService{HasConfigured: true}
+	proposerServer := &Server{BeaconDB: db, BlockBuilder: bb}
+	f := make([]byte, fieldparams.FeeRecipientLength)
+	req := &ethpb.PrepareBeaconProposerRequest{
+		Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			{FeeRecipient: f, ValidatorIndex: 1, Relays: []string{"https://relay-a.example.com"}},
+			{FeeRecipient: f, ValidatorIndex: 2},
+		},
+	}
+	_, err := proposerServer.PrepareBeaconProposer(ctx, req)
+	require.NoError(t, err)
+	require.DeepEqual(t, map[types.ValidatorIndex][]string{1: {"https://relay-a.example.com"}}, bb.ValidatorRelays)
+
+	// Relays are updated even if the fee recipients did not change.
+	req.Recipients[1].Relays = []string{"https://relay-b.example.com"}
+	_, err = proposerServer.PrepareBeaconProposer(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"https://relay-b.example.com"}, bb.ValidatorRelays[2])
+
+	// Validators which do not choose relays keep their previous choice.
+	req.Recipients[0].Relays = nil
+	_, err = proposerServer.PrepareBeaconProposer(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"https://relay-a.example.com"}, bb.ValidatorRelays[1])
+
+	// Relays are not updated when a recipient is invalid.
+	_, err = proposerServer.PrepareBeaconProposer(ctx, &ethpb.PrepareBeaconProposerRequest{
+		Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			{FeeRecipient: f, ValidatorIndex: 1, Relays: []string{"https://relay-c.example.com"}},
+			{FeeRecipient: []byte{0x01}, ValidatorIndex: 2, Relays: []string{"https://relay-c.example.com"}},
+		},
+	})
+	require.ErrorContains(t, "Invalid fee recipient address", err)
+	assert.DeepEqual(t, []string{"https://relay-a.example.com"}, bb.ValidatorRelays[1])
+}
+
 func BenchmarkServer_PrepareBeaconProposer(b *testing.B) {
 	db := dbutil.SetupDB(b)
 	ctx := context.Background()
@@ -6,8 +6,10 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"sync"
 
+	"github.com/gorilla/mux"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -21,6 +23,7 @@ import (
 	opfeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/blstoexec"
@@ -36,11 +39,13 @@ import (
 	beaconv1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/beacon"
 	debugv1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/debug"
 	nodev1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/node"
+	slasherv1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/slasher"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/statefetcher"
 	slasherservice "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/v3/config/features"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	"github.com/prysmaticlabs/prysm/v3/io/logs"
@@ -86,6 +91,7 @@ type Config struct {
 	CanonicalFetcher              blockchain.CanonicalFetcher
 	ForkFetcher                   blockchain.ForkFetcher
 	FinalizationFetcher           blockchain.FinalizationFetcher
+	LightClientFetcher            blockchain.LightClientFetcher
 	AttestationReceiver           blockchain.AttestationReceiver
 	BlockReceiver                 blockchain.BlockReceiver
 	ExecutionChainService         execution.Chain
@@ -99,12 +105,16 @@ type Config struct {
 	ExitPool                      voluntaryexits.PoolManager
 	SlashingsPool                 slashings.PoolManager
 	SlashingChecker               slasherservice.SlashingChecker
+	PruneStatusFetcher            pruner.StatusFetcher
+	BackfillStatusFetcher         backfill.StatusFetcher
 	SyncCommitteeObjectPool       synccommittee.Pool
 	BLSChangesPool                blstoexec.PoolManager
 	SyncService                   chainSync.Checker
 	Broadcaster                   p2p.Broadcaster
 	PeersFetcher                  p2p.PeersProvider
 	PeerManager                   p2p.PeerManager
+	PeerBanner                    p2p.PeerBanner
+	TrustedPeerManager            p2p.TrustedPeerManager
 	MetadataProvider              p2p.MetadataProvider
 	DepositFetcher                depositcache.DepositFetcher
 	PendingDepositFetcher         depositcache.PendingDepositsFetcher
@@ -117,6 +127,7 @@ type Config struct {
 	ProposerIdsCache              *cache.ProposerPayloadIDsCache
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
 	BlockBuilder                  builder.BlockBuilder
+	Router                        *mux.Router
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -195,6 +206,7 @@ func (s *Service) Start() {
 		AttestationCache:       cache.NewAttestationCache(),
 		AttPool:                s.cfg.AttestationsPool,
 		ExitPool:               s.cfg.ExitPool,
+		BLSChangesPool:         s.cfg.BLSChangesPool,
 		HeadFetcher:            s.cfg.HeadFetcher,
 		HeadUpdater:            s.cfg.HeadUpdater,
 		ForkFetcher:            s.cfg.ForkFetcher,
@@ -246,18 +258,21 @@ func (s *Service) Start() {
 	}
 
 	nodeServer := &nodev1alpha1.Server{
-		LogsStreamer:         logs.NewStreamServer(),
-		StreamLogsBufferSize: 1000, // Enough to handle bursts of beacon node logs for gRPC streaming.
-		BeaconDB:             s.cfg.BeaconDB,
-		Server:               s.grpcServer,
-		SyncChecker:          s.cfg.SyncService,
-		GenesisTimeFetcher:   s.cfg.GenesisTimeFetcher,
-		PeersFetcher:         s.cfg.PeersFetcher,
-		PeerManager:          s.cfg.PeerManager,
-		GenesisFetcher:       s.cfg.GenesisFetcher,
-		POWChainInfoFetcher:  s.cfg.ExecutionChainInfoFetcher,
-		BeaconMonitoringHost: s.cfg.BeaconMonitoringHost,
-		BeaconMonitoringPort: s.cfg.BeaconMonitoringPort,
+		LogsStreamer:          logs.NewStreamServer(),
+		StreamLogsBufferSize:  1000, // Enough to handle bursts of beacon node logs for gRPC streaming.
+		BeaconDB:              s.cfg.BeaconDB,
+		Server:                s.grpcServer,
+		SyncChecker:           s.cfg.SyncService,
+		GenesisTimeFetcher:    s.cfg.GenesisTimeFetcher,
+		PeersFetcher:          s.cfg.PeersFetcher,
+		PeerManager:           s.cfg.PeerManager,
+		PeerBanner:            s.cfg.PeerBanner,
+		TrustedPeerManager:    s.cfg.TrustedPeerManager,
+		BackfillStatusFetcher: s.cfg.BackfillStatusFetcher,
+		GenesisFetcher:        s.cfg.GenesisFetcher,
+		POWChainInfoFetcher:   s.cfg.ExecutionChainInfoFetcher,
+		BeaconMonitoringHost:  s.cfg.BeaconMonitoringHost,
+		BeaconMonitoringPort:  s.cfg.BeaconMonitoringPort,
 	}
 	nodeServerV1 := &node.Server{
 		BeaconDB:              s.cfg.BeaconDB,
@@ -269,6 +284,8 @@ func (s *Service) Start() {
 		PeerManager:           s.cfg.PeerManager,
 		MetadataProvider:      s.cfg.MetadataProvider,
 		HeadFetcher:           s.cfg.HeadFetcher,
+		PruneStatusFetcher:    s.cfg.PruneStatusFetcher,
+		BackfillStatusFetcher: s.cfg.BackfillStatusFetcher,
 	}
 
 	beaconChainServer := &beaconv1alpha1.Server{
@@ -322,12 +339,37 @@ func (s *Service) Start() {
 		ExecutionPayloadReconstructor: s.cfg.ExecutionPayloadReconstructor,
 		BLSChangesPool:                s.cfg.BLSChangesPool,
 		FinalizationFetcher:           s.cfg.FinalizationFetcher,
+		LightClientFetcher:            s.cfg.LightClientFetcher,
 	}
 	ethpbv1alpha1.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbservice.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	ethpbv1alpha1.RegisterHealthServer(s.grpcServer, nodeServer)
 	ethpbv1alpha1.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpbservice.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
+	if s.cfg.Router != nil {
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/rewards/blocks/{block_id}", beaconChainServerV1.BlockRewards).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/rewards/attestations/{epoch}", beaconChainServerV1.AttestationRewards).Methods(http.MethodPost)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/rewards/sync_committee/{block_id}", beaconChainServerV1.SyncCommitteeRewards).Methods(http.MethodPost)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/bootstrap/{block_root}", beaconChainServerV1.LightClientBootstrap).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/updates", beaconChainServerV1.LightClientUpdatesByRange).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/finality_update", beaconChainServerV1.LightClientFinalityUpdate).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/optimistic_update", beaconChainServerV1.LightClientOptimisticUpdate).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/prysm/v1/node/bans", nodeServer.ListBans).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/prysm/v1/node/bans", nodeServer.BanPeer).Methods(http.MethodPost)
+		s.cfg.Router.HandleFunc("/prysm/v1/node/bans", nodeServer.UnbanPeer).Methods(http.MethodDelete)
+		s.cfg.Router.HandleFunc("/prysm/v1/node/trusted_peers", nodeServer.ListTrustedPeers).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/prysm/v1/node/trusted_peers", nodeServer.AddTrustedPeer).Methods(http.MethodPost)
+		s.cfg.Router.HandleFunc("/prysm/v1/node/trusted_peers", nodeServer.RemoveTrustedPeer).Methods(http.MethodDelete)
+		s.cfg.Router.HandleFunc("/prysm/v1/node/backfill", nodeServer.GetBackfillStatus).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/prysm/v1/validator/prepare_beacon_proposer", validatorServer.PrepareBeaconProposerWithRelays).Methods(http.MethodPost)
+	}
+	if s.cfg.SlashingChecker != nil {
+		ethpbv1alpha1.RegisterSlasherServer(s.grpcServer, &slasherv1alpha1.Server{
+			Ctx:               s.ctx,
+			SlashingChecker:   s.cfg.SlashingChecker,
+			OperationNotifier: s.cfg.OperationNotifier,
+		})
+	}
 	ethpbservice.RegisterEventsServer(s.grpcServer, &events.Server{
 		Ctx:               s.ctx,
 		StateNotifier:     s.cfg.StateNotifier,
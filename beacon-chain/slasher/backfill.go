@@ -0,0 +1,197 @@
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/filters"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// backfillBatchSize is the number of epochs backfilled between two checkpoints. The evidence
+// found in a batch is exported once the whole batch is done.
+const backfillBatchSize = types.Epoch(8)
+
+// backfill feeds the attestations and proposer headers of the blocks stored in the beacon
+// database through slashing detection, in batches of epochs. It resumes from the epoch
+// checkpointed in the slasher database and never goes further back than the history length.
+// It runs alongside the detection of the attestations and blocks received live, which only
+// waits for the epoch being backfilled. Live detection starts once the node is synced, so the
+// backfill goes on past the finalized checkpoint up to the head, until it catches up with it.
+func (s *Service) backfill(ctx context.Context) error {
+	finalized, err := s.serviceCfg.BeaconDatabase.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	endEpoch := finalized.Epoch
+	var startEpoch types.Epoch
+	if endEpoch > s.params.historyLength {
+		startEpoch = endEpoch - s.params.historyLength
+	}
+	checkpoint, err := s.serviceCfg.Database.BackfillCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill checkpoint")
+	}
+	if checkpointEpoch := slots.ToEpoch(checkpoint); checkpointEpoch > startEpoch {
+		startEpoch = checkpointEpoch
+	}
+
+	firstEpoch, start := startEpoch, time.Now()
+	for {
+		if startEpoch < endEpoch {
+			log.WithFields(logrus.Fields{
+				"startEpoch": startEpoch,
+				"endEpoch":   endEpoch,
+			}).Info("Backfilling slashing detection")
+			for batchStart := startEpoch; batchStart < endEpoch; batchStart += backfillBatchSize {
+				batchEnd := batchStart + backfillBatchSize
+				if batchEnd > endEpoch {
+					batchEnd = endEpoch
+				}
+				if err := s.backfillBatch(ctx, batchStart, batchEnd); err != nil {
+					return err
+				}
+			}
+			startEpoch = endEpoch
+		}
+		// The epoch of the head is included, as its attestations may have been received
+		// before live detection started.
+		headEpoch := slots.ToEpoch(s.serviceCfg.HeadStateFetcher.HeadSlot()) + 1
+		if headEpoch <= startEpoch {
+			break
+		}
+		endEpoch = headEpoch
+	}
+	if startEpoch > firstEpoch {
+		log.WithField("elapsed", time.Since(start)).Info("Done backfilling slashing detection")
+	}
+	return nil
+}
+
+// backfillBatch backfills the epochs from start up to, but not including, end. The evidence
+// found is then exported and the backfill checkpointed at the start of the end epoch.
+func (s *Service) backfillBatch(ctx context.Context, start, end types.Epoch) error {
+	var attesterSlashings []*ethpb.AttesterSlashing
+	var proposerSlashings []*ethpb.ProposerSlashing
+	for epoch := start; epoch < end; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		attSlashings, propSlashings, err := s.backfillEpoch(ctx, epoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not backfill epoch %d", epoch)
+		}
+		attesterSlashings = append(attesterSlashings, attSlashings...)
+		proposerSlashings = append(proposerSlashings, propSlashings...)
+	}
+	if err := s.exportEvidence(attesterSlashings, proposerSlashings); err != nil {
+		return errors.Wrap(err, "could not export evidence")
+	}
+	next, err := slots.EpochStart(end)
+	if err != nil {
+		return err
+	}
+	if err := s.serviceCfg.Database.SaveBackfillCheckpoint(ctx, next); err != nil {
+		return errors.Wrap(err, "could not save backfill checkpoint")
+	}
+	backfilledEpoch.Set(float64(end - 1))
+	return nil
+}
+
+// backfillEpoch performs slashing detection on the blocks of an epoch, and the attestations
+// they include, as if they were received during that epoch. It returns the slashings found.
+func (s *Service) backfillEpoch(
+	ctx context.Context, epoch types.Epoch,
+) ([]*ethpb.AttesterSlashing, []*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.backfillEpoch")
+	defer span.End()
+
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	blks, _, err := s.serviceCfg.BeaconDatabase.Blocks(
+		ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(startSlot+params.BeaconConfig().SlotsPerEpoch-1),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get blocks")
+	}
+
+	atts := make([]*slashertypes.IndexedAttestationWrapper, 0)
+	proposals := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(blks))
+	for _, blk := range blks {
+		header, err := blk.Header()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get block header")
+		}
+		if validateBlockHeaderIntegrity(header) {
+			signingRoot, err := header.Header.HashTreeRoot()
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "could not get hash tree root of block header")
+			}
+			proposals = append(proposals, &slashertypes.SignedBlockHeaderWrapper{
+				SignedBeaconBlockHeader: header,
+				SigningRoot:             signingRoot,
+			})
+		}
+		for _, att := range blk.Block().Body().Attestations() {
+			targetState, err := s.serviceCfg.AttestationStateFetcher.AttestationTargetState(ctx, att.Data.Target)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "could not get attestation target state")
+			}
+			committee, err := helpers.BeaconCommitteeFromState(ctx, targetState, att.Data.Slot, att.Data.CommitteeIndex)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "could not get attestation committee")
+			}
+			indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "could not convert to indexed attestation")
+			}
+			if !validateAttestationIntegrity(indexedAtt) {
+				continue
+			}
+			signingRoot, err := indexedAtt.Data.HashTreeRoot()
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "could not get hash tree root of attestation")
+			}
+			atts = append(atts, &slashertypes.IndexedAttestationWrapper{
+				IndexedAttestation: indexedAtt,
+				SigningRoot:        signingRoot,
+			})
+		}
+	}
+
+	// The spans are only updated by one epoch worth of attestations at a time, be it live or
+	// backfilled ones.
+	s.detectionLock.Lock()
+	defer s.detectionLock.Unlock()
+	if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(ctx, atts); err != nil {
+		return nil, nil, errors.Wrap(err, "could not save attestation records")
+	}
+	attesterSlashings, err := s.checkSlashableAttestations(ctx, epoch, atts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not check slashable attestations")
+	}
+	proposerSlashings, err := s.detectProposerSlashings(ctx, proposals)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not detect proposer slashings")
+	}
+	if err := s.processAttesterSlashings(ctx, attesterSlashings); err != nil {
+		return nil, nil, errors.Wrap(err, "could not process attester slashings")
+	}
+	if err := s.processProposerSlashings(ctx, proposerSlashings); err != nil {
+		return nil, nil, errors.Wrap(err, "could not process proposer slashings")
+	}
+	processedAttestationsTotal.Add(float64(len(atts)))
+	processedBlocksTotal.Add(float64(len(proposals)))
+	return attesterSlashings, proposerSlashings, nil
+}
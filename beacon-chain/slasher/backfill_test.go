@@ -0,0 +1,209 @@
+package slasher
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	slashingsmock "github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings/mock"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestService_backfill(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconDB := dbtest.SetupDB(t)
+	beaconState, err := util.NewBeaconState()
+	require.NoError(t, err)
+
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, genesisRoot))
+
+	// Two different blocks proposed by the same validator at slots 40 and 72.
+	for _, slot := range []types.Slot{40, 72} {
+		for _, graffiti := range []string{"a", "b"} {
+			blk := util.NewBeaconBlock()
+			blk.Block.Slot = slot
+			blk.Block.ProposerIndex = 1
+			blk.Block.Body.Graffiti = bytesutil.PadTo([]byte(graffiti), 32)
+			blk.Signature = bytesutil.PadTo([]byte("signature"), fieldparams.BLSSignatureLength)
+			util.SaveBlock(t, ctx, beaconDB, blk)
+		}
+	}
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: genesisRoot[:]}))
+	// The epoch of the first double proposal was already backfilled.
+	require.NoError(t, slasherDB.SaveBackfillCheckpoint(ctx, 2*params.BeaconConfig().SlotsPerEpoch))
+
+	evidenceFile := filepath.Join(t.TempDir(), "evidence.jsonl")
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			BeaconDatabase:       beaconDB,
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+			StateGen:             stategen.New(beaconDB, doublylinkedtree.New()),
+			SlashingPoolInserter: &slashingsmock.PoolMock{},
+			Backfill:             true,
+			EvidenceFile:         evidenceFile,
+		},
+		params:                         DefaultParams(),
+		latestEpochWrittenForValidator: make(map[types.ValidatorIndex]types.Epoch),
+	}
+	require.NoError(t, s.backfill(ctx))
+
+	checkpoint, err := slasherDB.BackfillCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3*params.BeaconConfig().SlotsPerEpoch, checkpoint)
+
+	evidence := readEvidence(t, evidenceFile)
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, evidenceDoubleProposal, evidence[0].Kind)
+	assert.Equal(t, types.Slot(72), evidence[0].Slot)
+	assert.DeepEqual(t, []types.ValidatorIndex{1}, evidence[0].ValidatorIndices)
+	slashing := &ethpb.ProposerSlashing{}
+	require.NoError(t, slashing.UnmarshalSSZ(evidence[0].Slashing))
+	assert.Equal(t, types.Slot(72), slashing.Header_1.Header.Slot)
+
+	// Nothing is left to backfill.
+	require.NoError(t, s.backfill(ctx))
+	assert.Equal(t, 1, len(readEvidence(t, evidenceFile)))
+}
+
+func TestService_backfill_ExtendsToHead(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconDB := dbtest.SetupDB(t)
+	beaconState, err := util.NewBeaconState()
+	require.NoError(t, err)
+
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, genesisRoot))
+
+	// A double proposal after the finalized checkpoint, in the epoch before the head.
+	for _, graffiti := range []string{"a", "b"} {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = 100
+		blk.Block.ProposerIndex = 1
+		blk.Block.Body.Graffiti = bytesutil.PadTo([]byte(graffiti), 32)
+		blk.Signature = bytesutil.PadTo([]byte("signature"), fieldparams.BLSSignatureLength)
+		util.SaveBlock(t, ctx, beaconDB, blk)
+	}
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: genesisRoot[:]}))
+	headState := beaconState.Copy()
+	require.NoError(t, headState.SetSlot(4*params.BeaconConfig().SlotsPerEpoch+2))
+
+	evidenceFile := filepath.Join(t.TempDir(), "evidence.jsonl")
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			BeaconDatabase:       beaconDB,
+			HeadStateFetcher:     &mock.ChainService{State: headState},
+			StateGen:             stategen.New(beaconDB, doublylinkedtree.New()),
+			SlashingPoolInserter: &slashingsmock.PoolMock{},
+			Backfill:             true,
+			EvidenceFile:         evidenceFile,
+		},
+		params:                         DefaultParams(),
+		latestEpochWrittenForValidator: make(map[types.ValidatorIndex]types.Epoch),
+	}
+	require.NoError(t, s.backfill(ctx))
+
+	// The epoch of the head is backfilled too.
+	checkpoint, err := slasherDB.BackfillCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5*params.BeaconConfig().SlotsPerEpoch, checkpoint)
+
+	evidence := readEvidence(t, evidenceFile)
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, evidenceDoubleProposal, evidence[0].Kind)
+	assert.Equal(t, types.Slot(100), evidence[0].Slot)
+}
+
+func TestService_exportEvidence_Appends(t *testing.T) {
+	evidenceFile := filepath.Join(t.TempDir(), "evidence.jsonl")
+	s := &Service{serviceCfg: &ServiceConfig{EvidenceFile: evidenceFile}}
+	att1 := createAttestationWrapper(t, 1, 2, []uint64{1, 2}, []byte{1}).IndexedAttestation
+	att2 := createAttestationWrapper(t, 1, 2, []uint64{2, 3}, []byte{2}).IndexedAttestation
+	slashings := []*ethpb.AttesterSlashing{{Attestation_1: att1, Attestation_2: att2}}
+
+	require.NoError(t, s.exportEvidence(nil, nil))
+	assert.Equal(t, false, file.FileExists(evidenceFile))
+	require.NoError(t, s.exportEvidence(slashings, nil))
+	require.NoError(t, s.exportEvidence(slashings, nil))
+	evidence := readEvidence(t, evidenceFile)
+	require.Equal(t, 2, len(evidence))
+	for _, ev := range evidence {
+		assert.Equal(t, evidenceDoubleVote, ev.Kind)
+		assert.DeepEqual(t, []types.ValidatorIndex{2}, ev.ValidatorIndices)
+	}
+}
+
+func readEvidence(t *testing.T, path string) []*Evidence {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	var evidence []*Evidence
+	dec := json.NewDecoder(f)
+	for dec.More() {
+		ev := &Evidence{}
+		require.NoError(t, dec.Decode(ev))
+		evidence = append(evidence, ev)
+	}
+	return evidence
+}
+
+func TestNewAttesterSlashingEvidence(t *testing.T) {
+	tests := []struct {
+		name    string
+		att1    *ethpb.IndexedAttestation
+		att2    *ethpb.IndexedAttestation
+		kind    string
+		indices []types.ValidatorIndex
+	}{
+		{
+			name:    "double vote",
+			att1:    createAttestationWrapper(t, 1, 2, []uint64{1, 2, 3}, []byte{1}).IndexedAttestation,
+			att2:    createAttestationWrapper(t, 1, 2, []uint64{2, 3, 4}, []byte{2}).IndexedAttestation,
+			kind:    evidenceDoubleVote,
+			indices: []types.ValidatorIndex{2, 3},
+		},
+		{
+			name:    "surround vote",
+			att1:    createAttestationWrapper(t, 1, 4, []uint64{1, 2}, nil).IndexedAttestation,
+			att2:    createAttestationWrapper(t, 2, 3, []uint64{2}, nil).IndexedAttestation,
+			kind:    evidenceSurroundVote,
+			indices: []types.ValidatorIndex{2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev, err := newAttesterSlashingEvidence(&ethpb.AttesterSlashing{Attestation_1: tt.att1, Attestation_2: tt.att2})
+			require.NoError(t, err)
+			assert.Equal(t, tt.kind, ev.Kind)
+			assert.DeepEqual(t, tt.indices, ev.ValidatorIndices)
+		})
+	}
+}
@@ -45,7 +45,10 @@ func (s *Service) checkSlashableAttestations(
 		slashings = append(slashings, attSlashings...)
 		indices := s.params.validatorIndicesInChunk(validatorChunkIdx)
 		for _, idx := range indices {
-			s.latestEpochWrittenForValidator[idx] = currentEpoch
+			// Backfilled epochs are older than the ones already written live.
+			if currentEpoch > s.latestEpochWrittenForValidator[idx] {
+				s.latestEpochWrittenForValidator[idx] = currentEpoch
+			}
 		}
 		batchTimes = append(batchTimes, time.Since(innerStart))
 	}
@@ -0,0 +1,114 @@
+package slasher
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/container/slice"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+)
+
+// Kinds of slashable offences in the evidence file.
+const (
+	evidenceDoubleVote     = "double_vote"
+	evidenceSurroundVote   = "surround_vote"
+	evidenceDoubleProposal = "double_proposal"
+)
+
+// Evidence of a slashable offence, along with the SSZ encoded attester or proposer slashing proving it.
+type Evidence struct {
+	Kind             string                 `json:"kind"`
+	ValidatorIndices []types.ValidatorIndex `json:"validator_indices"`
+	Slot             types.Slot             `json:"slot"`
+	Slashing         hexutil.Bytes          `json:"slashing_ssz"`
+}
+
+// newAttesterSlashingEvidence returns the evidence of a double or surround vote.
+func newAttesterSlashingEvidence(slashing *ethpb.AttesterSlashing) (*Evidence, error) {
+	enc, err := slashing.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attester slashing")
+	}
+	kind := evidenceSurroundVote
+	if slashing.Attestation_1.Data.Target.Epoch == slashing.Attestation_2.Data.Target.Epoch {
+		kind = evidenceDoubleVote
+	}
+	indices := slice.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+	validatorIndices := make([]types.ValidatorIndex, len(indices))
+	for i, idx := range indices {
+		validatorIndices[i] = types.ValidatorIndex(idx)
+	}
+	return &Evidence{
+		Kind:             kind,
+		ValidatorIndices: validatorIndices,
+		Slot:             slashing.Attestation_2.Data.Slot,
+		Slashing:         enc,
+	}, nil
+}
+
+// newProposerSlashingEvidence returns the evidence of a double proposal.
+func newProposerSlashingEvidence(slashing *ethpb.ProposerSlashing) (*Evidence, error) {
+	enc, err := slashing.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal proposer slashing")
+	}
+	return &Evidence{
+		Kind:             evidenceDoubleProposal,
+		ValidatorIndices: []types.ValidatorIndex{slashing.Header_1.Header.ProposerIndex},
+		Slot:             slashing.Header_1.Header.Slot,
+		Slashing:         enc,
+	}, nil
+}
+
+// exportEvidence appends the evidence of the given slashings to the evidence file, one JSON
+// object per line, so that the evidence already exported is never read back or rewritten.
+func (s *Service) exportEvidence(
+	attesterSlashings []*ethpb.AttesterSlashing, proposerSlashings []*ethpb.ProposerSlashing,
+) error {
+	if s.serviceCfg.EvidenceFile == "" || len(attesterSlashings)+len(proposerSlashings) == 0 {
+		return nil
+	}
+	evidence := make([]*Evidence, 0, len(attesterSlashings)+len(proposerSlashings))
+	for _, sl := range attesterSlashings {
+		ev, err := newAttesterSlashingEvidence(sl)
+		if err != nil {
+			return err
+		}
+		evidence = append(evidence, ev)
+	}
+	for _, sl := range proposerSlashings {
+		ev, err := newProposerSlashingEvidence(sl)
+		if err != nil {
+			return err
+		}
+		evidence = append(evidence, ev)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, ev := range evidence {
+		if err := enc.Encode(ev); err != nil {
+			return errors.Wrap(err, "could not encode evidence")
+		}
+	}
+	path, err := file.ExpandPath(s.serviceCfg.EvidenceFile)
+	if err != nil {
+		return errors.Wrap(err, "could not expand evidence file path")
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304 -- The path is provided by the node operator.
+	if err != nil {
+		return errors.Wrap(err, "could not open evidence file")
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close evidence file")
+		}
+		return errors.Wrap(err, "could not write evidence")
+	}
+	return f.Close()
+}
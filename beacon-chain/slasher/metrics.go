@@ -53,4 +53,8 @@ var (
 		Name: "slasher_surrounded_votes_total",
 		Help: "Total slashable surrounded votes successfully detected by slasher",
 	})
+	backfilledEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_backfilled_epoch",
+		Help: "The last historical epoch fed through slashing detection by the slasher backfill",
+	})
 )
@@ -0,0 +1,190 @@
+package slasher
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/sirupsen/logrus"
+)
+
+// SpanMigrationDatabase defines the slasher database access needed to migrate the spans
+// to new parameters.
+type SpanMigrationDatabase interface {
+	db.SlasherDatabase
+	SlasherChunkKeys(ctx context.Context, kind slashertypes.ChunkKind) ([][]byte, error)
+	SaveMigratedSlasherChunks(
+		ctx context.Context, kind slashertypes.ChunkKind, chunkKeys [][]byte, chunks [][]uint16,
+	) error
+	DiscardMigratedSlasherChunks(ctx context.Context) error
+	CommitMigratedSlasherChunks(ctx context.Context, p *slashertypes.SpanParameters) error
+}
+
+// MigrateSpans re-chunks the min and max spans of all validators from the parameters recorded
+// in the database to the given ones. When the history length changes, the spans of each validator
+// are realigned on the last epoch written for it: epochs no longer in the history are dropped
+// and epochs newly in the history get neutral elements. The database must not be in use.
+func MigrateSpans(ctx context.Context, slasherDB SpanMigrationDatabase, to *Parameters) error {
+	stored, err := slasherDB.SpanParameters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get span parameters")
+	}
+	if stored == nil {
+		return slasherDB.SaveSpanParameters(ctx, to.SpanParameters())
+	}
+	if *stored == *to.SpanParameters() {
+		return nil
+	}
+	from, err := ParamsFromSpanParameters(stored)
+	if err != nil {
+		return errors.Wrap(err, "invalid span parameters in database")
+	}
+	log.WithFields(logrus.Fields{
+		"fromChunkSize":          from.chunkSize,
+		"fromValidatorChunkSize": from.validatorChunkSize,
+		"fromHistoryLength":      from.historyLength,
+		"toChunkSize":            to.chunkSize,
+		"toValidatorChunkSize":   to.validatorChunkSize,
+		"toHistoryLength":        to.historyLength,
+	}).Info("Migrating slasher spans")
+
+	// Chunks left over by an interrupted migration must not end up in the new spans.
+	if err := slasherDB.DiscardMigratedSlasherChunks(ctx); err != nil {
+		return errors.Wrap(err, "could not discard previously migrated chunks")
+	}
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		if err := migrateChunks(ctx, slasherDB, kind, from, to); err != nil {
+			return err
+		}
+	}
+	return slasherDB.CommitMigratedSlasherChunks(ctx, to.SpanParameters())
+}
+
+// migrateChunks re-chunks the spans of a kind, one validator chunk of the new parameters at a time.
+func migrateChunks(
+	ctx context.Context, slasherDB SpanMigrationDatabase, kind slashertypes.ChunkKind, from, to *Parameters,
+) error {
+	keys, err := slasherDB.SlasherChunkKeys(ctx, kind)
+	if err != nil {
+		return errors.Wrap(err, "could not get chunk keys")
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	width := uint64(from.historyLength.Div(from.chunkSize))
+	maxValidatorChunkIdx := uint64(0)
+	for _, key := range keys {
+		if idx := ssz.UnmarshallUint64(key) / width; idx > maxValidatorChunkIdx {
+			maxValidatorChunkIdx = idx
+		}
+	}
+	numValidators := (maxValidatorChunkIdx + 1) * from.validatorChunkSize
+	neutral := neutralElement(kind)
+
+	for validatorChunkIdx := uint64(0); validatorChunkIdx*to.validatorChunkSize < numValidators; validatorChunkIdx++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		indices := to.validatorIndicesInChunk(validatorChunkIdx)
+		spans, err := loadSpans(ctx, slasherDB, kind, from, indices)
+		if err != nil {
+			return err
+		}
+		attestedEpochs, err := slasherDB.LastEpochWrittenForValidators(ctx, indices)
+		if err != nil {
+			return errors.Wrap(err, "could not get last epochs written")
+		}
+		lastEpochs := make(map[types.ValidatorIndex]types.Epoch, len(attestedEpochs))
+		for _, attested := range attestedEpochs {
+			lastEpochs[attested.ValidatorIndex] = attested.Epoch
+		}
+
+		numChunks := uint64(to.historyLength.Div(to.chunkSize))
+		chunkKeys := make([][]byte, 0, numChunks)
+		chunks := make([][]uint16, 0, numChunks)
+		for chunkIdx := uint64(0); chunkIdx < numChunks; chunkIdx++ {
+			chunk := make([]uint16, to.chunkSize*to.validatorChunkSize)
+			isNeutral := true
+			for _, validatorIdx := range indices {
+				last := lastEpochs[validatorIdx]
+				for position := to.firstEpoch(chunkIdx); position <= to.lastEpoch(chunkIdx); position++ {
+					value := neutral
+					// The epoch stored at this position in the new history, which is at most the last
+					// epoch written, is looked up in the old history if it is recent enough to be there.
+					age := (uint64(last.Mod(uint64(to.historyLength))) + uint64(to.historyLength) - uint64(position)) % uint64(to.historyLength)
+					if age <= uint64(last) && age < uint64(from.historyLength) {
+						value = spans[validatorIdx][(last - types.Epoch(age)).Mod(uint64(from.historyLength))]
+					}
+					chunk[to.cellIndex(validatorIdx, position)] = value
+					if value != neutral {
+						isNeutral = false
+					}
+				}
+			}
+			// Chunks missing from the database are read as neutral, so there is no need to store them.
+			if isNeutral {
+				continue
+			}
+			chunkKeys = append(chunkKeys, to.flatSliceID(validatorChunkIdx, chunkIdx))
+			chunks = append(chunks, chunk)
+		}
+		if err := slasherDB.SaveMigratedSlasherChunks(ctx, kind, chunkKeys, chunks); err != nil {
+			return errors.Wrap(err, "could not save migrated chunks")
+		}
+	}
+	return nil
+}
+
+// loadSpans returns the spans of a kind of the given validators, indexed by the position of the
+// epochs in the history of the parameters they are chunked with.
+func loadSpans(
+	ctx context.Context,
+	slasherDB SpanMigrationDatabase,
+	kind slashertypes.ChunkKind,
+	p *Parameters,
+	indices []types.ValidatorIndex,
+) (map[types.ValidatorIndex][]uint16, error) {
+	neutral := neutralElement(kind)
+	numChunks := uint64(p.historyLength.Div(p.chunkSize))
+	spans := make(map[types.ValidatorIndex][]uint16, len(indices))
+	firstChunkIdx := p.validatorChunkIndex(indices[0])
+	lastChunkIdx := p.validatorChunkIndex(indices[len(indices)-1])
+	for validatorChunkIdx := firstChunkIdx; validatorChunkIdx <= lastChunkIdx; validatorChunkIdx++ {
+		keys := make([][]byte, numChunks)
+		for chunkIdx := uint64(0); chunkIdx < numChunks; chunkIdx++ {
+			keys[chunkIdx] = p.flatSliceID(validatorChunkIdx, chunkIdx)
+		}
+		chunks, _, err := slasherDB.LoadSlasherChunks(ctx, kind, keys)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load chunks")
+		}
+		for _, validatorIdx := range indices {
+			if p.validatorChunkIndex(validatorIdx) != validatorChunkIdx {
+				continue
+			}
+			span := make([]uint16, p.historyLength)
+			for position := range span {
+				chunk := chunks[p.chunkIndex(types.Epoch(position))]
+				if len(chunk) == 0 {
+					span[position] = neutral
+					continue
+				}
+				span[position] = chunk[p.cellIndex(validatorIdx, types.Epoch(position))]
+			}
+			spans[validatorIdx] = span
+		}
+	}
+	return spans, nil
+}
+
+// neutralElement returns the value of the epochs no attestation was recorded for in spans of a kind.
+func neutralElement(kind slashertypes.ChunkKind) uint16 {
+	if kind == slashertypes.MinSpan {
+		return math.MaxUint16
+	}
+	return 0
+}
@@ -0,0 +1,90 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestMigrateSpans(t *testing.T) {
+	from, err := NewParams(2, 3, 8)
+	require.NoError(t, err)
+	tests := []struct {
+		name               string
+		chunkSize          uint64
+		validatorChunkSize uint64
+		historyLength      types.Epoch
+	}{
+		{name: "same parameters", chunkSize: 2, validatorChunkSize: 3, historyLength: 8},
+		{name: "larger chunks and history", chunkSize: 4, validatorChunkSize: 5, historyLength: 16},
+		{name: "smaller chunks and history", chunkSize: 1, validatorChunkSize: 2, historyLength: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			slasherDB := dbtest.SetupSlasherDB(t)
+			s, err := New(ctx, &ServiceConfig{Database: slasherDB, Params: from})
+			require.NoError(t, err)
+
+			// Validators 0 to 6, spanning several validator chunks, vote from epoch 1 to 2.
+			currentEpoch := types.Epoch(3)
+			atts := []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(t, 1, 2, []uint64{0, 1, 2, 3, 4, 5, 6}, []byte{1}),
+			}
+			require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(ctx, atts))
+			slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, atts)
+			require.NoError(t, err)
+			require.Equal(t, 0, len(slashings))
+			require.NoError(t, slasherDB.SaveLastEpochsWrittenForValidators(ctx, s.latestEpochWrittenForValidator))
+
+			to, err := NewParams(tt.chunkSize, tt.validatorChunkSize, tt.historyLength)
+			require.NoError(t, err)
+			migrationDB, ok := slasherDB.(SpanMigrationDatabase)
+			require.Equal(t, true, ok)
+			require.NoError(t, MigrateSpans(ctx, migrationDB, to))
+
+			stored, err := slasherDB.SpanParameters(ctx)
+			require.NoError(t, err)
+			assert.DeepEqual(t, to.SpanParameters(), stored)
+			if *from.SpanParameters() != *to.SpanParameters() {
+				_, err = New(ctx, &ServiceConfig{Database: slasherDB, Params: from})
+				require.ErrorContains(t, "migrate-slasher-spans", err)
+			}
+
+			// Votes from epoch 0 to 3 of validator 5 surround its previous vote, and votes from
+			// epoch 1 to 3 of validator 6 are safe, which is only known from the migrated spans.
+			s, err = New(ctx, &ServiceConfig{Database: slasherDB, Params: to})
+			require.NoError(t, err)
+			epochs, err := slasherDB.LastEpochWrittenForValidators(ctx, []types.ValidatorIndex{5, 6})
+			require.NoError(t, err)
+			for _, e := range epochs {
+				s.latestEpochWrittenForValidator[e.ValidatorIndex] = e.Epoch
+			}
+			slashings, err = s.checkSlashableAttestations(ctx, currentEpoch, []*slashertypes.IndexedAttestationWrapper{
+				createAttestationWrapper(t, 0, 3, []uint64{5}, []byte{2}),
+				createAttestationWrapper(t, 1, 3, []uint64{6}, []byte{2}),
+			})
+			require.NoError(t, err)
+			require.NotEqual(t, 0, len(slashings))
+			for _, slashing := range slashings {
+				assert.DeepEqual(t, []uint64{5}, slashing.Attestation_1.AttestingIndices)
+			}
+		})
+	}
+}
+
+func TestMigrateSpans_EmptyDatabase(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	to, err := NewParams(4, 5, 16)
+	require.NoError(t, err)
+	require.NoError(t, MigrateSpans(ctx, slasherDB.(SpanMigrationDatabase), to))
+	stored, err := slasherDB.SpanParameters(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, to.SpanParameters(), stored)
+}
@@ -1,7 +1,13 @@
 package slasher
 
 import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 )
 
@@ -38,6 +44,60 @@ func DefaultParams() *Parameters {
 	}
 }
 
+// NewParams returns the parameters for the given chunk size, validator chunk size and
+// history length. The history length must be a multiple of the chunk size.
+func NewParams(chunkSize, validatorChunkSize uint64, historyLength types.Epoch) (*Parameters, error) {
+	if chunkSize == 0 || validatorChunkSize == 0 || historyLength == 0 {
+		return nil, errors.New("slasher parameters must be greater than 0")
+	}
+	if uint64(historyLength)%chunkSize != 0 {
+		return nil, errors.Errorf("history length %d is not a multiple of chunk size %d", historyLength, chunkSize)
+	}
+	// Spans are distances stored as uint16, with MaxUint16 standing for an undefined min span.
+	if uint64(historyLength) >= math.MaxUint16 {
+		return nil, errors.Errorf("history length %d must be lower than %d", historyLength, math.MaxUint16)
+	}
+	return &Parameters{
+		chunkSize:          chunkSize,
+		validatorChunkSize: validatorChunkSize,
+		historyLength:      historyLength,
+	}, nil
+}
+
+// ParamsFromSpanParameters returns the parameters spans recorded in the database are chunked with.
+func ParamsFromSpanParameters(p *slashertypes.SpanParameters) (*Parameters, error) {
+	return NewParams(p.ChunkSize, p.ValidatorChunkSize, p.HistoryLength)
+}
+
+// SpanParameters returns the parameters in the form recorded in the database.
+func (p *Parameters) SpanParameters() *slashertypes.SpanParameters {
+	return &slashertypes.SpanParameters{
+		ChunkSize:          p.chunkSize,
+		ValidatorChunkSize: p.validatorChunkSize,
+		HistoryLength:      p.historyLength,
+	}
+}
+
+// checkSpanParameters ensures the spans in the database are chunked with the given parameters,
+// recording them if the database holds no span yet.
+func checkSpanParameters(ctx context.Context, slasherDB db.SlasherDatabase, p *Parameters) error {
+	stored, err := slasherDB.SpanParameters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get span parameters")
+	}
+	if stored == nil {
+		return slasherDB.SaveSpanParameters(ctx, p.SpanParameters())
+	}
+	if *stored != *p.SpanParameters() {
+		return errors.Errorf(
+			"slasher database spans are chunked with chunk size %d, validator chunk size %d and history length %d, "+
+				"which differ from the configured parameters. Use `prysmctl db migrate-slasher-spans` to migrate them",
+			stored.ChunkSize, stored.ValidatorChunkSize, stored.HistoryLength,
+		)
+	}
+	return nil
+}
+
 // Validator min and max spans are split into chunks of length C = chunkSize.
 // That is, if we are keeping N epochs worth of attesting history, finding what
 // chunk a certain epoch, e, falls into can be computed as (e % N) / C. For example,
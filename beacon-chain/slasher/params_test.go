@@ -1,12 +1,16 @@
 package slasher
 
 import (
+	"context"
+	"math"
 	"reflect"
 	"testing"
 
 	ssz "github.com/prysmaticlabs/fastssz"
+	dbtest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
 )
 
 func TestDefaultParams(t *testing.T) {
@@ -538,3 +542,32 @@ func TestParameters_lastEpoch(t *testing.T) {
 		})
 	}
 }
+
+func TestNewParams(t *testing.T) {
+	p, err := NewParams(4, 512, 8192)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &Parameters{chunkSize: 4, validatorChunkSize: 512, historyLength: 8192}, p)
+	_, err = NewParams(0, 512, 8192)
+	assert.ErrorContains(t, "greater than 0", err)
+	_, err = NewParams(3, 512, 8192)
+	assert.ErrorContains(t, "not a multiple of chunk size", err)
+	_, err = NewParams(1, 1, math.MaxUint16)
+	assert.ErrorContains(t, "must be lower than", err)
+}
+
+func TestService_checkSpanParameters(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	_, err := New(ctx, &ServiceConfig{Database: slasherDB})
+	require.NoError(t, err)
+	stored, err := slasherDB.SpanParameters(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, DefaultParams().SpanParameters(), stored)
+
+	p, err := NewParams(4, 512, 8192)
+	require.NoError(t, err)
+	_, err = New(ctx, &ServiceConfig{Database: slasherDB, Params: p})
+	assert.ErrorContains(t, "differ from the configured parameters", err)
+	_, err = New(ctx, &ServiceConfig{Database: slasherDB, Params: DefaultParams()})
+	require.NoError(t, err)
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/container/slice"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 )
@@ -36,11 +40,22 @@ func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*eth
 
 		// Log the slashing event and insert into the beacon node's operations pool.
 		logAttesterSlashing(sl)
+		indices := slice.IntersectionUint64(sl.Attestation_1.AttestingIndices, sl.Attestation_2.AttestingIndices)
+		ev := &ethpb.SlashingEvent{
+			AttesterSlashing: sl,
+			ValidatorIndices: make([]types.ValidatorIndex, len(indices)),
+		}
+		for i, index := range indices {
+			ev.ValidatorIndices[i] = types.ValidatorIndex(index)
+		}
+		pooled := true
 		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(
 			ctx, beaconState, sl,
 		); err != nil {
 			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+			pooled = false
 		}
+		s.notifySlashing(ev, pooled, alreadySlashed(beaconState, ev.ValidatorIndices))
 	}
 	return nil
 }
@@ -71,13 +86,54 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 		}
 		// Log the slashing event and insert into the beacon node's operations pool.
 		logProposerSlashing(sl)
+		ev := &ethpb.SlashingEvent{
+			ProposerSlashing: sl,
+			ValidatorIndices: []types.ValidatorIndex{sl.Header_1.Header.ProposerIndex},
+		}
+		pooled := true
 		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, sl); err != nil {
 			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
+			pooled = false
 		}
+		s.notifySlashing(ev, pooled, alreadySlashed(beaconState, ev.ValidatorIndices))
 	}
 	return nil
 }
 
+// notifySlashing notifies a detected slashing to the operation feed subscribers, along with whether
+// it was inserted into the operations pool or the offending validators are all already slashed on chain.
+func (s *Service) notifySlashing(ev *ethpb.SlashingEvent, pooled, included bool) {
+	if s.serviceCfg.OperationNotifier == nil {
+		return
+	}
+	switch {
+	case included:
+		ev.Status = ethpb.SlashingEvent_INCLUDED
+	case pooled:
+		ev.Status = ethpb.SlashingEvent_POOLED
+	default:
+		ev.Status = ethpb.SlashingEvent_DETECTED
+	}
+	s.serviceCfg.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.SlashingDetected,
+		Data: &operation.SlashingDetectedData{Slashing: ev},
+	})
+}
+
+// alreadySlashed checks whether all the validators are already slashed in the given state.
+func alreadySlashed(st state.ReadOnlyBeaconState, indices []types.ValidatorIndex) bool {
+	if st == nil || st.IsNil() || len(indices) == 0 {
+		return false
+	}
+	for _, index := range indices {
+		val, err := st.ValidatorAtIndexReadOnly(index)
+		if err != nil || !val.Slashed() {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *Service) verifyBlockSignature(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) error {
 	parentState, err := s.serviceCfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(header.Header.ParentRoot))
 	if err != nil {
@@ -4,16 +4,21 @@ import (
 	"context"
 	"testing"
 
+	"github.com/pkg/errors"
 	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	dbtest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
 	slashingsmock "github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings/mock"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 	"github.com/prysmaticlabs/prysm/v3/testing/util"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -250,4 +255,85 @@ func TestService_processProposerSlashings(t *testing.T) {
 		require.NoError(tt, err)
 		require.LogsDoNotContain(tt, hook, "Invalid signature")
 	})
+
+	t.Run("pool_insert_fails", func(tt *testing.T) {
+		hook := logTest.NewGlobal()
+		signature := privKey.Sign(signingRoot[:])
+		firstBlockHeader.Signature = signature.Marshal()
+		secondBlockHeader.Signature = signature.Marshal()
+		notifier := &mock.MockOperationNotifier{}
+		s.serviceCfg.OperationNotifier = notifier
+		s.serviceCfg.SlashingPoolInserter = &slashingsmock.PoolMock{ErrInsert: errors.New("pool is full")}
+		events := make(chan *feed.Event, 1)
+		sub := notifier.OperationFeed().Subscribe(events)
+		defer sub.Unsubscribe()
+
+		slashings := []*ethpb.ProposerSlashing{
+			{
+				Header_1: firstBlockHeader,
+				Header_2: secondBlockHeader,
+			},
+		}
+
+		err = s.processProposerSlashings(ctx, slashings)
+		require.NoError(tt, err)
+		require.LogsContain(tt, hook, "Could not insert proposer slashing into operations pool")
+		ev := <-events
+		data, ok := ev.Data.(*operation.SlashingDetectedData)
+		require.Equal(tt, true, ok)
+		assert.Equal(tt, ethpb.SlashingEvent_DETECTED, data.Slashing.Status)
+	})
+}
+
+func TestService_notifySlashing(t *testing.T) {
+	sl := &ethpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+	}
+	tests := []struct {
+		name     string
+		pooled   bool
+		included bool
+		want     ethpb.SlashingEvent_Status
+	}{
+		{name: "pooled", pooled: true, want: ethpb.SlashingEvent_POOLED},
+		{name: "not pooled", want: ethpb.SlashingEvent_DETECTED},
+		{name: "already included", pooled: true, included: true, want: ethpb.SlashingEvent_INCLUDED},
+		{name: "already included and not pooled", included: true, want: ethpb.SlashingEvent_INCLUDED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifier := &mock.MockOperationNotifier{}
+			s := &Service{
+				serviceCfg: &ServiceConfig{
+					OperationNotifier: notifier,
+				},
+			}
+			events := make(chan *feed.Event, 1)
+			sub := notifier.OperationFeed().Subscribe(events)
+			defer sub.Unsubscribe()
+
+			s.notifySlashing(&ethpb.SlashingEvent{
+				ProposerSlashing: sl,
+				ValidatorIndices: []types.ValidatorIndex{0},
+			}, tt.pooled, tt.included)
+
+			ev := <-events
+			assert.Equal(t, feed.EventType(operation.SlashingDetected), ev.Type)
+			data, ok := ev.Data.(*operation.SlashingDetectedData)
+			require.Equal(t, true, ok)
+			assert.Equal(t, tt.want, data.Slashing.Status)
+			assert.DeepEqual(t, []types.ValidatorIndex{0}, data.Slashing.ValidatorIndices)
+		})
+	}
+}
+
+func TestAlreadySlashed(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators([]*ethpb.Validator{{Slashed: true}, {}}))
+	assert.Equal(t, true, alreadySlashed(st, []types.ValidatorIndex{0}))
+	assert.Equal(t, false, alreadySlashed(st, []types.ValidatorIndex{0, 1}))
+	assert.Equal(t, false, alreadySlashed(st, []types.ValidatorIndex{2}))
+	assert.Equal(t, false, alreadySlashed(st, nil))
 }
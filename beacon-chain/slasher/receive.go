@@ -101,15 +101,18 @@ func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-ch
 			}).Info("Processing queued attestations for slashing detection")
 
 			// Save the attestation records to our database.
+			s.detectionLock.Lock()
 			if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(
 				ctx, validAtts,
 			); err != nil {
+				s.detectionLock.Unlock()
 				log.WithError(err).Error("Could not save attestation records to DB")
 				continue
 			}
 
 			// Check for slashings.
 			slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAtts)
+			s.detectionLock.Unlock()
 			if err != nil {
 				log.WithError(err).Error("Could not check slashable attestations")
 				continue
@@ -148,7 +151,9 @@ func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan typ
 
 			start := time.Now()
 			// Check for slashings.
+			s.detectionLock.Lock()
 			slashings, err := s.detectProposerSlashings(ctx, blocks)
+			s.detectionLock.Unlock()
 			if err != nil {
 				log.WithError(err).Error("Could not detect proposer slashings")
 				continue
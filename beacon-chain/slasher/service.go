@@ -6,16 +6,18 @@ package slasher
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/v3/async/event"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
-	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
+	beaconsync "github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
@@ -38,7 +40,19 @@ type ServiceConfig struct {
 	StateGen                stategen.StateManager
 	SlashingPoolInserter    slashings.PoolInserter
 	HeadStateFetcher        blockchain.HeadFetcher
-	SyncChecker             sync.Checker
+	SyncChecker             beaconsync.Checker
+	// OperationNotifier is notified of every slashing detected. No notification is sent when it is nil.
+	OperationNotifier operation.Notifier
+	// BeaconDatabase is the source of the history fed through slashing detection
+	// when Backfill is set.
+	BeaconDatabase db.ReadOnlyDatabase
+	Backfill       bool
+	// EvidenceFile is the path of the file the offences found by the backfill are appended
+	// to, one JSON object per line. No evidence is written when it is empty.
+	EvidenceFile string
+	// Params are the parameters spans are chunked with, DefaultParams if nil. They must
+	// match the ones recorded in the database.
+	Params *Parameters
 }
 
 // SlashingChecker is an interface for defining services that the beacon node may interact with to provide slashing data.
@@ -66,13 +80,24 @@ type Service struct {
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochWrittenForValidator map[types.ValidatorIndex]types.Epoch
+	// detectionLock keeps the backfill and the detection of the attestations and blocks
+	// received live from updating the spans at the same time.
+	detectionLock sync.Mutex
 }
 
 // New instantiates a new slasher from configuration values.
 func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	params := srvCfg.Params
+	if params == nil {
+		params = DefaultParams()
+	}
+	if err := checkSpanParameters(ctx, srvCfg.Database, params); err != nil {
+		cancel()
+		return nil, err
+	}
 	return &Service{
-		params:                         DefaultParams(),
+		params:                         params,
 		serviceCfg:                     srvCfg,
 		indexedAttsChan:                make(chan *ethpb.IndexedAttestation, 1),
 		beaconBlockHeadersChan:         make(chan *ethpb.SignedBeaconBlockHeader, 1),
@@ -135,6 +160,16 @@ func (s *Service) run() {
 	go s.processQueuedAttestations(s.ctx, s.attsSlotTicker.C())
 	go s.processQueuedBlocks(s.ctx, s.blocksSlotTicker.C())
 	go s.pruneSlasherData(s.ctx, s.pruningSlotTicker.C())
+
+	// The history is backfilled in the background so that live detection is not
+	// held back until it is done.
+	if s.serviceCfg.Backfill && s.serviceCfg.BeaconDatabase != nil {
+		go func() {
+			if err := s.backfill(s.ctx); err != nil {
+				log.WithError(err).Error("Could not backfill slashing detection")
+			}
+		}()
+	}
 }
 
 // Stop the slasher service.
@@ -51,3 +51,10 @@ type AttestedEpochForValidator struct {
 	ValidatorIndex types.ValidatorIndex
 	Epoch          types.Epoch
 }
+
+// SpanParameters are the parameters the min and max spans of validators are chunked with on disk.
+type SpanParameters struct {
+	ChunkSize          uint64
+	ValidatorChunkSize uint64
+	HistoryLength      types.Epoch
+}
@@ -6,6 +6,10 @@ import (
 	"fmt"
 
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/features"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -39,11 +43,27 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	// Start at previous finalized slot, stop at current finalized slot (it will be handled in the next migration).
 	// If the slot is on archived point, save the state of that slot to the DB.
+	diffs := features.Get().EnableHistoricalStateDiffs
+	diffInterval := types.Slot(uint64(1) << params.BeaconConfig().StateDiffExponents[0])
 	for slot := oldFSlot; slot < fSlot; slot++ {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
 
+		// With historical state diffs, states are archived at every finest diff interval
+		// in place of the archived points.
+		if diffs {
+			if slot%diffInterval == 0 && slot != 0 {
+				aRoot, err := s.saveStateDiff(ctx, slot)
+				if err != nil {
+					return err
+				}
+				// The archived state is kept, so it is no longer one of the hot states to delete.
+				s.releaseHotStateRoot(aRoot)
+			}
+			continue
+		}
+
 		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
@@ -84,17 +104,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 			if s.beaconDB.HasState(ctx, aRoot) {
 				// If you are migrating a state and its already part of the hot state cache saved to the db,
 				// you can just remove it from the hot state cache as it becomes redundant.
-				s.saveHotStateDB.lock.Lock()
-				roots := s.saveHotStateDB.blockRootsOfSavedStates
-				for i := 0; i < len(roots); i++ {
-					if aRoot == roots[i] {
-						s.saveHotStateDB.blockRootsOfSavedStates = append(roots[:i], roots[i+1:]...)
-						// There shouldn't be duplicated roots in `blockRootsOfSavedStates`.
-						// Break here is ok.
-						break
-					}
-				}
-				s.saveHotStateDB.lock.Unlock()
+				s.releaseHotStateRoot(aRoot)
 				continue
 			}
 
@@ -120,3 +130,89 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	return nil
 }
+
+// releaseHotStateRoot removes the block root of an archived state from the hot states saved to the DB,
+// so that the state is not deleted when the node stops saving hot states to the DB.
+func (s *State) releaseHotStateRoot(root [32]byte) {
+	s.saveHotStateDB.lock.Lock()
+	defer s.saveHotStateDB.lock.Unlock()
+	roots := s.saveHotStateDB.blockRootsOfSavedStates
+	for i := 0; i < len(roots); i++ {
+		if root == roots[i] {
+			s.saveHotStateDB.blockRootsOfSavedStates = append(roots[:i], roots[i+1:]...)
+			// There shouldn't be duplicated roots in `blockRootsOfSavedStates`.
+			// Break here is ok.
+			break
+		}
+	}
+}
+
+// saveStateDiff archives the state at the given slot in the state diff storage and returns the root of
+// the block it was built from. When the slot was skipped, the state of the highest block below it is
+// advanced to the slot. As the history is only replayed from states at the slot of their block, the
+// advanced state is then only archived by slot, and the state of the block is saved beside it unless
+// the DB already has it.
+func (s *State) saveStateDiff(ctx context.Context, slot types.Slot) ([32]byte, error) {
+	cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not get epoch boundary state for slot %d", slot)
+	}
+	var aRoot [32]byte
+	var aState state.BeaconState
+	if exists {
+		aRoot = cached.root
+		aState = cached.state
+	} else {
+		_, roots, err := s.beaconDB.HighestRootsBelowSlot(ctx, slot)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		// Given the block has been finalized, the db should not have more than one block in a given slot.
+		if len(roots) != 1 {
+			return [32]byte{}, errUnknownBlock
+		}
+		aRoot = roots[0]
+		aState, err = s.StateByRoot(ctx, aRoot)
+		if err != nil {
+			return [32]byte{}, err
+		}
+	}
+	aBlock, err := s.beaconDB.Block(ctx, aRoot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if err := blocks.BeaconBlockIsNil(aBlock); err != nil {
+		return [32]byte{}, err
+	}
+	diffRoot := aRoot
+	if blockSlot := aBlock.Block().Slot(); blockSlot < slot {
+		diffRoot = params.BeaconConfig().ZeroHash
+		if !s.beaconDB.HasState(ctx, aRoot) {
+			bState := aState
+			if bState.Slot() != blockSlot {
+				bState, err = s.StateByRoot(ctx, aRoot)
+				if err != nil {
+					return [32]byte{}, err
+				}
+			}
+			if err := s.beaconDB.SaveState(ctx, bState, aRoot); err != nil {
+				return [32]byte{}, err
+			}
+		}
+	}
+	if aState.Slot() < slot {
+		aState, err = ReplayProcessSlots(ctx, aState.Copy(), slot)
+		if err != nil {
+			return [32]byte{}, err
+		}
+	}
+	if err := s.beaconDB.SaveStateDiff(ctx, aState, diffRoot); err != nil {
+		return [32]byte{}, err
+	}
+	log.WithFields(
+		logrus.Fields{
+			"slot": slot,
+			"root": hex.EncodeToString(bytesutil.Trunc(aRoot[:])),
+		}).Debug("Saved state diff in DB")
+	return aRoot, nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
 	testDB "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v3/config/features"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
 	consensusblocks "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
@@ -63,6 +65,154 @@ func TestMigrateToCold_HappyPath(t *testing.T) {
 	require.LogsContain(t, hook, "Saved state in DB")
 }
 
+func TestMigrateToCold_SavesStateDiffs(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableHistoricalStateDiffs: true})
+	defer resetCfg()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.StateDiffExponents = []uint64{5, 6}
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB, doublylinkedtree.New())
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(32))
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 32
+	aRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, b)
+	require.NoError(t, service.epochBoundaryStateCache.put(aRoot, beaconState))
+	fBlock := util.NewBeaconBlock()
+	fBlock.Block.Slot = 65
+	fBlock.Block.ParentRoot = aRoot[:]
+	fRoot, err := fBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, fBlock)
+
+	// Slot 64 is skipped, so the state of the block at slot 32 is advanced to it.
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	assert.Equal(t, true, beaconDB.HasState(ctx, aRoot))
+	gotState, err := beaconDB.State(ctx, aRoot)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, beaconState.ToProtoUnsafe(), gotState.ToProtoUnsafe(), "Did not save state diff")
+	lastIndex, err := beaconDB.LastArchivedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), lastIndex, "Archived point should not be saved")
+}
+
+func TestMigrateToCold_StateDiffsSaveSkippedBlockState(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableHistoricalStateDiffs: true})
+	defer resetCfg()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.StateDiffExponents = []uint64{5, 6}
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB, doublylinkedtree.New())
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(20))
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 20
+	aRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, b)
+	service.hotStateCache.put(aRoot, beaconState)
+	fBlock := util.NewBeaconBlock()
+	fBlock.Block.Slot = 33
+	fBlock.Block.ParentRoot = aRoot[:]
+	fRoot, err := fBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, fBlock)
+
+	// Slot 32 is skipped, so the state of the block at slot 20 is advanced to it in the state diff,
+	// while the state of the block is saved at its own slot so the history can be replayed from it.
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	gotState, err := beaconDB.State(ctx, aRoot)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), gotState.Slot())
+}
+
+func TestMigrateToCold_StateDiffsKeepSkippedBlockStateOnRestart(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableHistoricalStateDiffs: true})
+	defer resetCfg()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.StateDiffExponents = []uint64{5, 6}
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB, doublylinkedtree.New())
+	genesis := util.NewBeaconBlock()
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(20))
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 20
+	b.Block.ParentRoot = gRoot[:]
+	aRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, b)
+	service.hotStateCache.put(aRoot, beaconState)
+	fBlock := util.NewBeaconBlock()
+	fBlock.Block.Slot = 65
+	fBlock.Block.ParentRoot = aRoot[:]
+	fRoot, err := fBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, fBlock)
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: fRoot[:]}))
+
+	// The dirty states are cleaned up on restart, which must keep the state of the block the
+	// archived states at slots 32 and 64 were advanced from.
+	require.NoError(t, beaconDB.CleanUpDirtyStates(ctx, params.BeaconConfig().SlotsPerArchivedPoint))
+	require.Equal(t, true, beaconDB.HasState(ctx, aRoot))
+	gotState, err := New(beaconDB, doublylinkedtree.New()).StateByRoot(ctx, aRoot)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), gotState.Slot())
+}
+
+func TestMigrateToCold_StateDiffsReleaseHotState(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableHistoricalStateDiffs: true})
+	defer resetCfg()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.StateDiffExponents = []uint64{5, 6}
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB, doublylinkedtree.New())
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(32))
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 32
+	aRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, b)
+	require.NoError(t, service.epochBoundaryStateCache.put(aRoot, beaconState))
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, aRoot))
+	fBlock := util.NewBeaconBlock()
+	fBlock.Block.Slot = 33
+	fBlock.Block.ParentRoot = aRoot[:]
+	fRoot, err := fBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, fBlock)
+
+	service.saveHotStateDB.blockRootsOfSavedStates = [][32]byte{{1}, aRoot, {2}}
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+	assert.DeepEqual(t, [][32]byte{{1}, {2}}, service.saveHotStateDB.blockRootsOfSavedStates)
+}
+
 func TestMigrateToCold_RegeneratePath(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
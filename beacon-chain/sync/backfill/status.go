@@ -2,42 +2,55 @@ package backfill
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 )
 
-// NewStatus correctly initializes a Status value with the required database value.
-func NewStatus(store BackfillDB) *Status {
+// NewStatus correctly initializes a Status value with the required database value. The database is
+// only migrated to the backfill of the history below the origin checkpoint when enabled is set.
+func NewStatus(store BackfillDB, enabled bool) *Status {
 	return &Status{
-		store: store,
+		store:   store,
+		enabled: enabled,
 	}
 }
 
 // Status provides a way to update and query the status of a backfill process that may be necessary to track when
 // a node was initialized via checkpoint sync. With checkpoint sync, there will be a gap in node history from genesis
-// until the checkpoint sync origin block. Status provides the means to update the value keeping track of the lower
-// end of the missing block range via the Advance() method, to check whether a Slot is missing from the database
-// via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// until the checkpoint sync origin block. The gap is filled backwards, from the origin towards genesis. Status
+// provides the means to update the value keeping track of the lowest block of the history connected to the origin
+// via the Advance() method, to check whether a Slot is missing from the database via the SlotCovered() method,
+// and to see the current StartGap() and EndGap(). The states of the backfilled blocks can only be regenerated
+// once the backfilled history reaches down to a block whose state is saved in the database, such as genesis.
 type Status struct {
+	lock        sync.RWMutex
 	start       types.Slot
 	end         types.Slot
+	archived    types.Slot
+	origin      types.Slot
 	store       BackfillDB
 	genesisSync bool
+	enabled     bool
 }
 
-// SlotCovered uses StartGap() and EndGap() to determine if the given slot is covered by the current chain history.
-// If the slot is <= StartGap(), or >= EndGap(), the result is true.
-// If the slot is between StartGap() and EndGap(), the result is false.
+// SlotCovered determines if the states of the given slot can be regenerated from the current chain history.
+// If the slot is <= StartGap(), or >= the slot of the lowest saved state of the backfilled history, the result is true.
+// Otherwise the result is false, even when the slot was backfilled, as there is no saved state to replay its
+// blocks from.
 func (s *Status) SlotCovered(sl types.Slot) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	// short circuit if the node was synced from genesis
 	if s.genesisSync {
 		return true
 	}
-	if s.StartGap() < sl && sl < s.EndGap() {
+	if s.start < sl && sl < s.archived {
 		return false
 	}
 	return true
@@ -45,29 +58,58 @@ func (s *Status) SlotCovered(sl types.Slot) bool {
 
 // StartGap returns the slot at the beginning of the range that needs to be backfilled.
 func (s *Status) StartGap() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	return s.start
 }
 
-// EndGap returns the slot at the end of the range that needs to be backfilled.
+// EndGap returns the slot at the end of the range that needs to be backfilled, which is the slot of the lowest
+// block backfilled so far.
 func (s *Status) EndGap() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	return s.end
 }
 
+// OriginSlot returns the slot of the checkpoint sync origin block, where the backfill started.
+func (s *Status) OriginSlot() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.origin
+}
+
 var ErrAdvancePastOrigin = errors.New("cannot advance backfill Status beyond the origin checkpoint slot")
 
 // Advance advances the backfill position to the given slot & root.
 // It updates the backfill block root entry in the database,
 // and also updates the Status value's copy of the backfill position slot.
 func (s *Status) Advance(ctx context.Context, upTo types.Slot, root [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	if upTo > s.end {
 		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.end)
 	}
-	s.start = upTo
-	return s.store.SaveBackfillBlockRoot(ctx, root)
+	if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return err
+	}
+	s.end = upTo
+	if s.store.HasState(ctx, root) {
+		s.archived = upTo
+	}
+	return nil
+}
+
+// GenesisSync returns true if the node was synced from genesis, in which case there is nothing to backfill.
+func (s *Status) GenesisSync() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.genesisSync
 }
 
 // Reload queries the database for backfill status, initializing the internal data and validating the database state.
 func (s *Status) Reload(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
 	if err != nil {
 		// mark genesis sync and short circuit further lookups
@@ -84,9 +126,9 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(cpBlock); err != nil {
 		return err
 	}
-	s.end = cpBlock.Block().Slot()
+	s.origin = cpBlock.Block().Slot()
 
-	_, err = s.store.GenesisBlockRoot(ctx)
+	genesisRoot, err := s.store.GenesisBlockRoot(ctx)
 	if err != nil {
 		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root required for checkpoint sync")
@@ -101,6 +143,22 @@ func (s *Status) Reload(ctx context.Context) error {
 		}
 		return err
 	}
+	if s.enabled && bfRoot == genesisRoot {
+		// Databases initialized before backfill was implemented point the backfill block root to genesis,
+		// without any of the history in between. Restart the backfill from the origin in that case, which
+		// is only done once the backfill is enabled.
+		parentRoot := cpBlock.Block().ParentRoot()
+		parent, err := s.store.Block(ctx, parentRoot)
+		if err != nil {
+			return errors.Wrapf(err, "error retrieving parent block of origin checkpoint root=%#x", parentRoot)
+		}
+		if parentRoot != genesisRoot && blocks.BeaconBlockIsNil(parent) != nil {
+			if err := s.store.SaveBackfillBlockRoot(ctx, cpRoot); err != nil {
+				return errors.Wrap(err, "could not reset backfill block root to origin checkpoint root")
+			}
+			bfRoot = cpRoot
+		}
+	}
 	bfBlock, err := s.store.Block(ctx, bfRoot)
 	if err != nil {
 		return errors.Wrapf(err, "error retrieving block for backfill root=%#x", bfRoot)
@@ -108,10 +166,23 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(bfBlock); err != nil {
 		return err
 	}
-	s.start = bfBlock.Block().Slot()
+	s.start = params.BeaconConfig().GenesisSlot
+	s.end = bfBlock.Block().Slot()
+	// The saved states passed by the backfill before a restart are not known, the backfilled history is only
+	// connected to a saved state when the lowest backfilled block has one.
+	s.archived = s.origin
+	if s.store.HasState(ctx, bfRoot) {
+		s.archived = s.end
+	}
 	return nil
 }
 
+// StatusFetcher retrieves the progress of the backfill.
+type StatusFetcher interface {
+	EndGap() types.Slot
+	OriginSlot() types.Slot
+}
+
 // BackfillDB describes the set of DB methods that the Status type needs to function.
 type BackfillDB interface {
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
@@ -119,4 +190,5 @@ type BackfillDB interface {
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 	Block(ctx context.Context, blockRoot [32]byte) (interfaces.SignedBeaconBlock, error)
+	HasState(ctx context.Context, blockRoot [32]byte) bool
 }
@@ -23,6 +23,7 @@ type mockBackfillDB struct {
 	originCheckpointBlockRoot func(ctx context.Context) ([32]byte, error)
 	backfillBlockRoot         func(ctx context.Context) ([32]byte, error)
 	block                     func(ctx context.Context, blockRoot [32]byte) (interfaces.SignedBeaconBlock, error)
+	hasState                  func(ctx context.Context, blockRoot [32]byte) bool
 }
 
 var _ BackfillDB = &mockBackfillDB{}
@@ -62,6 +63,13 @@ func (db *mockBackfillDB) Block(ctx context.Context, blockRoot [32]byte) (interf
 	return nil, errEmptyMockDBMethod
 }
 
+func (db *mockBackfillDB) HasState(ctx context.Context, blockRoot [32]byte) bool {
+	if db.hasState != nil {
+		return db.hasState(ctx, blockRoot)
+	}
+	return false
+}
+
 func TestSlotCovered(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -77,13 +85,13 @@ func TestSlotCovered(t *testing.T) {
 		},
 		{
 			name:   "above end true",
-			status: &Status{end: 1},
+			status: &Status{end: 1, archived: 1},
 			slot:   2,
 			result: true,
 		},
 		{
 			name:   "equal end true",
-			status: &Status{end: 1},
+			status: &Status{end: 1, archived: 1},
 			slot:   1,
 			result: true,
 		},
@@ -95,10 +103,22 @@ func TestSlotCovered(t *testing.T) {
 		},
 		{
 			name:   "between false",
-			status: &Status{start: 1, end: 3},
+			status: &Status{start: 1, end: 3, archived: 3},
 			slot:   2,
 			result: false,
 		},
+		{
+			name:   "backfilled above archived true",
+			status: &Status{start: 1, end: 3, archived: 5},
+			slot:   5,
+			result: true,
+		},
+		{
+			name:   "backfilled below archived false",
+			status: &Status{start: 1, end: 3, archived: 5},
+			slot:   4,
+			result: false,
+		},
 		{
 			name:   "genesisSync always true",
 			status: &Status{genesisSync: true},
@@ -115,25 +135,37 @@ func TestSlotCovered(t *testing.T) {
 func TestAdvance(t *testing.T) {
 	ctx := context.Background()
 	saveBackfillBuf := make([][32]byte, 0)
+	var root, archivedRoot [32]byte
+	copy(root[:], []byte{0x23, 0x23})
+	copy(archivedRoot[:], []byte{0x42, 0x42})
 	mdb := &mockBackfillDB{
 		saveBackfillBlockRoot: func(ctx context.Context, root [32]byte) error {
 			saveBackfillBuf = append(saveBackfillBuf, root)
 			return nil
 		},
+		hasState: func(ctx context.Context, root [32]byte) bool {
+			return root == archivedRoot
+		},
 	}
-	s := &Status{end: 100, store: mdb}
-	var root [32]byte
-	copy(root[:], []byte{0x23, 0x23})
+	s := &Status{end: 100, archived: 100, origin: 100, store: mdb}
 	require.NoError(t, s.Advance(ctx, 90, root))
 	require.Equal(t, root, saveBackfillBuf[0])
-	not := s.SlotCovered(95)
-	require.Equal(t, false, not)
+	require.Equal(t, types.Slot(90), s.EndGap())
+	// The backfilled slots are not covered until a saved state is reached.
+	require.Equal(t, true, s.SlotCovered(100))
+	require.Equal(t, false, s.SlotCovered(95))
+	require.Equal(t, false, s.SlotCovered(85))
 
-	// this should still be len 1 after failing to advance
-	require.Equal(t, 1, len(saveBackfillBuf))
-	require.ErrorIs(t, s.Advance(ctx, s.end+1, root), ErrAdvancePastOrigin)
-	// this has an element in it from the previous test, there shouldn't be an additional one
-	require.Equal(t, 1, len(saveBackfillBuf))
+	require.NoError(t, s.Advance(ctx, 80, archivedRoot))
+	require.Equal(t, true, s.SlotCovered(95))
+	require.Equal(t, true, s.SlotCovered(80))
+	require.Equal(t, false, s.SlotCovered(75))
+
+	// this should still be len 2 after failing to advance
+	require.Equal(t, 2, len(saveBackfillBuf))
+	require.ErrorIs(t, s.Advance(ctx, s.origin+1, root), ErrAdvancePastOrigin)
+	// this has elements in it from the previous advances, there shouldn't be an additional one
+	require.Equal(t, 2, len(saveBackfillBuf))
 }
 
 func goodBlockRoot(root [32]byte) func(ctx context.Context) ([32]byte, error) {
@@ -167,10 +199,17 @@ func TestReload(t *testing.T) {
 	backfillBlock, err := setupTestBlock(backfillSlot)
 	require.NoError(t, err)
 
+	var genesisRoot [32]byte
+	copy(genesisRoot[:], []byte{0x03})
+	genesisBlock, err := setupTestBlock(0)
+	require.NoError(t, err)
+	var savedBackfillRoot [32]byte
+
 	cases := []struct {
 		name     string
 		db       BackfillDB
 		err      error
+		enabled  bool
 		expected *Status
 	}{
 		/*{
@@ -334,14 +373,80 @@ func TestReload(t *testing.T) {
 				backfillBlockRoot: goodBlockRoot(backfillRoot),
 			},
 			err:      derp,
-			expected: &Status{genesisSync: false, start: backfillSlot, end: originSlot},
+			expected: &Status{genesisSync: false, start: 0, end: backfillSlot, archived: originSlot, origin: originSlot},
+		},
+		{
+			name: "backfill block with saved state",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.SignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case backfillRoot:
+						return backfillBlock, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(backfillRoot),
+				hasState: func(ctx context.Context, root [32]byte) bool {
+					return root == backfillRoot
+				},
+			},
+			expected: &Status{genesisSync: false, start: 0, end: backfillSlot, archived: backfillSlot, origin: originSlot},
+		},
+		{
+			name: "backfill root at genesis without history, reset to origin",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.SignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case genesisRoot:
+						return genesisBlock, nil
+					}
+					return nil, nil
+				},
+				backfillBlockRoot: goodBlockRoot(genesisRoot),
+				saveBackfillBlockRoot: func(ctx context.Context, root [32]byte) error {
+					savedBackfillRoot = root
+					return nil
+				},
+			},
+			enabled:  true,
+			expected: &Status{genesisSync: false, start: 0, end: originSlot, archived: originSlot, origin: originSlot},
+		},
+		{
+			name: "backfill root at genesis without history, backfill disabled",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.SignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case genesisRoot:
+						return genesisBlock, nil
+					}
+					return nil, nil
+				},
+				backfillBlockRoot: goodBlockRoot(genesisRoot),
+				saveBackfillBlockRoot: func(ctx context.Context, root [32]byte) error {
+					return errors.New("the backfill block root must not be migrated")
+				},
+				hasState: func(ctx context.Context, root [32]byte) bool {
+					return root == genesisRoot
+				},
+			},
+			expected: &Status{genesisSync: false, start: 0, end: 0, archived: 0, origin: originSlot},
 		},
 	}
 
 	for _, c := range cases {
-		s := &Status{
-			store: c.db,
-		}
+		s := NewStatus(c.db, c.enabled)
 		err := s.Reload(ctx)
 		if err != nil {
 			require.ErrorIs(t, err, c.err)
@@ -354,5 +459,8 @@ func TestReload(t *testing.T) {
 		require.Equal(t, c.expected.genesisSync, s.genesisSync)
 		require.Equal(t, c.expected.start, s.start)
 		require.Equal(t, c.expected.end, s.end)
+		require.Equal(t, c.expected.archived, s.archived)
+		require.Equal(t, c.expected.origin, s.origin)
 	}
+	require.Equal(t, originRoot, savedBackfillRoot)
 }
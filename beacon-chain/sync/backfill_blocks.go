@@ -0,0 +1,282 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v3/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v3/network/forks"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+const (
+	defaultBackfillBatchSize       = 64
+	defaultBackfillBlocksPerSecond = 32
+	// backfillRetryInterval is how long the backfill waits after a failed batch, or while the node is syncing.
+	backfillRetryInterval = 6 * time.Second
+)
+
+var errBackfillNoPeers = errors.New("no peers to backfill blocks from")
+
+// backfiller holds the state of the backfill between batches.
+type backfiller struct {
+	limiter *leakybucket.LeakyBucket
+	randGen *rand.Rand
+	// emptyRoot is the root of the lowest backfilled block when the slots from emptyStart up to it were
+	// reported empty, so the next batch is requested below emptyStart.
+	emptyRoot  [32]byte
+	emptyStart types.Slot
+}
+
+// backfillRoutine downloads the blocks missing between genesis and the checkpoint sync origin, backwards
+// from the origin, once the node is synced. It stops when it reaches genesis.
+func (s *Service) backfillRoutine() {
+	batchSize := s.cfg.backfillBatchSize
+	if batchSize == 0 {
+		batchSize = defaultBackfillBatchSize
+	}
+	if batchSize > params.BeaconNetworkConfig().MaxRequestBlocks {
+		batchSize = params.BeaconNetworkConfig().MaxRequestBlocks
+	}
+	blocksPerSecond := s.cfg.backfillBlocksPerSecond
+	if blocksPerSecond == 0 {
+		blocksPerSecond = defaultBackfillBlocksPerSecond
+	}
+	capacity := blocksPerSecond
+	if capacity < batchSize {
+		capacity = batchSize
+	}
+	bf := &backfiller{
+		limiter: leakybucket.NewLeakyBucket(float64(blocksPerSecond), int64(capacity), time.Second),
+		randGen: rand.NewGenerator(),
+	}
+
+	status := s.cfg.backfillStatus
+	for {
+		if status.GenesisSync() || status.EndGap() <= status.StartGap() {
+			backfillSlotGauge.Set(float64(status.EndGap()))
+			if !status.GenesisSync() {
+				log.Info("Backfill of the blocks before the checkpoint sync origin is complete")
+			}
+			return
+		}
+		backfillSlotGauge.Set(float64(status.EndGap()))
+		if !s.chainStarted.IsSet() || s.cfg.initialSync.Syncing() {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(backfillRetryInterval):
+				continue
+			}
+		}
+
+		start := time.Now()
+		if err := s.backfillBatch(s.ctx, bf, batchSize); err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			backfillFailuresCount.Inc()
+			log.WithError(err).Debug("Could not backfill blocks")
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(backfillRetryInterval):
+			}
+			continue
+		}
+		backfillBatchDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}
+}
+
+// backfillBatch requests the batch of blocks below the lowest backfilled block from a peer. The blocks are
+// saved and marked as finalized once they are verified to be the ancestors of the lowest backfilled block,
+// signed by their proposers.
+func (s *Service) backfillBatch(ctx context.Context, bf *backfiller, batchSize uint64) error {
+	ctx, span := trace.StartSpan(ctx, "sync.backfillBatch")
+	defer span.End()
+
+	lowRoot, err := s.cfg.beaconDB.BackfillBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill block root")
+	}
+	low, err := s.cfg.beaconDB.Block(ctx, lowRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get backfill block %#x", lowRoot)
+	}
+	if err := blocks.BeaconBlockIsNil(low); err != nil {
+		return err
+	}
+	genesisRoot, err := s.cfg.beaconDB.GenesisBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	lowSlot := low.Block().Slot()
+	parentRoot := low.Block().ParentRoot()
+	if parentRoot == genesisRoot {
+		return s.cfg.backfillStatus.Advance(ctx, params.BeaconConfig().GenesisSlot, genesisRoot)
+	}
+
+	endSlot := lowSlot
+	windowMoved := bf.emptyRoot == lowRoot
+	if windowMoved {
+		endSlot = bf.emptyStart
+	}
+	startSlot := params.BeaconConfig().GenesisSlot + 1
+	if endSlot > startSlot+types.Slot(batchSize) {
+		startSlot = endSlot - types.Slot(batchSize)
+	}
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: startSlot,
+		Count:     uint64(endSlot - startSlot),
+		Step:      1,
+	}
+
+	_, bestPeers := s.cfg.p2p.Peers().BestFinalized(maxPeerRequest, s.cfg.chain.FinalizedCheckpt().Epoch)
+	if len(bestPeers) == 0 {
+		return errBackfillNoPeers
+	}
+	pid := bestPeers[bf.randGen.Intn(len(bestPeers))]
+
+	if bf.limiter.Remaining() < int64(req.Count) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(bf.limiter.TillEmpty()):
+		}
+	}
+	bf.limiter.Add(int64(req.Count))
+	blks, err := SendBeaconBlocksByRangeRequest(ctx, s.cfg.chain, s.cfg.p2p, pid, req, nil)
+	if err != nil {
+		if errors.Is(err, ErrInvalidFetchedData) {
+			s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		}
+		return errors.Wrapf(err, "could not request blocks from peer %s", pid)
+	}
+	if len(blks) == 0 {
+		if startSlot > params.BeaconConfig().GenesisSlot+1 {
+			// Every slot of the range may have been skipped, so the next batch is requested below it.
+			bf.emptyRoot, bf.emptyStart = lowRoot, startSlot
+			log.WithFields(logrus.Fields{
+				"peer":      pid,
+				"startSlot": startSlot,
+				"endSlot":   endSlot - 1,
+			}).Debug("No blocks to backfill in range")
+			return nil
+		}
+		// The lowest backfilled block is not at genesis, so its parent is in one of the slots reported
+		// empty since then, and the peer withheld at least the blocks of this range.
+		bf.emptyRoot = [32]byte{}
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		return errors.Errorf("peer %s returned no blocks for slots %d to %d", pid, startSlot, endSlot-1)
+	}
+
+	roots, err := s.verifyBackfillChain(blks, parentRoot)
+	if err != nil {
+		if windowMoved {
+			// The blocks of the slots reported empty before may have been withheld by another peer,
+			// so the range is requested again from the lowest backfilled block.
+			bf.emptyRoot = [32]byte{}
+			return errors.Wrapf(err, "blocks from peer %s do not lead to the lowest backfilled block", pid)
+		}
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		return errors.Wrapf(err, "invalid blocks from peer %s", pid)
+	}
+	if err := s.verifyBackfillSignatures(ctx, blks); err != nil {
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		return errors.Wrapf(err, "invalid blocks from peer %s", pid)
+	}
+
+	if err := s.cfg.beaconDB.SaveBlocks(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	if err := s.cfg.beaconDB.BackfillFinalizedIndex(ctx, blks, lowRoot); err != nil {
+		return errors.Wrap(err, "could not index backfilled blocks")
+	}
+	lowest := blks[0].Block().Slot()
+	if err := s.cfg.backfillStatus.Advance(ctx, lowest, roots[0]); err != nil {
+		return errors.Wrap(err, "could not advance backfill status")
+	}
+	backfillBlocksCount.Add(float64(len(blks)))
+	backfillSlotGauge.Set(float64(lowest))
+	log.WithFields(logrus.Fields{
+		"peer":       pid,
+		"blocks":     len(blks),
+		"lowestSlot": lowest,
+		"originSlot": s.cfg.backfillStatus.OriginSlot(),
+	}).Debug("Backfilled blocks")
+	return nil
+}
+
+// verifyBackfillChain checks that the blocks, sorted by slot, form a chain whose last block has the
+// given root, the parent root of the lowest backfilled block. It returns the roots of the blocks.
+func (_ *Service) verifyBackfillChain(blks []interfaces.SignedBeaconBlock, childParentRoot [32]byte) ([][32]byte, error) {
+	roots := make([][32]byte, len(blks))
+	expected := childParentRoot
+	for i := len(blks) - 1; i >= 0; i-- {
+		if err := blocks.BeaconBlockIsNil(blks[i]); err != nil {
+			return nil, err
+		}
+		root, err := blks[i].Block().HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		if root != expected {
+			return nil, errors.Wrapf(ErrInvalidFetchedData, "block %#x at slot %d is not the expected ancestor %#x",
+				root, blks[i].Block().Slot(), expected)
+		}
+		roots[i] = root
+		expected = blks[i].Block().ParentRoot()
+	}
+	return roots, nil
+}
+
+// verifyBackfillSignatures verifies the proposer signatures of the blocks in a single batch.
+func (s *Service) verifyBackfillSignatures(ctx context.Context, blks []interfaces.SignedBeaconBlock) error {
+	gvr := s.cfg.chain.GenesisValidatorsRoot()
+	set := bls.NewSet()
+	for _, blk := range blks {
+		epoch := slots.ToEpoch(blk.Block().Slot())
+		fork, err := forks.Fork(epoch)
+		if err != nil {
+			return err
+		}
+		domain, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainBeaconProposer, gvr[:])
+		if err != nil {
+			return err
+		}
+		pub, err := s.cfg.chain.HeadValidatorIndexToPublicKey(ctx, blk.Block().ProposerIndex())
+		if err != nil {
+			return errors.Wrapf(err, "could not get public key of proposer %d", blk.Block().ProposerIndex())
+		}
+		if pub == [fieldparams.BLSPubkeyLength]byte{} {
+			return errors.Errorf("unknown proposer %d", blk.Block().ProposerIndex())
+		}
+		sig := blk.Signature()
+		blkSet, err := signing.BlockSignatureBatch(pub[:], sig[:], domain, blk.Block().HashTreeRoot)
+		if err != nil {
+			return err
+		}
+		set.Join(blkSet)
+	}
+	res, err := s.validateWithBatchVerifier(ctx, "backfilled blocks", set)
+	if err != nil {
+		return err
+	}
+	if res != pubsub.ValidationAccept {
+		return errors.New("invalid proposer signature")
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v3/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+// backfillTestChain returns a chain of blocks from genesis to the given slot, signed by the given key.
+// The chain is indexed by slot, and the skipped slots are nil.
+func backfillTestChain(t *testing.T, key bls.SecretKey, gvr [32]byte, slot types.Slot, skipped ...types.Slot) []interfaces.SignedBeaconBlock {
+	genesis := util.NewBeaconBlock()
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	wsb, err := blocks.NewSignedBeaconBlock(genesis)
+	require.NoError(t, err)
+	chain := []interfaces.SignedBeaconBlock{wsb}
+	skip := make(map[types.Slot]bool, len(skipped))
+	for _, s := range skipped {
+		skip[s] = true
+	}
+	for i := types.Slot(1); i <= slot; i++ {
+		if skip[i] {
+			chain = append(chain, nil)
+			continue
+		}
+		b := util.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parentRoot[:])
+		epoch := slots.ToEpoch(i)
+		fork, err := forks.Fork(epoch)
+		require.NoError(t, err)
+		domain, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainBeaconProposer, gvr[:])
+		require.NoError(t, err)
+		root, err := signing.ComputeSigningRoot(b.Block, domain)
+		require.NoError(t, err)
+		b.Signature = key.Sign(root[:]).Marshal()
+		parentRoot, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		chain = append(chain, wsb)
+	}
+	return chain
+}
+
+// backfillTestPeer connects a peer serving the given blocks, indexed by slot, to p1.
+func backfillTestPeer(t *testing.T, p1 *p2ptest.TestP2P, chainService *mock.ChainService, chain []interfaces.SignedBeaconBlock) *p2ptest.TestP2P {
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
+	p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
+	p1.Peers().SetChainState(p2.PeerID(), &ethpb.Status{})
+	p2.SetStreamHandler(fmt.Sprintf("%s/ssz_snappy", p2p.RPCBlocksByRangeTopicV1), func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		req := &ethpb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, req))
+		for i := req.StartSlot; i < req.StartSlot.Add(req.Count) && uint64(i) < uint64(len(chain)); i++ {
+			if chain[i] == nil {
+				continue
+			}
+			assert.NoError(t, WriteBlockChunk(stream, chainService, p2.Encoding(), chain[i]))
+		}
+	})
+	return p2
+}
+
+// backfillTestService returns a service whose database holds the genesis block and the checkpoint sync
+// origin block of the chain, so the blocks in between are to be backfilled.
+func backfillTestService(
+	t *testing.T, ctx context.Context, key bls.SecretKey, gvr [32]byte, chain []interfaces.SignedBeaconBlock,
+) (*Service, *backfill.Status, *mock.ChainService) {
+	origin := types.Slot(len(chain) - 1)
+	genesisRoot, err := chain[0].Block().HashTreeRoot()
+	require.NoError(t, err)
+	originRoot, err := chain[origin].Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	db, err := kv.NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	require.NoError(t, db.SaveBlocks(ctx, []interfaces.SignedBeaconBlock{chain[0], chain[origin]}))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: slots.ToEpoch(origin), Root: originRoot[:]}))
+	bfs := backfill.NewStatus(db, true)
+	require.NoError(t, bfs.Reload(ctx))
+	require.Equal(t, origin, bfs.EndGap())
+
+	chainService := &mock.ChainService{
+		Genesis:             time.Now(),
+		ValidatorsRoot:      gvr,
+		PublicKey:           bytesutil.ToBytes48(key.PublicKey().Marshal()),
+		FinalizedCheckPoint: &ethpb.Checkpoint{},
+	}
+	r := &Service{
+		ctx: ctx,
+		cfg: &config{
+			beaconDB:       db,
+			p2p:            p2ptest.NewTestP2P(t),
+			chain:          chainService,
+			backfillStatus: bfs,
+		},
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	go r.verifierRoutine()
+	return r, bfs, chainService
+}
+
+func TestService_backfillBatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	gvr := [32]byte{'A'}
+	origin := types.Slot(10)
+	chain := backfillTestChain(t, key, gvr, origin)
+	roots := make([][32]byte, len(chain))
+	for i, blk := range chain {
+		roots[i], err = blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	r, bfs, chainService := backfillTestService(t, ctx, key, gvr, chain)
+	db := r.cfg.beaconDB
+	p1, ok := r.cfg.p2p.(*p2ptest.TestP2P)
+	require.Equal(t, true, ok)
+	bf := &backfiller{
+		limiter: leakybucket.NewLeakyBucket(1000, 1000, time.Second),
+		randGen: rand.NewGenerator(),
+	}
+
+	// Peers returning no blocks are only penalized once every slot down to genesis was reported empty.
+	emptyPeer := backfillTestPeer(t, p1, chainService, nil)
+	require.NoError(t, r.backfillBatch(ctx, bf, 4))
+	require.NoError(t, r.backfillBatch(ctx, bf, 4))
+	badResponses, err := p1.Peers().Scorers().BadResponsesScorer().Count(emptyPeer.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 0, badResponses)
+	require.ErrorContains(t, "returned no blocks", r.backfillBatch(ctx, bf, 4))
+	assert.Equal(t, origin, bfs.EndGap())
+	badResponses, err = p1.Peers().Scorers().BadResponsesScorer().Count(emptyPeer.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses)
+	p1.Peers().SetConnectionState(emptyPeer.PeerID(), peers.PeerDisconnected)
+
+	// Blocks signed by another key are rejected.
+	otherKey, err := bls.RandKey()
+	require.NoError(t, err)
+	badPeer := backfillTestPeer(t, p1, chainService, backfillTestChain(t, otherKey, gvr, origin))
+	require.ErrorContains(t, "invalid blocks from peer", r.backfillBatch(ctx, bf, 4))
+	assert.Equal(t, origin, bfs.EndGap())
+	badResponses, err = p1.Peers().Scorers().BadResponsesScorer().Count(badPeer.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses)
+	p1.Peers().SetConnectionState(badPeer.PeerID(), peers.PeerDisconnected)
+
+	backfillTestPeer(t, p1, chainService, chain)
+	require.NoError(t, r.backfillBatch(ctx, bf, 4))
+	assert.Equal(t, types.Slot(6), bfs.EndGap())
+	// The states of the backfilled slots can not be regenerated before the genesis state is reached.
+	assert.Equal(t, false, bfs.SlotCovered(6))
+	assert.Equal(t, true, bfs.SlotCovered(origin))
+	for i := 0; i < 3 && bfs.EndGap() > 0; i++ {
+		require.NoError(t, r.backfillBatch(ctx, bf, 4))
+	}
+	assert.Equal(t, types.Slot(0), bfs.EndGap())
+	assert.Equal(t, true, bfs.SlotCovered(6))
+	bfRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[0], bfRoot)
+	for i := types.Slot(1); i < origin; i++ {
+		blk, err := db.Block(ctx, roots[i])
+		require.NoError(t, err)
+		require.NotNil(t, blk)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[i]), "Block at slot %d is not finalized", i)
+	}
+}
+
+func TestService_backfillBatch_SkippedSlots(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	gvr := [32]byte{'A'}
+	// The parent of the block at slot 10 is the block at slot 3.
+	chain := backfillTestChain(t, key, gvr, 10, 4, 5, 6, 7, 8, 9)
+	r, bfs, chainService := backfillTestService(t, ctx, key, gvr, chain)
+	p1, ok := r.cfg.p2p.(*p2ptest.TestP2P)
+	require.Equal(t, true, ok)
+	bf := &backfiller{
+		limiter: leakybucket.NewLeakyBucket(1000, 1000, time.Second),
+		randGen: rand.NewGenerator(),
+	}
+
+	// The peer rightly returns no blocks for slots 6 to 9, so the next batch is requested below them.
+	p2 := backfillTestPeer(t, p1, chainService, chain)
+	require.NoError(t, r.backfillBatch(ctx, bf, 4))
+	assert.Equal(t, types.Slot(10), bfs.EndGap())
+	require.NoError(t, r.backfillBatch(ctx, bf, 4))
+	assert.Equal(t, types.Slot(2), bfs.EndGap())
+	badResponses, err := p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 0, badResponses)
+}
+
+func TestService_verifyBackfillChain(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	chain := backfillTestChain(t, key, [32]byte{}, 4)
+	childParentRoot, err := chain[4].Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	r := &Service{}
+	roots, err := r.verifyBackfillChain(chain[1:5], childParentRoot)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(roots))
+	for i, root := range roots {
+		want, err := chain[i+1].Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, want, root)
+	}
+
+	_, err = r.verifyBackfillChain(chain[1:4], childParentRoot)
+	require.ErrorIs(t, err, ErrInvalidFetchedData)
+	_, err = r.verifyBackfillChain([]interfaces.SignedBeaconBlock{chain[1], chain[2], chain[4]}, childParentRoot)
+	require.ErrorIs(t, err, ErrInvalidFetchedData)
+}
@@ -24,20 +24,10 @@ func (s *Service) decodePubsubMessage(msg *pubsub.Message) (ssz.Unmarshaler, err
 	if err != nil {
 		return nil, errors.Wrapf(err, "extraction failed for topic: %s", topic)
 	}
-	topic = strings.TrimSuffix(topic, s.cfg.p2p.Encoding().ProtocolSuffix())
-	topic, err = s.replaceForkDigest(topic)
+	topic, err = s.gossipTopicFormat(topic)
 	if err != nil {
 		return nil, err
 	}
-	// Specially handle subnet messages.
-	switch {
-	case strings.Contains(topic, p2p.GossipAttestationMessage):
-		topic = p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.Attestation{})]
-		// Given that both sync message related subnets have the same message name, we have to
-		// differentiate them below.
-	case strings.Contains(topic, p2p.GossipSyncCommitteeMessage) && !strings.Contains(topic, p2p.SyncContributionAndProofSubnetTopicFormat):
-		topic = p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.SyncCommitteeMessage{})]
-	}
 
 	base := p2p.GossipTopicMappings(topic, 0)
 	if base == nil {
@@ -60,6 +50,26 @@ func (s *Service) decodePubsubMessage(msg *pubsub.Message) (ssz.Unmarshaler, err
 	return m, nil
 }
 
+// Returns the format of the given gossip topic, as used in the gossip topic mappings, by replacing the
+// fork digest with the formatter and dropping the encoding suffix and the subnet index.
+func (s *Service) gossipTopicFormat(topic string) (string, error) {
+	topic = strings.TrimSuffix(topic, s.cfg.p2p.Encoding().ProtocolSuffix())
+	topic, err := s.replaceForkDigest(topic)
+	if err != nil {
+		return "", err
+	}
+	// Specially handle subnet messages.
+	switch {
+	case strings.Contains(topic, p2p.GossipAttestationMessage):
+		topic = p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.Attestation{})]
+		// Given that both sync message related subnets have the same message name, we have to
+		// differentiate them below.
+	case strings.Contains(topic, p2p.GossipSyncCommitteeMessage) && !strings.Contains(topic, p2p.SyncContributionAndProofSubnetTopicFormat):
+		topic = p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.SyncCommitteeMessage{})]
+	}
+	return topic, nil
+}
+
 // Replaces our fork digest with the formatter.
 func (_ *Service) replaceForkDigest(topic string) (string, error) {
 	subStrings := strings.Split(topic, "/")
@@ -0,0 +1,74 @@
+package sync
+
+import (
+	"context"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
+	"go.opencensus.io/trace"
+)
+
+// gossipValidators returns the gossip validators of the service keyed by topic format, matching the
+// validators registered with the pubsub router in registerSubscribers.
+func (s *Service) gossipValidators() map[string]wrappedVal {
+	return map[string]wrappedVal{
+		p2p.BlockSubnetTopicFormat:                    s.validateBeaconBlockPubSub,
+		p2p.AggregateAndProofSubnetTopicFormat:        s.validateAggregateAndProof,
+		p2p.ExitSubnetTopicFormat:                     s.validateVoluntaryExit,
+		p2p.ProposerSlashingSubnetTopicFormat:         s.validateProposerSlashing,
+		p2p.AttesterSlashingSubnetTopicFormat:         s.validateAttesterSlashing,
+		p2p.AttestationSubnetTopicFormat:              s.validateCommitteeIndexBeaconAttestation,
+		p2p.SyncContributionAndProofSubnetTopicFormat: s.validateSyncContributionAndProof,
+		p2p.SyncCommitteeSubnetTopicFormat:            s.validateSyncCommitteeMessage,
+		p2p.LightClientFinalityUpdateTopicFormat:      s.validateLightClientFinalityUpdate,
+		p2p.LightClientOptimisticUpdateTopicFormat:    s.validateLightClientOptimisticUpdate,
+		p2p.BlsToExecutionChangeSubnetTopicFormat:     s.validateBlsToExecutionChange,
+	}
+}
+
+// ValidateGossipMessage runs the message through the gossip validator of its topic, as if it was
+// just received from msg.ReceivedFrom. The topic of the message is the full gossip topic, including
+// the fork digest and the encoding suffix. It is used to replay recorded gossip messages offline,
+// the subscriber of the topic is not run.
+func (s *Service) ValidateGossipMessage(ctx context.Context, msg *pubsub.Message) (res pubsub.ValidationResult, err error) {
+	ctx, span := trace.StartSpan(ctx, "sync.ValidateGossipMessage")
+	defer span.End()
+	defer func() {
+		if r := recover(); r != nil {
+			res, err = pubsub.ValidationIgnore, errors.Errorf("panic in gossip validator: %v", r)
+		}
+		if err != nil {
+			tracing.AnnotateError(span, err)
+		}
+	}()
+
+	if msg == nil || msg.Topic == nil || *msg.Topic == "" {
+		return pubsub.ValidationReject, errNilPubsubMessage
+	}
+	topic := *msg.Topic
+	digest, err := p2p.ExtractGossipDigest(topic)
+	if err != nil {
+		return pubsub.ValidationIgnore, errors.Wrapf(err, "extraction failed for topic: %s", topic)
+	}
+	currDigest, err := s.currentForkDigest()
+	if err != nil {
+		return pubsub.ValidationIgnore, errors.Wrap(err, "could not compute fork digest")
+	}
+	if currDigest != digest {
+		return pubsub.ValidationIgnore, errors.Errorf("message from outdated fork digest %#x", digest)
+	}
+	format, err := s.gossipTopicFormat(topic)
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+	validate, ok := s.gossipValidators()[format]
+	if !ok {
+		return pubsub.ValidationIgnore, errors.Wrapf(p2p.ErrMessageNotMapped, "topic %s", topic)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pubsubMessageTimeout)
+	defer cancel()
+	return validate(ctx, msg.ReceivedFrom, msg)
+}
@@ -0,0 +1,80 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	mockChain "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/v3/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestService_gossipTopicFormat(t *testing.T) {
+	r := &Service{cfg: &config{p2p: p2ptest.NewTestP2P(t)}}
+	tests := []struct {
+		topic string
+		want  string
+	}{
+		{topic: "/eth2/abababab/beacon_block/ssz_snappy", want: p2p.BlockSubnetTopicFormat},
+		{topic: "/eth2/abababab/beacon_aggregate_and_proof/ssz_snappy", want: p2p.AggregateAndProofSubnetTopicFormat},
+		{topic: "/eth2/abababab/beacon_attestation_12/ssz_snappy", want: p2p.AttestationSubnetTopicFormat},
+		{topic: "/eth2/abababab/sync_committee_3/ssz_snappy", want: p2p.SyncCommitteeSubnetTopicFormat},
+		{topic: "/eth2/abababab/sync_committee_contribution_and_proof/ssz_snappy", want: p2p.SyncContributionAndProofSubnetTopicFormat},
+	}
+	for _, tt := range tests {
+		got, err := r.gossipTopicFormat(tt.topic)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, tt.topic)
+		_, ok := r.gossipValidators()[got]
+		assert.Equal(t, true, ok, "No validator for %s", tt.topic)
+	}
+	_, err := r.gossipTopicFormat("foo")
+	require.ErrorIs(t, err, errInvalidTopic)
+}
+
+func TestService_ValidateGossipMessage(t *testing.T) {
+	p2pService := p2ptest.NewTestP2P(t)
+	r := &Service{
+		ctx: context.Background(),
+		cfg: &config{
+			p2p:         p2pService,
+			initialSync: &mockSync.Sync{IsSyncing: false},
+			chain: &mockChain.ChainService{
+				ValidatorsRoot: [32]byte{'A'},
+				Genesis:        time.Now(),
+			},
+		},
+	}
+	digest, err := r.currentForkDigest()
+	require.NoError(t, err)
+	newMsg := func(topic string) *pubsub.Message {
+		return &pubsub.Message{
+			Message:      &pubsubpb.Message{Topic: &topic, Data: []byte("not a message")},
+			ReceivedFrom: "peer",
+		}
+	}
+
+	res, err := r.ValidateGossipMessage(context.Background(), nil)
+	assert.Equal(t, pubsub.ValidationReject, res)
+	require.ErrorIs(t, err, errNilPubsubMessage)
+
+	res, err = r.ValidateGossipMessage(context.Background(), newMsg("/eth2/abababab/voluntary_exit/ssz_snappy"))
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+	assert.ErrorContains(t, "outdated fork digest", err)
+
+	res, err = r.ValidateGossipMessage(context.Background(), newMsg(fmt.Sprintf("/eth2/%x/foo/ssz_snappy", digest)))
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+	require.ErrorIs(t, err, p2p.ErrMessageNotMapped)
+
+	// The message reaches the validator of its topic, which rejects it.
+	res, err = r.ValidateGossipMessage(context.Background(), newMsg(fmt.Sprintf(p2p.ExitSubnetTopicFormat, digest)+p2pService.Encoding().ProtocolSuffix()))
+	assert.Equal(t, pubsub.ValidationReject, res)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,5 @@
+package gossipreplay
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "gossip-replay")
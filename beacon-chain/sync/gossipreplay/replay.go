@@ -0,0 +1,115 @@
+// Package gossipreplay replays recorded gossip messages through the gossip validators of the beacon
+// node, offline, at the time they were received by the node.
+package gossipreplay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/gossiptrace"
+	regularsync "github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+)
+
+// clock is the source of the current time of the gossip validators, set to the arrival time of the
+// replayed message.
+type clock struct {
+	lock sync.RWMutex
+	t    time.Time
+}
+
+func (c *clock) now() time.Time {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.t
+}
+
+func (c *clock) set(t time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.t = t
+}
+
+// Replayer validates recorded gossip messages against a beacon database.
+type Replayer struct {
+	chain        *blockchain.Service
+	validator    *regularsync.Service
+	clock        *clock
+	importBlocks bool
+}
+
+// NewReplayer starts the services needed to validate gossip messages from the finalized state of the
+// database. With importBlocks, the accepted blocks are imported optimistically into the database, so
+// that the messages which follow can refer to them.
+func NewReplayer(ctx context.Context, db *kv.Store, importBlocks bool) (*Replayer, error) {
+	c := &clock{t: time.Now()}
+	chain, validator, err := startServices(ctx, db, c)
+	if err != nil {
+		return nil, err
+	}
+	return &Replayer{
+		chain:        chain,
+		validator:    validator,
+		clock:        c,
+		importBlocks: importBlocks,
+	}, nil
+}
+
+// Replay validates the recorded message at the time it was received, and returns the replayed
+// validation result along with the error of the validator, if any.
+func (r *Replayer) Replay(ctx context.Context, rec *gossiptrace.Record) (gossiptrace.Result, error) {
+	// Duplicates were not validated by the node, and their data is not traced.
+	if rec.Result == gossiptrace.ResultDuplicate {
+		return gossiptrace.ResultDuplicate, nil
+	}
+	r.clock.set(rec.Arrival)
+	topic := rec.Topic
+	msg := &pubsub.Message{
+		Message:      &pubsubpb.Message{Topic: &topic, Data: rec.Data},
+		ID:           rec.MessageID,
+		ReceivedFrom: rec.From,
+	}
+	res, err := r.validator.ValidateGossipMessage(ctx, msg)
+	if r.importBlocks && res == pubsub.ValidationAccept {
+		if err := r.importBlock(ctx, msg); err != nil {
+			log.WithError(err).Error("Could not import block")
+		}
+	}
+	return replayedResult(res), err
+}
+
+func (r *Replayer) importBlock(ctx context.Context, msg *pubsub.Message) error {
+	blk, ok := msg.ValidatorData.(interfaces.SignedBeaconBlock)
+	if !ok {
+		return nil
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash block")
+	}
+	if err := r.chain.ReceiveBlock(ctx, blk, root); err != nil {
+		return errors.Wrapf(err, "could not receive block at slot %d", blk.Block().Slot())
+	}
+	return nil
+}
+
+// replayedResult converts the result of a gossip validator to a traced result.
+func replayedResult(res pubsub.ValidationResult) gossiptrace.Result {
+	switch res {
+	case pubsub.ValidationAccept:
+		return gossiptrace.ResultAccept
+	case pubsub.ValidationReject:
+		return gossiptrace.ResultReject
+	case pubsub.ValidationIgnore:
+		return gossiptrace.ResultIgnore
+	default:
+		// The only other result is the throttled result of the pubsub router, which is not exported.
+		return gossiptrace.ResultThrottled
+	}
+}
@@ -0,0 +1,32 @@
+package gossipreplay
+
+import (
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/gossiptrace"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+)
+
+func TestReplayedResult(t *testing.T) {
+	tests := []struct {
+		res  pubsub.ValidationResult
+		want gossiptrace.Result
+	}{
+		{res: pubsub.ValidationAccept, want: gossiptrace.ResultAccept},
+		{res: pubsub.ValidationReject, want: gossiptrace.ResultReject},
+		{res: pubsub.ValidationIgnore, want: gossiptrace.ResultIgnore},
+		{res: pubsub.ValidationResult(-1), want: gossiptrace.ResultThrottled},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, replayedResult(tt.res))
+	}
+}
+
+func TestClock(t *testing.T) {
+	arrival := time.Unix(1606824023, 0)
+	c := &clock{t: time.Now()}
+	c.set(arrival)
+	assert.Equal(t, arrival, c.now())
+}
@@ -0,0 +1,168 @@
+package gossipreplay
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
+	mockExecution "github.com/prysmaticlabs/prysm/v3/beacon-chain/execution/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/voluntaryexits"
+	p2ptest "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
+	regularsync "github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+)
+
+// notifier provides the event feeds of the beacon node to the replay services.
+type notifier struct {
+	stateFeed     *event.Feed
+	blockFeed     *event.Feed
+	operationFeed *event.Feed
+}
+
+// StateFeed --
+func (n *notifier) StateFeed() *event.Feed {
+	return n.stateFeed
+}
+
+// BlockFeed --
+func (n *notifier) BlockFeed() *event.Feed {
+	return n.blockFeed
+}
+
+// OperationFeed --
+func (n *notifier) OperationFeed() *event.Feed {
+	return n.operationFeed
+}
+
+// syncChecker reports the node as synced, so that the validators do not ignore gossip messages.
+type syncChecker struct{}
+
+// Initialized --
+func (_ *syncChecker) Initialized() bool {
+	return true
+}
+
+// Syncing --
+func (_ *syncChecker) Syncing() bool {
+	return false
+}
+
+// Synced --
+func (_ *syncChecker) Synced() bool {
+	return true
+}
+
+// Status --
+func (_ *syncChecker) Status() error {
+	return nil
+}
+
+// Resync --
+func (_ *syncChecker) Resync() error {
+	return nil
+}
+
+// startServices starts the blockchain and sync services needed to run the gossip validators, from the
+// finalized state of the database. The gossip validators read the current time from the given clock.
+func startServices(ctx context.Context, db *kv.Store, clock *clock) (*blockchain.Service, *regularsync.Service, error) {
+	fc := doublylinkedtree.New()
+	sg := stategen.New(db, fc)
+	cp, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	root := bytesutil.ToBytes32(cp.Root)
+	if root == params.BeaconConfig().ZeroHash {
+		genesisBlock, err := db.GenesisBlock(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get genesis block")
+		}
+		if genesisBlock == nil || genesisBlock.IsNil() {
+			return nil, nil, errors.New("database has no genesis block")
+		}
+		root, err = genesisBlock.Block().HashTreeRoot()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	finalizedState, err := sg.StateByRoot(ctx, root)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get finalized state")
+	}
+
+	n := &notifier{stateFeed: new(event.Feed), blockFeed: new(event.Feed), operationFeed: new(event.Feed)}
+	attPool := attestations.NewPool()
+	exitPool := voluntaryexits.NewPool()
+	slashingPool := slashings.NewPool()
+	blsToExecPool := blstoexec.NewPool()
+	fakeP2P := &p2ptest.FakeP2P{}
+	depositCache, err := depositcache.New()
+	if err != nil {
+		return nil, nil, err
+	}
+	attService, err := attestations.NewService(ctx, &attestations.Config{Pool: attPool})
+	if err != nil {
+		return nil, nil, err
+	}
+	// Without an execution client, the imported blocks are optimistic.
+	engine := &mockExecution.EngineClient{
+		ErrNewPayload:        execution.ErrAcceptedSyncingPayloadStatus,
+		ErrForkchoiceUpdated: execution.ErrAcceptedSyncingPayloadStatus,
+	}
+	chain, err := blockchain.NewService(ctx,
+		blockchain.WithDatabase(db),
+		blockchain.WithForkChoiceStore(fc),
+		blockchain.WithStateGen(sg),
+		blockchain.WithStateNotifier(n),
+		blockchain.WithAttestationService(attService),
+		blockchain.WithAttestationPool(attPool),
+		blockchain.WithExitPool(exitPool),
+		blockchain.WithSlashingPool(slashingPool),
+		blockchain.WithBLSToExecPool(blsToExecPool),
+		blockchain.WithDepositCache(depositCache),
+		blockchain.WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+		blockchain.WithExecutionEngineCaller(engine),
+		blockchain.WithP2PBroadcaster(fakeP2P),
+		blockchain.WithFinalizedStateAtStartUp(finalizedState),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not create blockchain service")
+	}
+	if err := chain.StartFromSavedState(finalizedState); err != nil {
+		return nil, nil, errors.Wrap(err, "could not start blockchain service")
+	}
+
+	validator := regularsync.NewService(ctx,
+		regularsync.WithP2P(fakeP2P),
+		regularsync.WithDatabase(db),
+		regularsync.WithChainService(chain),
+		regularsync.WithInitialSync(&syncChecker{}),
+		regularsync.WithStateNotifier(n),
+		regularsync.WithBlockNotifier(n),
+		regularsync.WithOperationNotifier(n),
+		regularsync.WithAttestationNotifier(n),
+		regularsync.WithAttestationPool(attPool),
+		regularsync.WithExitPool(exitPool),
+		regularsync.WithSlashingPool(slashingPool),
+		regularsync.WithSyncCommsPool(synccommittee.NewPool()),
+		regularsync.WithBlsToExecPool(blsToExecPool),
+		regularsync.WithStateGen(sg),
+		regularsync.WithClock(clock.now),
+	)
+	if validator == nil {
+		return nil, nil, errors.New("could not create sync service")
+	}
+	return chain, validator, nil
+}
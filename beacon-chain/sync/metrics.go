@@ -121,6 +121,25 @@ var (
 			Help: "Time to verify gossiped blocks",
 		},
 	)
+
+	// Backfill of the history missing since checkpoint sync.
+	backfillSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfill_slot",
+		Help: "The slot of the lowest block backfilled below the checkpoint sync origin.",
+	})
+	backfillBlocksCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_blocks_total",
+		Help: "The number of blocks backfilled below the checkpoint sync origin.",
+	})
+	backfillBatchDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "backfill_batch_duration_milliseconds",
+		Help:    "The time it takes to download, verify and save a batch of backfilled blocks.",
+		Buckets: []float64{100, 250, 500, 1000, 2500, 5000, 10000, 30000},
+	})
+	backfillFailuresCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_batch_failures_total",
+		Help: "The number of batches of backfilled blocks which could not be downloaded, verified or saved.",
+	})
 )
 
 func (s *Service) updateMetrics() {
@@ -1,6 +1,8 @@
 package sync
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v3/async/event"
 	blockfeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
@@ -14,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync/backfill"
 )
 
 type Option func(s *Service) error
@@ -136,3 +139,31 @@ func WithExecutionPayloadReconstructor(r execution.ExecutionPayloadReconstructor
 		return nil
 	}
 }
+
+// WithClock sets the source of the current time used by the gossip validators. It defaults to the
+// local clock, and is meant for replaying recorded gossip messages at the time they were received.
+func WithClock(now func() time.Time) Option {
+	return func(s *Service) error {
+		s.cfg.clock = now
+		return nil
+	}
+}
+
+// WithBackfillStatus enables the backfill of the blocks missing below the checkpoint sync origin,
+// whose progress is tracked by the given status.
+func WithBackfillStatus(bfs *backfill.Status) Option {
+	return func(s *Service) error {
+		s.cfg.backfillStatus = bfs
+		return nil
+	}
+}
+
+// WithBackfillRateLimit sets the number of blocks requested in each backfill batch,
+// and the number of blocks which can be backfilled per second.
+func WithBackfillRateLimit(batchSize, blocksPerSecond uint64) Option {
+	return func(s *Service) error {
+		s.cfg.backfillBatchSize = batchSize
+		s.cfg.backfillBlocksPerSecond = blocksPerSecond
+		return nil
+	}
+}
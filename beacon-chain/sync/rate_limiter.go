@@ -67,6 +67,12 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV1)] = blockCollector
 	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV2)] = blockCollectorV2
 
+	// Light client requests
+	topicMap[addEncoding(p2p.RPCLightClientBootstrapTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	topicMap[addEncoding(p2p.RPCLightClientUpdatesByRangeTopicV1)] = leakybucket.NewCollector(maxRequestLightClientUpdates, maxRequestLightClientUpdates, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	topicMap[addEncoding(p2p.RPCLightClientFinalityUpdateTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	topicMap[addEncoding(p2p.RPCLightClientOptimisticUpdateTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+
 	// General topic for all rpc requests.
 	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, leakyBucketPeriod, false /* deleteEmptyBuckets */)
 
@@ -91,6 +97,10 @@ func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 	if err != nil {
 		return err
 	}
+	// Trusted peers are not rate limited.
+	if l.p2p.Peers().IsTrustedPeer(stream.Conn().RemotePeer()) {
+		return nil
+	}
 	key := stream.Conn().RemotePeer().String()
 	remaining := collector.Remaining(key)
 	// Treat each request as a minimum of 1.
@@ -116,6 +126,9 @@ func (l *limiter) validateRawRpcRequest(stream network.Stream) error {
 	if err != nil {
 		return err
 	}
+	if l.p2p.Peers().IsTrustedPeer(stream.Conn().RemotePeer()) {
+		return nil
+	}
 	key := stream.Conn().RemotePeer().String()
 	remaining := collector.Remaining(key)
 	// Treat each request as a minimum of 1.
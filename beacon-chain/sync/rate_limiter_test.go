@@ -18,7 +18,7 @@ import (
 
 func TestNewRateLimiter(t *testing.T) {
 	rlimiter := newRateLimiter(mockp2p.NewTestP2P(t))
-	assert.Equal(t, len(rlimiter.limiterMap), 10, "correct number of topics not registered")
+	assert.Equal(t, len(rlimiter.limiterMap), 14, "correct number of topics not registered")
 }
 
 func TestNewRateLimiter_FreeCorrectly(t *testing.T) {
@@ -106,6 +106,31 @@ func TestRateLimiter_ExceedRawCapacity(t *testing.T) {
 	}
 }
 
+func TestRateLimiter_TrustedPeerExempt(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Peers().Add(nil, p2.PeerID(), p2.BHost.Addrs()[0], network.DirOutbound)
+	p1.Peers().AddTrustedPeer(p2.PeerID())
+
+	rlimiter := newRateLimiter(p1)
+
+	// BlockByRange
+	topic := p2p.RPCBlocksByRangeTopicV1 + p1.Encoding().ProtocolSuffix()
+	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	require.NoError(t, err, "could not create stream")
+
+	for i := 0; i < 4*defaultBurstLimit; i++ {
+		require.NoError(t, rlimiter.validateRawRpcRequest(stream))
+		rlimiter.addRawStream(stream)
+	}
+	require.NoError(t, rlimiter.validateRequest(stream, 64))
+	rlimiter.add(stream, 64)
+	require.NoError(t, rlimiter.validateRequest(stream, 1000))
+	assert.Equal(t, false, p1.Peers().IsBad(p2.PeerID()), "trusted peer is marked as a bad peer")
+	require.NoError(t, stream.Close(), "could not close stream")
+}
+
 func Test_limiter_retrieveCollector_requiresLock(t *testing.T) {
 	l := limiter{}
 	_, err := l.retrieveCollector("")
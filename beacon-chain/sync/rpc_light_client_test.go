@@ -0,0 +1,256 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	db "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v3/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v3/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+func testLightClientUpdate(attestedSlot types.Slot) *ethpb.LightClientUpdate {
+	header := util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: attestedSlot})
+	pubKeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubKeys {
+		pubKeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	branch := func(depth int) [][]byte {
+		b := make([][]byte, depth)
+		for i := range b {
+			b[i] = make([]byte, fieldparams.RootLength)
+		}
+		return b
+	}
+	return &ethpb.LightClientUpdate{
+		AttestedHeader: header,
+		NextSyncCommittee: &ethpb.SyncCommittee{
+			Pubkeys:         pubKeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: branch(5),
+		FinalizedHeader:         util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{}),
+		FinalityBranch:          branch(6),
+		SyncAggregate: &ethpb.SyncAggregate{
+			SyncCommitteeBits:      bitfield.NewBitvector512(),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		SignatureSlot: attestedSlot + 1,
+	}
+}
+
+func expectLightClientContext(t *testing.T, stream network.Stream, slot types.Slot) {
+	want, err := forks.ForkDigestFromEpoch(slots.ToEpoch(slot), make([]byte, fieldparams.RootLength))
+	require.NoError(t, err)
+	digest := make([]byte, 4)
+	_, err = stream.Read(digest)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want[:], digest)
+}
+
+func TestLightClientBootstrapRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+	ctx := context.Background()
+
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	stRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk := util.NewBeaconBlockAltair()
+	blk.Block.StateRoot = stRoot[:]
+	util.SaveBlock(t, ctx, d, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveState(ctx, st, root))
+
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, stateGen: stategen.New(d, doublylinkedtree.New()), chain: &mock.ChainService{}}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCLightClientBootstrapTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10, 10, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		expectLightClientContext(t, stream, 0)
+		res := &ethpb.LightClientBootstrap{}
+		require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+		headerRoot, err := res.Header.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root, headerRoot)
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := p2ptypes.LightClientBootstrapReq(root)
+	require.NoError(t, r.lightClientBootstrapRPCHandler(ctx, &req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err = p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req = p2ptypes.LightClientBootstrapReq{'a'}
+	assert.NotNil(t, r.lightClientBootstrapRPCHandler(ctx, &req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+	ctx := context.Background()
+
+	periodSlots := types.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * uint64(params.BeaconConfig().SlotsPerEpoch))
+	for _, period := range []uint64{1, 2, 4} {
+		require.NoError(t, d.SaveLightClientUpdate(ctx, period, testLightClientUpdate(types.Slot(period)*periodSlots)))
+	}
+
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, chain: &mock.ChainService{}}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(100, 100, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for _, period := range []uint64{2, 4} {
+			expectSuccess(t, stream)
+			expectLightClientContext(t, stream, types.Slot(period)*periodSlots)
+			res := &ethpb.LightClientUpdate{}
+			require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+			assert.Equal(t, types.Slot(period)*periodSlots, res.AttestedHeader.Slot)
+		}
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientUpdatesByRangeRPCHandler(ctx, &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: 2, Count: 5}, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeInvalidRequest, p2ptypes.ErrInvalidRequest.Error(), stream)
+	})
+	stream, err = p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	err = r.lightClientUpdatesByRangeRPCHandler(ctx, &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: 2, Count: 0}, stream)
+	assert.ErrorContains(t, p2ptypes.ErrInvalidRequest.Error(), err)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientFinalityUpdateRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	ctx := context.Background()
+
+	chain := &mock.ChainService{}
+	r := &Service{cfg: &config{p2p: p1, chain: chain}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCLightClientFinalityUpdateTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10, 10, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	assert.ErrorContains(t, p2ptypes.ErrResourceUnavailable.Error(), r.lightClientFinalityUpdateRPCHandler(ctx, nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	update := testLightClientUpdate(10)
+	chain.FinalityUpdate = &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		expectLightClientContext(t, stream, 10)
+		res := &ethpb.LightClientFinalityUpdate{}
+		require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+		assert.DeepEqual(t, chain.FinalityUpdate, res)
+	})
+	stream, err = p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientFinalityUpdateRPCHandler(ctx, nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientOptimisticUpdateRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	ctx := context.Background()
+
+	update := testLightClientUpdate(10)
+	chain := &mock.ChainService{OptimisticUpdate: &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}}
+	r := &Service{cfg: &config{p2p: p1, chain: chain}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCLightClientOptimisticUpdateTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10, 10, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		expectLightClientContext(t, stream, 10)
+		res := &ethpb.LightClientOptimisticUpdate{}
+		require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+		assert.DeepEqual(t, chain.OptimisticUpdate, res)
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientOptimisticUpdateRPCHandler(ctx, nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
@@ -0,0 +1,27 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientFinalityUpdateSubscriber has nothing to process, as only the updates matching the ones
+// computed locally are accepted.
+func (s *Service) lightClientFinalityUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, ok := msg.(*ethpb.LightClientFinalityUpdate); !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &ethpb.LightClientFinalityUpdate{}, msg)
+	}
+	return nil
+}
+
+// lightClientOptimisticUpdateSubscriber has nothing to process, as only the updates matching the ones
+// computed locally are accepted.
+func (s *Service) lightClientOptimisticUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, ok := msg.(*ethpb.LightClientOptimisticUpdate); !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &ethpb.LightClientOptimisticUpdate{}, msg)
+	}
+	return nil
+}
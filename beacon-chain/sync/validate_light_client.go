@@ -0,0 +1,96 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate validates a light client finality update received over gossip.
+// Light client updates can't be verified without a light client store, so the update is only
+// forwarded when it matches the one computed locally.
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if !s.isLightClientUpdateTimely(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	local := s.cfg.chain.LightClientFinalityUpdate()
+	if local == nil || !proto.Equal(local, update) {
+		return pubsub.ValidationIgnore, nil
+	}
+	msg.ValidatorData = update
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate validates a light client optimistic update received over gossip.
+// Light client updates can't be verified without a light client store, so the update is only
+// forwarded when it matches the one computed locally.
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if !s.isLightClientUpdateTimely(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	local := s.cfg.chain.LightClientOptimisticUpdate()
+	if local == nil || !proto.Equal(local, update) {
+		return pubsub.ValidationIgnore, nil
+	}
+	msg.ValidatorData = update
+	return pubsub.ValidationAccept, nil
+}
+
+// isLightClientUpdateTimely returns true if at least one third of the signature slot has passed,
+// which is when the sync committee signatures of the update are expected to have been propagated.
+func (s *Service) isLightClientUpdateTimely(signatureSlot types.Slot) bool {
+	slotStart := slots.StartTime(uint64(s.cfg.chain.GenesisTime().Unix()), signatureSlot)
+	dueTime := slotStart.Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / time.Duration(params.BeaconConfig().IntervalsPerSlot))
+	return !s.now().Add(params.BeaconNetworkConfig().MaximumGossipClockDisparity).Before(dueTime)
+}
@@ -70,10 +70,11 @@ func (s *Service) validateSyncCommitteeMessage(
 
 	// Validate sync message times before proceeding.
 	// The message's `slot` is for the current slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
-	if err := altair.ValidateSyncMessageTime(
+	if err := altair.ValidateSyncMessageTimeAt(
 		m.Slot,
 		s.cfg.chain.GenesisTime(),
 		params.BeaconNetworkConfig().MaximumGossipClockDisparity,
+		s.now(),
 	); err != nil {
 		tracing.AnnotateError(span, err)
 		return pubsub.ValidationIgnore, err
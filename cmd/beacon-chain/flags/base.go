@@ -10,10 +10,11 @@ import (
 )
 
 var (
-	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
+	// MevRelayEndpoint provides HTTP access endpoints to a MEV builder network.
 	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
+		Name: "http-mev-relay",
+		Usage: "A comma separated list of MEV builder relay http endpoints, these will be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"Headers are requested from all relays in parallel and the highest bid is used",
 		Value: "",
 	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
@@ -26,6 +27,17 @@ var (
 		Usage: "Number of total skip slot to fallback from using relay/builder to local execution engine for block construction in last epoch rolling window",
 		Value: 8,
 	}
+	LocalBlockValueBoost = &cli.Uint64Flag{
+		Name: "local-block-value-boost",
+		Usage: "A percentage boost applied to the value of the locally built execution payload when comparing it " +
+			"against a builder bid. The builder block is only proposed if its bid exceeds the boosted local value",
+		Value: 0,
+	}
+	MinBuilderBid = &cli.Uint64Flag{
+		Name:  "min-builder-bid",
+		Usage: "The minimum value, in gwei, a builder bid needs for the builder block to be proposed instead of the local one",
+		Value: 0,
+	}
 	// ExecutionEngineEndpoint provides an HTTP access endpoint to connect to an execution client on the execution layer
 	ExecutionEngineEndpoint = &cli.StringFlag{
 		Name:  "execution-endpoint",
@@ -155,6 +167,46 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB.",
 		Value: 2048,
 	}
+	// StateDiffExponents specifies the power of two slot intervals of the historical state diff layers,
+	// used when historical state diffs are enabled.
+	StateDiffExponents = &cli.IntSliceFlag{
+		Name: "state-diff-exponents",
+		Usage: "Comma separated list of exponents e, in ascending order, such that a historical state is archived every 2^e slots. " +
+			"The largest exponent is stored as a full snapshot and each smaller one as a diff against the next larger one. " +
+			"Only used with --enable-historical-state-diffs.",
+		Value: cli.NewIntSlice(5, 7, 10, 13, 16),
+	}
+	// HistoryRetentionEpochs specifies the number of finalized epochs of blocks and states to keep in the database.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name: "history-retention-epochs",
+		Usage: "Enables pruning of the blocks, states and their indices which are older than the given number of epochs " +
+			"before the finalized checkpoint. The retention window is never shorter than the weak subjectivity period. " +
+			"Disabled when 0.",
+	}
+	// EnableBackfill enables the download of the blocks missing between genesis and the checkpoint sync origin.
+	EnableBackfill = &cli.BoolFlag{
+		Name: "enable-backfill",
+		Usage: "Downloads the blocks missing between genesis and the checkpoint sync origin, backwards from the origin, " +
+			"so that the node can serve them to its peers. Cannot be used with --history-retention-epochs.",
+	}
+	// BackfillBatchSize specifies the number of blocks requested in each backfill batch.
+	BackfillBatchSize = &cli.Uint64Flag{
+		Name:  "backfill-batch-size",
+		Usage: "The number of blocks requested from a peer in each backfill batch. Only used with --enable-backfill.",
+		Value: 64,
+	}
+	// BackfillBlocksPerSecond specifies the maximum rate at which blocks are backfilled.
+	BackfillBlocksPerSecond = &cli.Uint64Flag{
+		Name:  "backfill-blocks-per-second",
+		Usage: "The maximum number of blocks backfilled per second. Only used with --enable-backfill.",
+		Value: 32,
+	}
+	// AttestationPoolPersistInterval specifies how often the attestation pool is persisted to the database.
+	AttestationPoolPersistInterval = &cli.DurationFlag{
+		Name: "attestation-pool-persist-interval",
+		Usage: "Persists the attestation pool to the database at the given interval and on shutdown, so that the " +
+			"attestations which have not expired are restored on restart. Disabled when 0.",
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -182,6 +234,40 @@ var (
 		Name:  "historical-slasher-node",
 		Usage: "Enables required flags for serving historical data to a slasher client. Results in additional storage usage",
 	}
+	// SlasherBackfill enables feeding the history stored in the beacon database through slashing detection.
+	SlasherBackfill = &cli.BoolFlag{
+		Name: "slasher-backfill",
+		Usage: "Feeds the blocks stored in the beacon database up to the head, and the attestations they include, through " +
+			"slashing detection in the background, alongside the ones received on gossip. An interrupted backfill " +
+			"resumes where it stopped. Only used with --slasher.",
+	}
+	// SlasherEvidenceFile specifies the path of the file the offences found by the slasher backfill are appended to.
+	SlasherEvidenceFile = &cli.StringFlag{
+		Name: "slasher-evidence-file",
+		Usage: "Path of the file the slashable offences found by the slasher backfill are appended to, one JSON " +
+			"object per line along with the SSZ encoded slashing. Defaults to slasher-evidence.jsonl in the data directory.",
+	}
+	// SlasherChunkSize specifies the number of epochs of a validator span stored in a slasher chunk.
+	SlasherChunkSize = &cli.Uint64Flag{
+		Name: "slasher-chunk-size",
+		Usage: "Number of epochs of the min and max spans of a validator stored in a slasher chunk. " +
+			"Changing it requires migrating the slasher database with `prysmctl db migrate-slasher-spans`.",
+		Value: 16,
+	}
+	// SlasherValidatorChunkSize specifies the number of validators whose spans are stored in a slasher chunk.
+	SlasherValidatorChunkSize = &cli.Uint64Flag{
+		Name: "slasher-validator-chunk-size",
+		Usage: "Number of validators whose min and max spans are stored together in a slasher chunk. " +
+			"Changing it requires migrating the slasher database with `prysmctl db migrate-slasher-spans`.",
+		Value: 256,
+	}
+	// SlasherHistoryLength specifies the number of epochs of history kept for slashing detection.
+	SlasherHistoryLength = &cli.Uint64Flag{
+		Name: "slasher-history-length",
+		Usage: "Number of epochs of attestation history kept for slashing detection, a multiple of the chunk size. " +
+			"Changing it requires migrating the slasher database with `prysmctl db migrate-slasher-spans`.",
+		Value: 4096,
+	}
 	// ChainID defines a flag to set the chain id. If none is set, it derives this value from NetworkConfig
 	ChainID = &cli.Uint64Flag{
 		Name:  "chain-id",
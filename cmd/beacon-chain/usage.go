@@ -54,6 +54,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RelayNode,
 			cmd.P2PUDPPort,
 			cmd.P2PTCPPort,
+			cmd.P2PQUICPort,
 			cmd.DataDirFlag,
 			cmd.VerbosityFlag,
 			cmd.EnableTracingFlag,
@@ -112,11 +113,22 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
 			flags.SlotsPerArchivedPoint,
+			flags.StateDiffExponents,
+			flags.HistoryRetentionEpochs,
+			flags.EnableBackfill,
+			flags.BackfillBatchSize,
+			flags.BackfillBlocksPerSecond,
+			flags.AttestationPoolPersistInterval,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
+			flags.SlasherBackfill,
+			flags.SlasherEvidenceFile,
+			flags.SlasherChunkSize,
+			flags.SlasherValidatorChunkSize,
+			flags.SlasherHistoryLength,
 			flags.ChainID,
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpoint,
@@ -125,6 +137,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MevRelayEndpoint,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
+			flags.LocalBlockValueBoost,
+			flags.MinBuilderBid,
 			flags.EngineEndpointTimeoutSeconds,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
@@ -153,8 +167,13 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
+			cmd.P2PGossipTraceDir,
+			cmd.P2PGossipTraceMaxFileSize,
+			cmd.P2PGossipTraceMaxFiles,
 			cmd.StaticPeers,
+			cmd.TrustedPeers,
 			cmd.EnableUPnPFlag,
+			cmd.EnableQUICFlag,
 			flags.MinSyncPeers,
 		},
 	},
@@ -94,6 +94,13 @@ var (
 		Name:  "peer",
 		Usage: "Connect with this peer. This flag may be used multiple times.",
 	}
+	// TrustedPeers specifies a set of peers which are always kept connected.
+	TrustedPeers = &cli.StringSliceFlag{
+		Name: "trusted-peer",
+		Usage: "Connect with this peer and keep it connected: trusted peers are redialed when disconnected, " +
+			"never pruned nor rate limited, and do not count against the peer limits. " +
+			"This flag may be used multiple times.",
+	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
 		Name:  "bootstrap-node",
@@ -119,6 +126,12 @@ var (
 		Usage: "The port used by libp2p.",
 		Value: 13000,
 	}
+	// P2PQUICPort defines the UDP port to be used by the QUIC transport of libp2p.
+	P2PQUICPort = &cli.IntFlag{
+		Name:  "p2p-quic-port",
+		Usage: "The UDP port used by the QUIC transport of libp2p, when enabled with --enable-quic.",
+		Value: 13000,
+	}
 	// P2PIP defines the local IP to be used by libp2p.
 	P2PIP = &cli.StringFlag{
 		Name:  "p2p-local-ip",
@@ -171,6 +184,24 @@ var (
 			"192.168.0.0/16 would deny connections from peers on your local network only. The " +
 			"default is to accept all connections.",
 	}
+	// P2PGossipTraceDir defines the directory where the gossip messages received are traced.
+	P2PGossipTraceDir = &cli.StringFlag{
+		Name: "p2p-gossip-trace-dir",
+		Usage: "Write every gossip message received, with its arrival time, topic, sender, validation result " +
+			"and message ID, to rotating trace files in this directory. Tracing is disabled when empty.",
+	}
+	// P2PGossipTraceMaxFileSize defines the size of a gossip trace file before a new one is started.
+	P2PGossipTraceMaxFileSize = &cli.Uint64Flag{
+		Name:  "p2p-gossip-trace-max-file-size",
+		Usage: "The size in megabytes of a gossip trace file before a new one is started.",
+		Value: 256,
+	}
+	// P2PGossipTraceMaxFiles defines the number of gossip trace files kept.
+	P2PGossipTraceMaxFiles = &cli.Uint64Flag{
+		Name:  "p2p-gossip-trace-max-files",
+		Usage: "The number of gossip trace files kept, the oldest ones being deleted.",
+		Value: 8,
+	}
 	// ForceClearDB removes any previously stored data at the data directory.
 	ForceClearDB = &cli.BoolFlag{
 		Name:  "force-clear-db",
@@ -203,6 +234,11 @@ var (
 		Name:  "enable-upnp",
 		Usage: "Enable the service (Beacon chain or Validator) to use UPnP when possible.",
 	}
+	// EnableQUICFlag specifies if the QUIC transport of libp2p should be enabled or not. The default value is false.
+	EnableQUICFlag = &cli.BoolFlag{
+		Name:  "enable-quic",
+		Usage: "Enable the QUIC transport of libp2p, in addition to TCP, and advertise it in the ENR.",
+	}
 	// ConfigFileFlag specifies the filepath to load flag values.
 	ConfigFileFlag = &cli.StringFlag{
 		Name:  "config-file",
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var migrateSlasherSpansFlags = struct {
+	Path               string
+	ChunkSize          uint64
+	ValidatorChunkSize uint64
+	HistoryLength      uint64
+}{}
+
+var migrateSlasherSpansCmd = &cli.Command{
+	Name:  "migrate-slasher-spans",
+	Usage: "re-chunk the min and max spans of a slasher db to new slasher parameters, while the beacon node is stopped",
+	Action: func(cliCtx *cli.Context) error {
+		if err := migrateSlasherSpansAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not migrate slasher spans")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing slasher.db",
+			Destination: &migrateSlasherSpansFlags.Path,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "chunk-size",
+			Usage:       "new number of epochs of a validator span stored in a chunk",
+			Destination: &migrateSlasherSpansFlags.ChunkSize,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "validator-chunk-size",
+			Usage:       "new number of validators whose spans are stored in a chunk",
+			Destination: &migrateSlasherSpansFlags.ValidatorChunkSize,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "history-length",
+			Usage:       "new number of epochs of history kept, a multiple of the chunk size",
+			Destination: &migrateSlasherSpansFlags.HistoryLength,
+			Required:    true,
+		},
+	},
+}
+
+func migrateSlasherSpansAction(cliCtx *cli.Context) error {
+	flags := migrateSlasherSpansFlags
+	p, err := slasher.NewParams(flags.ChunkSize, flags.ValidatorChunkSize, types.Epoch(flags.HistoryLength))
+	if err != nil {
+		return err
+	}
+	if !file.FileExists(filepath.Join(flags.Path, slasherkv.DatabaseFileName)) {
+		return errors.Errorf("no %s in %s", slasherkv.DatabaseFileName, flags.Path)
+	}
+	d, err := slasherkv.NewKVStore(cliCtx.Context, flags.Path)
+	if err != nil {
+		return err
+	}
+	if err := slasher.MigrateSpans(cliCtx.Context, d, p); err != nil {
+		if closeErr := d.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close slasher db")
+		}
+		return err
+	}
+	log.Info("Migrated slasher spans")
+	return d.Close()
+}
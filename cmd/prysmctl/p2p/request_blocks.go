@@ -44,13 +44,13 @@ var requestBlocksCmd = &cli.Command{
 		cmd.ChainConfigFileFlag,
 		&cli.StringFlag{
 			Name:        "peer-multiaddrs",
-			Usage:       "comma-separated, peer multiaddr(s) to connect to for p2p requests",
+			Usage:       "comma-separated, peer multiaddr(s) or ENR(s) to connect to for p2p requests, over TCP or QUIC (/quic-v1)",
 			Destination: &requestBlocksFlags.Peers,
 			Value:       "",
 		},
 		&cli.UintFlag{
 			Name:        "client-port",
-			Usage:       "port to use for the client as a libp2p host",
+			Usage:       "port to use for the client as a libp2p host, over TCP and QUIC",
 			Destination: &requestBlocksFlags.ClientPort,
 			Value:       13001,
 		},
@@ -0,0 +1,13 @@
+package threshold
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "threshold",
+		Usage: "commands dealing with threshold signing, where validator keys are split among several remote signers",
+		Subcommands: []*cli.Command{
+			splitCmd,
+		},
+	},
+}
@@ -0,0 +1,249 @@
+package threshold
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/io/prompt"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const configFileName = "threshold-config.json"
+
+var splitFlags = struct {
+	Keystores          string
+	PasswordFile       string
+	SharesPasswordFile string
+	Threshold          uint64
+	Signers            uint64
+	OutputDir          string
+}{}
+
+var splitCmd = &cli.Command{
+	Name:  "split",
+	Usage: "Split EIP-2335 keystores into key shares for threshold signing, writing a keystore per signer and the threshold keymanager configuration.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionSplit(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not split keystores")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "keystores",
+			Usage:       "path to an EIP-2335 keystore file, or to a directory of keystore files, to split",
+			Destination: &splitFlags.Keystores,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "keystores-password-file",
+			Usage:       "path to a file containing the password of the keystores. The password is prompted for if not set",
+			Destination: &splitFlags.PasswordFile,
+		},
+		&cli.StringFlag{
+			Name:        "shares-password-file",
+			Usage:       "path to a file containing the password to encrypt the share keystores with. The keystores password is used if not set",
+			Destination: &splitFlags.SharesPasswordFile,
+		},
+		&cli.Uint64Flag{
+			Name:        "threshold",
+			Usage:       "number of signers needed to sign for a validator",
+			Destination: &splitFlags.Threshold,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "signers",
+			Usage:       "number of signers to split each key among",
+			Destination: &splitFlags.Signers,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "output-dir",
+			Usage:       "directory to write the share keystores of each signer and the threshold keymanager configuration to",
+			Destination: &splitFlags.OutputDir,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionSplit(_ *cli.Context) error {
+	f := splitFlags
+
+	keystores, err := readKeystores(f.Keystores)
+	if err != nil {
+		return err
+	}
+	password, err := readPassword(f.PasswordFile, "Enter the password of the keystores")
+	if err != nil {
+		return err
+	}
+	sharesPassword := password
+	if f.SharesPasswordFile != "" {
+		sharesPassword, err = readPassword(f.SharesPasswordFile, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	shares, opts, err := splitKeystores(keystores, password, sharesPassword, f.Threshold, f.Signers)
+	if err != nil {
+		return err
+	}
+
+	if file.FileExists(filepath.Join(f.OutputDir, configFileName)) {
+		return fmt.Errorf("%s already contains split keystores", f.OutputDir)
+	}
+	for i, signerShares := range shares {
+		dir := filepath.Join(f.OutputDir, fmt.Sprintf("signer-%d", i+1))
+		if err := file.MkdirAll(dir); err != nil {
+			return errors.Wrapf(err, "could not create %s", dir)
+		}
+		for j, share := range signerShares {
+			encoded, err := json.MarshalIndent(share, "", "\t")
+			if err != nil {
+				return errors.Wrap(err, "could not marshal share keystore")
+			}
+			if err := file.WriteFile(filepath.Join(dir, fmt.Sprintf("keystore-%d.json", j)), encoded); err != nil {
+				return errors.Wrap(err, "could not write share keystore")
+			}
+		}
+	}
+	encoded, err := json.MarshalIndent(opts, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal threshold keymanager config")
+	}
+	configPath := filepath.Join(f.OutputDir, configFileName)
+	if err := file.WriteFile(configPath, encoded); err != nil {
+		return errors.Wrap(err, "could not write threshold keymanager config")
+	}
+
+	log.WithFields(log.Fields{
+		"validators": len(keystores),
+		"threshold":  f.Threshold,
+		"signers":    f.Signers,
+	}).Info("Split keystores")
+	fmt.Printf("\nImport the keystores of each signer-<index> directory of %s into the remote signer with that index. "+
+		"Then set the remote address and certificates of each signer in %s, and create the threshold wallet with:\n", f.OutputDir, configPath)
+	fmt.Printf("validator wallet create --keymanager-kind=threshold --threshold-config-file=%s\n\n", configPath)
+	return nil
+}
+
+// splitKeystores decrypts the keystores and splits their keys into n shares each, any threshold of which can
+// sign for the keys. The share keystores are returned by signer, along with the threshold keymanager options
+// listing the validators and their shares. The addresses of the signers are left for the user to set.
+func splitKeystores(
+	keystores []*keymanager.Keystore, password, sharesPassword string, t, n uint64,
+) ([][]*keymanager.Keystore, *threshold.KeymanagerOpts, error) {
+	opts := &threshold.KeymanagerOpts{
+		Threshold:  t,
+		Signers:    make([]*threshold.SignerConfig, n),
+		Validators: make([]*threshold.ValidatorConfig, len(keystores)),
+	}
+	for i := range opts.Signers {
+		opts.Signers[i] = &threshold.SignerConfig{
+			Index:             uint64(i + 1),
+			RemoteCertificate: &remote.CertificateConfig{RequireTls: true},
+		}
+	}
+	encryptor := keystorev4.New()
+	shares := make([][]*keymanager.Keystore, n)
+	for i, keystore := range keystores {
+		secretKeyBytes, err := encryptor.Decrypt(keystore.Crypto, password)
+		if err != nil {
+			if strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+				return nil, nil, fmt.Errorf("incorrect password for keystore 0x%s", keystore.Pubkey)
+			}
+			return nil, nil, errors.Wrap(err, "could not decrypt keystore")
+		}
+		secretKey, err := bls.SecretKeyFromBytes(secretKeyBytes)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not parse secret key")
+		}
+		secretKeyShares, err := bls.SplitSecretKey(secretKey, t, n)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not split key %#x", secretKey.PublicKey().Marshal())
+		}
+		v := &threshold.ValidatorConfig{
+			PublicKey:       hexutil.Encode(secretKey.PublicKey().Marshal()),
+			SharePublicKeys: make(map[uint64]string, n),
+		}
+		for j, share := range secretKeyShares {
+			sharePubKey := share.PublicKey().Marshal()
+			cryptoFields, err := encryptor.Encrypt(share.Marshal(), sharesPassword)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "could not encrypt share %#x", sharePubKey)
+			}
+			id, err := uuid.NewRandom()
+			if err != nil {
+				return nil, nil, err
+			}
+			shares[j] = append(shares[j], &keymanager.Keystore{
+				Crypto:  cryptoFields,
+				ID:      id.String(),
+				Pubkey:  fmt.Sprintf("%x", sharePubKey),
+				Version: encryptor.Version(),
+				Name:    encryptor.Name(),
+				Path:    keystore.Path,
+			})
+			v.SharePublicKeys[uint64(j+1)] = hexutil.Encode(sharePubKey)
+		}
+		opts.Validators[i] = v
+	}
+	return shares, opts, nil
+}
+
+// readKeystores reads the keystore at the path, or all the keystores of the directory at the path.
+func readKeystores(path string) ([]*keymanager.Keystore, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", path)
+	}
+	paths := []string{path}
+	if info.IsDir() {
+		paths, err = filepath.Glob(filepath.Join(path, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("no keystore found in %s", path)
+		}
+	}
+	keystores := make([]*keymanager.Keystore, len(paths))
+	for i, p := range paths {
+		enc, err := file.ReadFileAsBytes(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read keystore %s", p)
+		}
+		keystore := &keymanager.Keystore{}
+		if err := json.Unmarshal(enc, keystore); err != nil {
+			return nil, errors.Wrapf(err, "could not decode keystore %s", p)
+		}
+		keystores[i] = keystore
+	}
+	return keystores, nil
+}
+
+// readPassword reads the password in the file at the path, or prompts for it if the path is empty.
+func readPassword(path, promptText string) (string, error) {
+	if path == "" {
+		return prompt.PasswordPrompt(promptText, prompt.NotEmpty)
+	}
+	data, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read password file")
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
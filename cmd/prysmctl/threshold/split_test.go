@@ -0,0 +1,71 @@
+package threshold
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func createKeystore(t *testing.T, secretKey bls.SecretKey, password string) *keymanager.Keystore {
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(secretKey.Marshal(), password)
+	require.NoError(t, err)
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	return &keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Pubkey:  fmt.Sprintf("%x", secretKey.PublicKey().Marshal()),
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+		Path:    "m/12381/3600/0/0/0",
+	}
+}
+
+func TestSplitKeystores(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	keystore := createKeystore(t, secretKey, "password")
+
+	_, _, err = splitKeystores([]*keymanager.Keystore{keystore}, "wrong", "shares", 2, 3)
+	require.ErrorContains(t, "incorrect password", err)
+
+	shares, opts, err := splitKeystores([]*keymanager.Keystore{keystore}, "password", "shares", 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(shares))
+	assert.Equal(t, uint64(2), opts.Threshold)
+	require.Equal(t, 3, len(opts.Signers))
+	require.Equal(t, 1, len(opts.Validators))
+	assert.Equal(t, fmt.Sprintf("%#x", secretKey.PublicKey().Marshal()), opts.Validators[0].PublicKey)
+
+	msg := []byte("hello")
+	encryptor := keystorev4.New()
+	indices := make([]uint64, 0, 2)
+	sigs := make([]bls.Signature, 0, 2)
+	for i, signerShares := range shares {
+		require.Equal(t, 1, len(signerShares))
+		share := signerShares[0]
+		assert.Equal(t, keystore.Path, share.Path)
+		shareKeyBytes, err := encryptor.Decrypt(share.Crypto, "shares")
+		require.NoError(t, err)
+		shareKey, err := bls.SecretKeyFromBytes(shareKeyBytes)
+		require.NoError(t, err)
+		index := uint64(i + 1)
+		assert.Equal(t, index, opts.Signers[i].Index)
+		assert.Equal(t, fmt.Sprintf("%#x", shareKey.PublicKey().Marshal()), opts.Validators[0].SharePublicKeys[index])
+		assert.Equal(t, fmt.Sprintf("%x", shareKey.PublicKey().Marshal()), share.Pubkey)
+		if i > 0 {
+			indices = append(indices, index)
+			sigs = append(sigs, shareKey.Sign(msg))
+		}
+	}
+	sig, err := bls.CombineSignatureShares(indices, sigs)
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(secretKey.PublicKey(), msg))
+}
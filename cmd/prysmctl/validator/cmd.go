@@ -0,0 +1,13 @@
+package validator
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "proposer-settings",
+		Usage: "commands dealing with the proposer settings of the validator client",
+		Subcommands: []*cli.Command{
+			diffCmd,
+		},
+	},
+}
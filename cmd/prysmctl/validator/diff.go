@@ -0,0 +1,100 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/validator/node"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var diffFlags = struct {
+	Current    string
+	New        string
+	PublicKeys string
+}{}
+
+var diffCmd = &cli.Command{
+	Name: "diff",
+	Usage: "Validate new proposer settings and print how they differ from the current ones, without applying them. " +
+		"This shows what a validator client reloading its proposer settings would change.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionDiff(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not diff proposer settings")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "current",
+			Usage:       "path or http(s) URL of the proposer settings currently used by the validator client",
+			Destination: &diffFlags.Current,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "new",
+			Usage:       "path or http(s) URL of the new proposer settings",
+			Destination: &diffFlags.New,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name: "public-keys",
+			Usage: "comma-separated list of the public key hex strings of the validators of the validator client. " +
+				"Changes of the default proposer settings are shown for them, and proposer settings for other public keys are reported",
+			Destination: &diffFlags.PublicKeys,
+		},
+		flags.EnableBuilderFlag,
+		flags.BuilderGasLimitFlag,
+	},
+}
+
+func cliActionDiff(cliCtx *cli.Context) error {
+	f := diffFlags
+
+	pubkeys, err := parsePublicKeys(f.PublicKeys)
+	if err != nil {
+		return err
+	}
+	current, err := node.LoadProposerSettings(cliCtx, f.Current)
+	if err != nil {
+		return errors.Wrapf(err, "could not load current proposer settings from %s", f.Current)
+	}
+	updated, err := node.LoadProposerSettings(cliCtx, f.New)
+	if err != nil {
+		return errors.Wrapf(err, "invalid new proposer settings from %s", f.New)
+	}
+
+	if len(pubkeys) > 0 {
+		for _, k := range updated.UnknownPubkeys(pubkeys) {
+			fmt.Printf("unknown validator %#x has proposer settings\n", k)
+		}
+	}
+	fmt.Println(validatorserviceconfig.DiffProposerSettings(current, updated, pubkeys).String())
+	return nil
+}
+
+func parsePublicKeys(s string) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	var pubkeys [][fieldparams.BLSPubkeyLength]byte
+	for _, key := range strings.Split(s, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		decoded, err := hexutil.Decode(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", key)
+		}
+		if len(decoded) != fieldparams.BLSPubkeyLength {
+			return nil, fmt.Errorf("%s is not a bls public key", key)
+		}
+		pubkeys = append(pubkeys, bytesutil.ToBytes48(decoded))
+	}
+	return pubkeys, nil
+}
@@ -0,0 +1,26 @@
+package validator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestParsePublicKeys(t *testing.T) {
+	key := "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+	pubkeys, err := parsePublicKeys(key + ", " + key + ",")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pubkeys))
+	assert.Equal(t, key, fmt.Sprintf("%#x", pubkeys[1]))
+
+	pubkeys, err = parsePublicKeys("")
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pubkeys))
+
+	_, err = parsePublicKeys("0x1234")
+	assert.ErrorContains(t, "is not a bls public key", err)
+	_, err = parsePublicKeys("not-hex")
+	assert.ErrorContains(t, "could not decode public key", err)
+}
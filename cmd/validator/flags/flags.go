@@ -46,6 +46,19 @@ var (
 		Usage: "Beacon node REST API provider endpoint",
 		Value: "http://127.0.0.1:3500",
 	}
+	// EnableBeaconNodeFailoverFlag enables the failover between several beacon nodes.
+	EnableBeaconNodeFailoverFlag = &cli.BoolFlag{
+		Name: "enable-beacon-node-failover",
+		Usage: "Connects separately to each of the comma separated beacon nodes of --beacon-rpc-provider, or of " +
+			"--beacon-rest-api-provider when using the REST API. The duties are requested from the healthiest node, " +
+			"the nodes being preferred in the order given, while attestations and blocks are sent to all healthy nodes.",
+	}
+	// BeaconNodeHealthCheckIntervalFlag defines how often the health of the beacon nodes is checked.
+	BeaconNodeHealthCheckIntervalFlag = &cli.DurationFlag{
+		Name:  "beacon-node-health-check-interval",
+		Usage: "How often the sync status of the beacon nodes is checked when --enable-beacon-node-failover is set.",
+		Value: 4 * time.Second,
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -247,6 +260,19 @@ var (
 		Name:  "slashing-protection-json-file",
 		Usage: "Path to an EIP-3076 compliant JSON file containing a user's slashing protection history",
 	}
+	// SlashingProtectionMergeModeFlag is used to merge the slashing protection JSON into the existing history.
+	SlashingProtectionMergeModeFlag = &cli.StringFlag{
+		Name: "slashing-protection-merge-mode",
+		Usage: "Merges the slashing protection JSON into the existing history of the validator database instead of importing it. " +
+			"The validators whose history conflicts with it are not merged and are added to the import blacklist, which stops " +
+			"them from signing. Either minimal, which only keeps the highest signed slot and epochs of each validator, or " +
+			"complete, which keeps every signed block and attestation (EIP-3076)",
+	}
+	// SlashingProtectionMergeReportFlag is used to enter the file path of the slashing protection merge report.
+	SlashingProtectionMergeReportFlag = &cli.StringFlag{
+		Name:  "slashing-protection-merge-report",
+		Usage: "Path to write a JSON report of the slashing protection merge to, listing conflicts and the history lost to a minimal merge",
+	}
 	// KeysDirFlag defines the path for a directory where keystores to be imported at stored.
 	KeysDirFlag = &cli.StringFlag{
 		Name:  "keys-dir",
@@ -285,6 +311,13 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// ThresholdConfigFileFlag defines the path to the configuration of a threshold wallet, listing
+	// the remote signers and the validator key shares they hold.
+	ThresholdConfigFileFlag = &cli.StringFlag{
+		Name:  "threshold-config-file",
+		Usage: "/path/to/threshold-config.json listing the threshold, the remote signers and the validator key shares, as output by `prysmctl threshold split`",
+		Value: "",
+	}
 	// Web3SignerURLFlag defines the URL for a web3signer to connect to.
 	// example:--validators-external-signer-url=http://localhost:9000
 	// web3signer documentation can be found in Consensys' web3signer project docs
@@ -306,7 +339,7 @@ var (
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either imported, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either imported, derived, remote, or threshold, specified during wallet creation",
 		Value: "",
 	}
 	// SkipDepositConfirmationFlag skips the y/n confirmation userprompt for sending a deposit to the deposit contract.
@@ -328,6 +361,12 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File",
 		Value: "",
 	}
+	// SlashingProtectionExportPublicKeysFlag allows exporting the slashing protection history of some validators only.
+	SlashingProtectionExportPublicKeysFlag = &cli.StringFlag{
+		Name:  "slashing-protection-export-public-keys",
+		Usage: "Comma-separated list of public key hex strings of the validators whose slashing protection history is exported. All validators if empty",
+		Value: "",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
@@ -345,6 +384,18 @@ var (
 		Usage: "Set URL to a REST endpoint containing validator settings used when proposing blocks such as (fee recipient) (i.e. --proposer-settings-url=https://example.com/api/getConfig). File format found in docs",
 		Value: "",
 	}
+	// ProposerSettingsReloadFlag enables reloading the proposer settings when they change.
+	ProposerSettingsReloadFlag = &cli.BoolFlag{
+		Name: "reload-proposer-settings",
+		Usage: "Reloads the proposer settings when the --" + ProposerSettingsFlag.Name + " file changes, or periodically from the --" +
+			ProposerSettingsURLFlag.Name + " URL, and updates the beacon node and builders with the settings of the changed validators.",
+	}
+	// ProposerSettingsURLPollIntervalFlag defines how often the proposer settings are fetched from their URL when reloading them.
+	ProposerSettingsURLPollIntervalFlag = &cli.DurationFlag{
+		Name:  "proposer-settings-url-poll-interval",
+		Usage: "How often the proposer settings are fetched from the --" + ProposerSettingsURLFlag.Name + " URL when --" + ProposerSettingsReloadFlag.Name + " is set.",
+		Value: 5 * time.Minute,
+	}
 
 	// SuggestedFeeRecipientFlag defines the address of the fee recipient.
 	SuggestedFeeRecipientFlag = &cli.StringFlag{
@@ -50,6 +50,8 @@ var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 	flags.BeaconRPCGatewayProviderFlag,
 	flags.BeaconRESTApiProviderFlag,
+	flags.EnableBeaconNodeFailoverFlag,
+	flags.BeaconNodeHealthCheckIntervalFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
 	flags.DisablePenaltyRewardLogFlag,
@@ -77,6 +79,8 @@ var appFlags = []cli.Flag{
 	flags.Web3SignerPublicValidatorKeysFlag,
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
+	flags.ProposerSettingsReloadFlag,
+	flags.ProposerSettingsURLPollIntervalFlag,
 	flags.ProposerSettingsFlag,
 	flags.EnableBuilderFlag,
 	flags.BuilderGasLimitFlag,
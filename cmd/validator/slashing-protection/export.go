@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/cmd"
@@ -63,11 +64,26 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 			log.WithError(err).Errorf("Could not close validator DB")
 		}
 	}()
-	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
+	var filteredKeys [][]byte
+	for _, key := range strings.Split(cliCtx.String(flags.SlashingProtectionExportPublicKeysFlag.Name), ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		pubKey, err := slashingprotection.PubKeyFromHex(key)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode public key %s", key)
+		}
+		filteredKeys = append(filteredKeys, pubKey[:])
+	}
+	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB, filteredKeys...)
 	if err != nil {
 		return errors.Wrap(err, "could not export slashing protection history")
 	}
 
+	if len(filteredKeys) > 0 && (eipJSON == nil || len(eipJSON.Data) == 0) {
+		return errors.New("no slashing protection history was found for the given public keys")
+	}
 	// Check if JSON data is empty and issue a warning about common problems to the user.
 	if eipJSON == nil || len(eipJSON.Data) == 0 {
 		log.Fatal(
@@ -2,6 +2,7 @@ package historycmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -11,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
 	slashingprotection "github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
@@ -23,6 +25,8 @@ import (
 // 3. Read the JSON file from user input.
 // 4. Call the function which actually imports the data from
 // from the standard slashing protection JSON file into our database.
+// 5. If a merge mode is set, merge the data into the existing history instead, and report
+// the conflicts and the data lost to a minimal merge.
 func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	var err error
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
@@ -69,8 +73,29 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	log.Infof("Starting import of slashing protection file %s", protectionFilePath)
 	buf := bytes.NewBuffer(enc)
+	if cliCtx.IsSet(flags.SlashingProtectionMergeModeFlag.Name) {
+		mode := slashingprotection.MergeMode(cliCtx.String(flags.SlashingProtectionMergeModeFlag.Name))
+		log.Infof("Starting %s merge of slashing protection file %s", mode, protectionFilePath)
+		report, err := slashingprotection.MergeStandardProtectionJSON(cliCtx.Context, valDB, buf, mode)
+		if err != nil {
+			return err
+		}
+		logMergeReport(report)
+		if reportPath := cliCtx.String(flags.SlashingProtectionMergeReportFlag.Name); reportPath != "" {
+			encoded, err := json.MarshalIndent(report, "", "\t")
+			if err != nil {
+				return errors.Wrap(err, "could not JSON marshal slashing protection merge report")
+			}
+			if err := file.WriteFile(reportPath, encoded); err != nil {
+				return errors.Wrapf(err, "could not write file to path %s", reportPath)
+			}
+			log.Infof("Wrote slashing protection merge report to %s", reportPath)
+		}
+		log.Infof("Slashing protection JSON successfully merged into %s", dataDir)
+		return nil
+	}
+	log.Infof("Starting import of slashing protection file %s", protectionFilePath)
 	if err := slashingprotection.ImportStandardProtectionJSON(
 		cliCtx.Context, valDB, buf,
 	); err != nil {
@@ -79,3 +104,30 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	log.Infof("Slashing protection JSON successfully imported into %s", dataDir)
 	return nil
 }
+
+func logMergeReport(report *slashingprotection.MergeReport) {
+	for _, v := range report.Validators {
+		fields := logrus.Fields{
+			"pubkey":                v.Pubkey,
+			"savedBlocks":           v.SavedBlocks,
+			"savedAttestations":     v.SavedAttestations,
+			"duplicateBlocks":       v.DuplicateBlocks,
+			"duplicateAttestations": v.DuplicateAttestations,
+		}
+		if v.Conflicts() {
+			fields["doubleProposals"] = len(v.DoubleProposals)
+			fields["doubleVotes"] = len(v.DoubleVotes)
+			fields["surroundingVotes"] = len(v.SurroundingVotes)
+			log.WithFields(fields).Warn("Slashing protection history conflicts with the existing history, " +
+				"it was not merged and the public key is no longer allowed to sign")
+			continue
+		}
+		if len(v.LostBlocks) > 0 || len(v.LostAttestations) > 0 {
+			fields["lostBlocks"] = len(v.LostBlocks)
+			fields["lostAttestations"] = len(v.LostAttestations)
+			log.WithFields(fields).Warn("Merged slashing protection history, some of it was lost to minification")
+			continue
+		}
+		log.WithFields(fields).Info("Merged slashing protection history")
+	}
+}
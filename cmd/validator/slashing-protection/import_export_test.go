@@ -8,11 +8,13 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v3/cmd"
 	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/io/file"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
 	dbTest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
+	slashingprotection "github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history"
 	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
 	mocks "github.com/prysmaticlabs/prysm/v3/validator/testing"
 	"github.com/urfave/cli/v2"
@@ -176,3 +178,58 @@ func TestImportExportSlashingProtectionCli_EmptyData(t *testing.T) {
 		require.DeepEqual(t, make([]*format.SignedAttestation, 0), item.SignedAttestations)
 	}
 }
+
+func TestImportExportSlashingProtectionCli_MergeAndExportSubset(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "slashing-exports")
+	require.NoError(t, file.MkdirAll(outputPath))
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+	protectionFilePath := filepath.Join(outputPath, "slashing_history_import.json")
+	require.NoError(t, file.WriteFile(protectionFilePath, encoded))
+
+	validatorDB := dbTest.SetupDB(t, pubKeys)
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	reportPath := filepath.Join(outputPath, "merge_report.json")
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dbPath, "")
+	set.String(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath, "")
+	set.String(flags.SlashingProtectionExportDirFlag.Name, outputPath, "")
+	set.String(flags.SlashingProtectionMergeModeFlag.Name, "", "")
+	set.String(flags.SlashingProtectionMergeReportFlag.Name, reportPath, "")
+	set.String(flags.SlashingProtectionExportPublicKeysFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dbPath))
+	require.NoError(t, set.Set(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath))
+	require.NoError(t, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputPath))
+	require.NoError(t, set.Set(flags.SlashingProtectionMergeModeFlag.Name, "complete"))
+	require.NoError(t, set.Set(flags.SlashingProtectionMergeReportFlag.Name, reportPath))
+	require.NoError(t, set.Set(flags.SlashingProtectionExportPublicKeysFlag.Name, mockJSON.Data[1].Pubkey))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	// We merge the slashing protection history file via CLI, and read the merge report.
+	require.NoError(t, importSlashingProtectionJSON(cliCtx))
+	enc, err := file.ReadFileAsBytes(reportPath)
+	require.NoError(t, err)
+	report := &slashingprotection.MergeReport{}
+	require.NoError(t, json.Unmarshal(enc, report))
+	require.Equal(t, 2, len(report.Validators))
+	for i, v := range report.Validators {
+		require.Equal(t, true, v.Merged)
+		require.Equal(t, len(proposalHistory[i].Proposals), v.SavedBlocks)
+	}
+
+	// We export the slashing protection history of the second validator only.
+	require.NoError(t, exportSlashingProtectionJSON(cliCtx))
+	enc, err = file.ReadFileAsBytes(filepath.Join(outputPath, jsonExportFileName))
+	require.NoError(t, err)
+	receivedJSON := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, receivedJSON))
+	require.Equal(t, 1, len(receivedJSON.Data))
+	require.Equal(t, mockJSON.Data[1].Pubkey, receivedJSON.Data[0].Pubkey)
+	require.Equal(t, len(mockJSON.Data[1].SignedBlocks), len(receivedJSON.Data[0].SignedBlocks))
+}
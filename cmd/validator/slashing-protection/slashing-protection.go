@@ -21,6 +21,7 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionExportPublicKeysFlag,
 				features.Mainnet,
 				features.PraterTestnet,
 				features.RopstenTestnet,
@@ -49,6 +50,8 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionJSONFileFlag,
+				flags.SlashingProtectionMergeModeFlag,
+				flags.SlashingProtectionMergeReportFlag,
 				features.Mainnet,
 				features.PraterTestnet,
 				features.RopstenTestnet,
@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
 	"github.com/urfave/cli/v2"
 )
 
@@ -122,6 +123,13 @@ func ConstructCLIManagerOpts(cliCtx *cli.Context, keymanagerKind keymanager.Kind
 		}
 		cliOpts = append(cliOpts, accounts.WithKeymanagerOpts(opts))
 	}
+	if keymanagerKind == keymanager.Threshold {
+		opts, err := readThresholdKeymanagerConfig(cliCtx)
+		if err != nil {
+			return []accounts.Option{}, errors.Wrap(err, "could not read threshold keymanager config")
+		}
+		cliOpts = append(cliOpts, accounts.WithThresholdKeymanagerOpts(opts))
+	}
 	if keymanagerKind == keymanager.Web3Signer {
 		return []accounts.Option{}, errors.New("web3signer keymanager does not require persistent wallets.")
 	}
@@ -139,6 +147,7 @@ func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 			wallet.KeymanagerKindSelections[keymanager.Derived],
 			wallet.KeymanagerKindSelections[keymanager.Remote],
 			wallet.KeymanagerKindSelections[keymanager.Web3Signer],
+			wallet.KeymanagerKindSelections[keymanager.Threshold],
 		},
 	}
 	selection, _, err := promptSelect.Run()
@@ -148,6 +157,27 @@ func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 	return keymanager.Kind(selection), nil
 }
 
+// readThresholdKeymanagerConfig reads and validates the threshold keymanager configuration file
+// given by the --threshold-config-file flag.
+func readThresholdKeymanagerConfig(cliCtx *cli.Context) (*threshold.KeymanagerOpts, error) {
+	configPath := cliCtx.String(flags.ThresholdConfigFileFlag.Name)
+	if configPath == "" {
+		return nil, fmt.Errorf("--%s is required for a threshold wallet", flags.ThresholdConfigFileFlag.Name)
+	}
+	f, err := os.Open(configPath) // #nosec G304 -- Open is safe
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open %s", configPath)
+	}
+	opts, err := threshold.UnmarshalOptionsFile(f)
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid threshold keymanager config")
+	}
+	return opts, nil
+}
+
 // CreateAndSaveWalletCli from user input with a desired keymanager. If a
 // wallet already exists in the path, it suggests the user alternatives
 // such as how to edit their existing wallet configuration.
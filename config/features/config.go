@@ -47,6 +47,8 @@ type Flags struct {
 	WriteWalletPasswordOnWebOnboarding  bool // WriteWalletPasswordOnWebOnboarding writes the password to disk after Prysm web signup.
 	EnableDoppelGanger                  bool // EnableDoppelGanger enables doppelganger protection on startup for the validator.
 	EnableHistoricalSpaceRepresentation bool // EnableHistoricalSpaceRepresentation enables the saving of registry validators in separate buckets to save space
+	EnableHistoricalStateDiffs          bool // EnableHistoricalStateDiffs archives finalized states as hierarchical diffs instead of full snapshots.
+	EnableRewardBasedAttestationPacking bool // EnableRewardBasedAttestationPacking packs the attestations of a block proposal by the proposer reward they add.
 	EnableBeaconRESTApi                 bool // EnableBeaconRESTApi enables experimental usage of the beacon REST API by the validator when querying a beacon node
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
@@ -74,6 +76,8 @@ type Flags struct {
 
 	EnableVerboseSigVerification bool // EnableVerboseSigVerification specifies whether to verify individual signature if batch verification fails
 
+	EnableLightClient bool // EnableLightClient enables the beacon node to derive and serve light client data.
+
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
 	KeystoreImportDebounceInterval time.Duration
@@ -205,6 +209,14 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		log.WithField(enableHistoricalSpaceRepresentation.Name, enableHistoricalSpaceRepresentation.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableHistoricalSpaceRepresentation = true
 	}
+	if ctx.Bool(enableHistoricalStateDiffs.Name) {
+		logEnabled(enableHistoricalStateDiffs)
+		cfg.EnableHistoricalStateDiffs = true
+	}
+	if ctx.Bool(enableRewardBasedAttestationPacking.Name) {
+		logEnabled(enableRewardBasedAttestationPacking)
+		cfg.EnableRewardBasedAttestationPacking = true
+	}
 	if ctx.Bool(disablePullTips.Name) {
 		logEnabled(disablePullTips)
 		cfg.DisablePullTips = true
@@ -263,6 +275,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(enableVerboseSigVerification)
 		cfg.EnableVerboseSigVerification = true
 	}
+	if ctx.IsSet(enableLightClient.Name) {
+		logEnabled(enableLightClient)
+		cfg.EnableLightClient = true
+	}
 	Init(cfg)
 	return nil
 }
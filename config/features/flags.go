@@ -93,6 +93,16 @@ var (
 			" (Warning): Once enabled, this feature migrates your database in to a new schema and " +
 			"there is no going back. At worst, your entire database might get corrupted.",
 	}
+	enableHistoricalStateDiffs = &cli.BoolFlag{
+		Name: "enable-historical-state-diffs",
+		Usage: "Enables the beacon chain to archive finalized states as layered diffs against periodic full snapshots, " +
+			"see --state-diff-exponents. States archived with --slots-per-archive-point remain readable.",
+	}
+	enableRewardBasedAttestationPacking = &cli.BoolFlag{
+		Name: "enable-reward-based-attestation-packing",
+		Usage: "Enables packing the attestations of a block proposal by the proposer reward they add on top of the " +
+			"participation already recorded in the head state, instead of by aggregation bit count. Only applies from altair onwards.",
+	}
 	disablePullTips = &cli.BoolFlag{
 		Name:  "experimental-enable-boundary-checks",
 		Usage: "Experimental enable of boundary checks, useful for debugging, may cause bad votes.",
@@ -136,6 +146,10 @@ var (
 		Name:  "enable-verbose-sig-verification",
 		Usage: "Enables identifying invalid signatures if batch verification fails when processing block",
 	}
+	enableLightClient = &cli.BoolFlag{
+		Name:  "enable-light-client",
+		Usage: "Enables the beacon node to derive, store and serve light client data over p2p and the Beacon API",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -174,6 +188,8 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	disableBroadcastSlashingFlag,
 	enableSlasherFlag,
 	enableHistoricalSpaceRepresentation,
+	enableHistoricalStateDiffs,
+	enableRewardBasedAttestationPacking,
 	disablePullTips,
 	disableVecHTR,
 	disableForkChoiceDoublyLinkedTree,
@@ -183,6 +199,7 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	disableDefensivePull,
 	enableFullSSZDataLogging,
 	enableVerboseSigVerification,
+	enableLightClient,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
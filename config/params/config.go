@@ -130,6 +130,7 @@ type BeaconChainConfig struct {
 	DefaultPageSize                int           // DefaultPageSize defines the default page size for RPC server request.
 	MaxPeersToSync                 int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
 	SlotsPerArchivedPoint          types.Slot    // SlotsPerArchivedPoint defines the number of slots per one archived point.
+	StateDiffExponents             []uint64      // StateDiffExponents defines the power of two slot intervals of the historical state diff layers, from the finest to the coarsest which is stored as full snapshots.
 	GenesisCountdownInterval       time.Duration // How often to log the countdown until the genesis time is reached.
 	BeaconStateFieldCount          int           // BeaconStateFieldCount defines how many fields are in the Phase0 beacon state.
 	BeaconStateAltairFieldCount    int           // BeaconStateAltairFieldCount defines how many fields are in the beacon state post upgrade to Altair.
@@ -208,6 +209,10 @@ type BeaconChainConfig struct {
 	MaxBuilderConsecutiveMissedSlots types.Slot // MaxBuilderConsecutiveMissedSlots defines the number of consecutive skip slot to fallback from using relay/builder to local execution engine for block construction.
 	MaxBuilderEpochMissedSlots       types.Slot // MaxBuilderEpochMissedSlots is defines the number of total skip slot (per epoch rolling windows) to fallback from using relay/builder to local execution engine for block construction.
 
+	// Mev-boost bid evaluation
+	LocalBlockValueBoost uint64 // LocalBlockValueBoost is the percentage a builder bid has to exceed the local execution payload value by for the builder block to be proposed.
+	MinBuilderBid        uint64 // MinBuilderBid is the minimum value, in gwei, a builder bid needs to be considered for block construction.
+
 	// Execution engine timeout value
 	ExecutionEngineTimeoutValue uint64 // ExecutionEngineTimeoutValue defines the seconds to wait before timing out engine endpoints with execution payload execution semantics (newPayload, forkchoiceUpdated).
 }
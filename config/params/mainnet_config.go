@@ -185,6 +185,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	DefaultPageSize:                250,
 	MaxPeersToSync:                 15,
 	SlotsPerArchivedPoint:          2048,
+	StateDiffExponents:             []uint64{5, 7, 10, 13, 16},
 	GenesisCountdownInterval:       time.Minute,
 	ConfigName:                     MainnetName,
 	PresetBase:                     "mainnet",
@@ -259,6 +260,10 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	MaxBuilderConsecutiveMissedSlots: 3,
 	MaxBuilderEpochMissedSlots:       8,
 
+	// Mevboost bid evaluation
+	LocalBlockValueBoost: 0,
+	MinBuilderBid:        0,
+
 	// Execution engine timeout value
 	ExecutionEngineTimeoutValue: 8, // 8 seconds default based on: https://github.com/ethereum/execution-apis/blob/main/src/engine/specification.md#core
 }
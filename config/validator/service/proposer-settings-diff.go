@@ -0,0 +1,231 @@
+package validator_service_config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+)
+
+// ProposerOptionChange is the change of the proposer option of a validator, or of the default proposer option.
+// Old or New are nil if the validator had or has no proposer option.
+type ProposerOptionChange struct {
+	Old *ProposerOption
+	New *ProposerOption
+}
+
+// String describes the changed fields of the proposer option.
+func (c *ProposerOptionChange) String() string {
+	var o, n ProposerOption
+	if c.Old != nil {
+		o = *c.Old
+	}
+	if c.New != nil {
+		n = *c.New
+	}
+	var changes []string
+	if c.Old == nil || c.New == nil || o.FeeRecipient != n.FeeRecipient {
+		changes = append(changes, fmt.Sprintf("fee recipient %s -> %s", feeRecipientString(c.Old), feeRecipientString(c.New)))
+	}
+	ob, nb := o.BuilderConfig, n.BuilderConfig
+	if builderEnabled(ob) != builderEnabled(nb) {
+		changes = append(changes, fmt.Sprintf("builder enabled %t -> %t", builderEnabled(ob), builderEnabled(nb)))
+	}
+	if gasLimit(ob) != gasLimit(nb) {
+		changes = append(changes, fmt.Sprintf("gas limit %d -> %d", gasLimit(ob), gasLimit(nb)))
+	}
+	if !equalRelays(relays(ob), relays(nb)) {
+		changes = append(changes, fmt.Sprintf("relays %v -> %v", relays(ob), relays(nb)))
+	}
+	return strings.Join(changes, ", ")
+}
+
+// ProposerSettingsDiff is the difference between two proposer settings.
+type ProposerSettingsDiff struct {
+	// DefaultConfig is the change of the default proposer option, nil if it did not change.
+	DefaultConfig *ProposerOptionChange
+	// Changes are the changes of the proposer options that apply to validators, by public key.
+	Changes map[[fieldparams.BLSPubkeyLength]byte]*ProposerOptionChange
+}
+
+// Empty returns true if the proposer settings did not change.
+func (d *ProposerSettingsDiff) Empty() bool {
+	return d.DefaultConfig == nil && len(d.Changes) == 0
+}
+
+// Pubkeys returns the public keys of the validators whose proposer option changed, in ascending order.
+func (d *ProposerSettingsDiff) Pubkeys() [][fieldparams.BLSPubkeyLength]byte {
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(d.Changes))
+	for k := range d.Changes {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return hexutil.Encode(keys[i][:]) < hexutil.Encode(keys[j][:])
+	})
+	return keys
+}
+
+// String describes the changes, one per line.
+func (d *ProposerSettingsDiff) String() string {
+	if d.Empty() {
+		return "no changes"
+	}
+	var lines []string
+	if d.DefaultConfig != nil {
+		lines = append(lines, "default: "+d.DefaultConfig.String())
+	}
+	for _, k := range d.Pubkeys() {
+		lines = append(lines, fmt.Sprintf("%#x: %s", k, d.Changes[k]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DiffProposerSettings returns the changes from the old to the new proposer settings. The changes are those of
+// the proposer options that apply to the validators with the given public keys or to validators with a
+// proposer option of their own, falling back to the default proposer option.
+func DiffProposerSettings(old, updated *ProposerSettings, pubkeys [][fieldparams.BLSPubkeyLength]byte) *ProposerSettingsDiff {
+	d := &ProposerSettingsDiff{Changes: make(map[[fieldparams.BLSPubkeyLength]byte]*ProposerOptionChange)}
+	if !equalOptions(old.defaultOption(), updated.defaultOption()) {
+		d.DefaultConfig = &ProposerOptionChange{Old: old.defaultOption(), New: updated.defaultOption()}
+	}
+	keys := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for _, k := range pubkeys {
+		keys[k] = true
+	}
+	for _, s := range []*ProposerSettings{old, updated} {
+		if s == nil {
+			continue
+		}
+		for k := range s.ProposeConfig {
+			keys[k] = true
+		}
+	}
+	for k := range keys {
+		o, n := old.Option(k), updated.Option(k)
+		if !equalOptions(o, n) {
+			d.Changes[k] = &ProposerOptionChange{Old: o, New: n}
+		}
+	}
+	return d
+}
+
+// UnknownPubkeys returns the public keys with a proposer option of their own which are not among the given
+// public keys, in ascending order.
+func (ps *ProposerSettings) UnknownPubkeys(pubkeys [][fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	if ps == nil {
+		return nil
+	}
+	known := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(pubkeys))
+	for _, k := range pubkeys {
+		known[k] = true
+	}
+	var unknown [][fieldparams.BLSPubkeyLength]byte
+	for k := range ps.ProposeConfig {
+		if !known[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool {
+		return hexutil.Encode(unknown[i][:]) < hexutil.Encode(unknown[j][:])
+	})
+	return unknown
+}
+
+// Option returns the proposer option that applies to the validator with the given public key: its own proposer
+// option, or the default one.
+func (ps *ProposerSettings) Option(pubkey [fieldparams.BLSPubkeyLength]byte) *ProposerOption {
+	if ps == nil {
+		return nil
+	}
+	if o, ok := ps.ProposeConfig[pubkey]; ok && o != nil {
+		return o
+	}
+	return ps.DefaultConfig
+}
+
+// Clone returns a deep copy of the proposer settings.
+func (ps *ProposerSettings) Clone() *ProposerSettings {
+	if ps == nil {
+		return nil
+	}
+	c := &ProposerSettings{DefaultConfig: ps.DefaultConfig.Clone()}
+	if ps.ProposeConfig != nil {
+		c.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption, len(ps.ProposeConfig))
+		for k, o := range ps.ProposeConfig {
+			c.ProposeConfig[k] = o.Clone()
+		}
+	}
+	return c
+}
+
+// Clone returns a deep copy of the proposer option.
+func (po *ProposerOption) Clone() *ProposerOption {
+	if po == nil {
+		return nil
+	}
+	c := &ProposerOption{FeeRecipient: po.FeeRecipient}
+	if po.BuilderConfig != nil {
+		b := *po.BuilderConfig
+		if po.BuilderConfig.Relays != nil {
+			b.Relays = append([]string{}, po.BuilderConfig.Relays...)
+		}
+		c.BuilderConfig = &b
+	}
+	return c
+}
+
+func (ps *ProposerSettings) defaultOption() *ProposerOption {
+	if ps == nil {
+		return nil
+	}
+	return ps.DefaultConfig
+}
+
+func equalOptions(a, b *ProposerOption) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.FeeRecipient == b.FeeRecipient &&
+		builderEnabled(a.BuilderConfig) == builderEnabled(b.BuilderConfig) &&
+		gasLimit(a.BuilderConfig) == gasLimit(b.BuilderConfig) &&
+		equalRelays(relays(a.BuilderConfig), relays(b.BuilderConfig))
+}
+
+func feeRecipientString(o *ProposerOption) string {
+	if o == nil {
+		return "none"
+	}
+	return o.FeeRecipient.Hex()
+}
+
+func builderEnabled(b *BuilderConfig) bool {
+	return b != nil && b.Enabled
+}
+
+func gasLimit(b *BuilderConfig) Uint64 {
+	if b == nil {
+		return 0
+	}
+	return b.GasLimit
+}
+
+func relays(b *BuilderConfig) []string {
+	if b == nil {
+		return nil
+	}
+	return b.Relays
+}
+
+func equalRelays(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package validator_service_config
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestDiffProposerSettings(t *testing.T) {
+	key1 := [fieldparams.BLSPubkeyLength]byte{1}
+	key2 := [fieldparams.BLSPubkeyLength]byte{2}
+	key3 := [fieldparams.BLSPubkeyLength]byte{3}
+	feeA := common.HexToAddress("0x046Fb65722E7b2455043BFEBf6177F1D2e9738D9")
+	feeB := common.HexToAddress("0x055Fb65722E7b2455043BFEBf6177F1D2e9738D9")
+	old := &ProposerSettings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption{
+			key1: {FeeRecipient: feeA, BuilderConfig: &BuilderConfig{Enabled: true, GasLimit: 30000000}},
+			key2: {FeeRecipient: feeA},
+		},
+		DefaultConfig: &ProposerOption{FeeRecipient: feeA},
+	}
+
+	d := DiffProposerSettings(old, old.Clone(), [][fieldparams.BLSPubkeyLength]byte{key1, key3})
+	assert.Equal(t, true, d.Empty())
+	assert.Equal(t, "no changes", d.String())
+
+	updated := old.Clone()
+	updated.ProposeConfig[key1].BuilderConfig.GasLimit = 35000000
+	updated.ProposeConfig[key1].BuilderConfig.Relays = []string{"https://relay.example.com"}
+	delete(updated.ProposeConfig, key2)
+	updated.DefaultConfig.FeeRecipient = feeB
+	// The old settings are left untouched by changes to the clone.
+	assert.Equal(t, Uint64(30000000), old.ProposeConfig[key1].BuilderConfig.GasLimit)
+
+	d = DiffProposerSettings(old, updated, [][fieldparams.BLSPubkeyLength]byte{key1, key3})
+	require.NotNil(t, d.DefaultConfig)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{key1, key2, key3}, d.Pubkeys())
+	assert.Equal(t, "gas limit 30000000 -> 35000000, relays [] -> [https://relay.example.com]", d.Changes[key1].String())
+	// Key 2 falls back to the changed default option.
+	assert.Equal(t, "fee recipient "+feeA.Hex()+" -> "+feeB.Hex(), d.Changes[key2].String())
+	assert.Equal(t, "fee recipient "+feeA.Hex()+" -> "+feeB.Hex(), d.Changes[key3].String())
+
+	d = DiffProposerSettings(nil, updated, nil)
+	assert.Equal(t, "fee recipient none -> "+feeB.Hex(), d.DefaultConfig.String())
+	require.Equal(t, 1, len(d.Changes))
+}
+
+func TestProposerSettings_UnknownPubkeys(t *testing.T) {
+	key1 := [fieldparams.BLSPubkeyLength]byte{1}
+	key2 := [fieldparams.BLSPubkeyLength]byte{2}
+	s := &ProposerSettings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption{
+			key1: {},
+			key2: {},
+		},
+	}
+	assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{key2}, s.UnknownPubkeys([][fieldparams.BLSPubkeyLength]byte{key1}))
+	assert.Equal(t, 0, len(s.UnknownPubkeys([][fieldparams.BLSPubkeyLength]byte{key1, key2})))
+}
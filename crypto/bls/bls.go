@@ -73,3 +73,14 @@ func NewAggregateSignature() common.Signature {
 func RandKey() (common.SecretKey, error) {
 	return blst.RandKey()
 }
+
+// SplitSecretKey splits a secret key into n shares, any threshold of which can sign for the secret key.
+func SplitSecretKey(secretKey common.SecretKey, threshold, n uint64) ([]common.SecretKey, error) {
+	return blst.SplitSecretKey(secretKey, threshold, n)
+}
+
+// CombineSignatureShares combines the signatures of the secret key shares at the given signer indices
+// into the signature of the secret key.
+func CombineSignatureShares(indices []uint64, sigs []common.Signature) (common.Signature, error) {
+	return blst.CombineSignatureShares(indices, sigs)
+}
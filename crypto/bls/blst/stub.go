@@ -152,3 +152,13 @@ func RandKey() (common.SecretKey, error) {
 func VerifyCompressed(_, _, _ []byte) bool {
 	panic(err)
 }
+
+// SplitSecretKey -- stub
+func SplitSecretKey(_ common.SecretKey, _, _ uint64) ([]common.SecretKey, error) {
+	panic(err)
+}
+
+// CombineSignatureShares -- stub
+func CombineSignatureShares(_ []uint64, _ []common.Signature) (common.Signature, error) {
+	panic(err)
+}
@@ -0,0 +1,106 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// curveOrder is the order r of the BLS12-381 subgroups, secret keys being elements of the scalar field of order r.
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// SplitSecretKey splits a secret key into n shares using Shamir's secret sharing, so that signatures
+// of any threshold of the shares can be combined into the signature of the secret key. The share at
+// index i of the returned slice is the share of signer index i+1.
+func SplitSecretKey(secretKey common.SecretKey, threshold, n uint64) ([]common.SecretKey, error) {
+	if threshold == 0 || threshold > n {
+		return nil, errors.Errorf("threshold %d must be between 1 and the number of shares %d", threshold, n)
+	}
+	// The polynomial of degree threshold-1 evaluating to the secret key at 0.
+	coefficients := make([]*big.Int, threshold)
+	coefficients[0] = new(big.Int).SetBytes(secretKey.Marshal())
+	for i := uint64(1); i < threshold; i++ {
+		c, err := rand.Int(rand.Reader, curveOrder)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate polynomial coefficient")
+		}
+		coefficients[i] = c
+	}
+	shares := make([]common.SecretKey, n)
+	for i := uint64(1); i <= n; i++ {
+		x := new(big.Int).SetUint64(i)
+		y := new(big.Int)
+		for j := len(coefficients) - 1; j >= 0; j-- {
+			y.Mul(y, x)
+			y.Add(y, coefficients[j])
+			y.Mod(y, curveOrder)
+		}
+		var b [scalarBytes]byte
+		share, err := SecretKeyFromBytes(y.FillBytes(b[:]))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create share %d", i)
+		}
+		shares[i-1] = share
+	}
+	return shares, nil
+}
+
+// CombineSignatureShares combines the signatures of a message by secret key shares, into the signature
+// of the message by the secret key which was split. The signatures are those of the signers at the given
+// indices, and there must be at least as many of them as the threshold the secret key was split with.
+func CombineSignatureShares(indices []uint64, sigs []common.Signature) (common.Signature, error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no signature share")
+	}
+	if len(indices) != len(sigs) {
+		return nil, errors.Errorf("got %d signer indices for %d signature shares", len(indices), len(sigs))
+	}
+	seen := make(map[uint64]bool, len(indices))
+	for _, i := range indices {
+		if i == 0 {
+			return nil, errors.New("signer indices must be positive")
+		}
+		if seen[i] {
+			return nil, errors.Errorf("duplicate signer index %d", i)
+		}
+		seen[i] = true
+	}
+
+	combined := new(blst.P2)
+	for i, index := range indices {
+		// Lagrange coefficient of the share at x=0.
+		num, den := big.NewInt(1), big.NewInt(1)
+		xi := new(big.Int).SetUint64(index)
+		for _, other := range indices {
+			if other == index {
+				continue
+			}
+			xj := new(big.Int).SetUint64(other)
+			num.Mul(num, xj)
+			num.Mod(num, curveOrder)
+			den.Mul(den, new(big.Int).Sub(xj, xi))
+			den.Mod(den, curveOrder)
+		}
+		coefficient := num.Mul(num, den.ModInverse(den, curveOrder))
+		coefficient.Mod(coefficient, curveOrder)
+		var b [scalarBytes]byte
+		scalar := new(blst.Scalar).Deserialize(coefficient.FillBytes(b[:]))
+		if scalar == nil {
+			return nil, errors.New("could not create lagrange coefficient")
+		}
+
+		sig, ok := sigs[i].(*Signature)
+		if !ok {
+			return nil, errors.Errorf("signature share %d is not a blst signature", index)
+		}
+		p := new(blst.P2)
+		p.FromAffine(sig.s)
+		combined.AddAssign(p.MultAssign(scalar))
+	}
+	return &Signature{s: combined.ToAffine()}, nil
+}
@@ -0,0 +1,66 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestSplitSecretKey_CombineSignatureShares(t *testing.T) {
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	shares, err := blst.SplitSecretKey(sk, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+
+	msg := []byte("hello")
+	sigs := make([]common.Signature, len(shares))
+	for i, share := range shares {
+		sigs[i] = share.Sign(msg)
+		assert.Equal(t, false, sigs[i].Verify(sk.PublicKey(), msg), "Share %d signed for the secret key", i+1)
+	}
+
+	for _, indices := range [][]uint64{{1, 2, 3}, {5, 2, 4}, {1, 2, 3, 4, 5}} {
+		subset := make([]common.Signature, len(indices))
+		for i, index := range indices {
+			subset[i] = sigs[index-1]
+		}
+		sig, err := blst.CombineSignatureShares(indices, subset)
+		require.NoError(t, err)
+		assert.Equal(t, true, sig.Verify(sk.PublicKey(), msg), "Could not combine shares %v", indices)
+		assert.DeepEqual(t, sk.Sign(msg).Marshal(), sig.Marshal())
+	}
+
+	// Fewer shares than the threshold do not sign for the secret key.
+	sig, err := blst.CombineSignatureShares([]uint64{1, 2}, sigs[:2])
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(sk.PublicKey(), msg))
+}
+
+func TestSplitSecretKey_InvalidThreshold(t *testing.T) {
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	_, err = blst.SplitSecretKey(sk, 0, 3)
+	assert.ErrorContains(t, "threshold 0 must be between 1 and the number of shares 3", err)
+	_, err = blst.SplitSecretKey(sk, 4, 3)
+	assert.ErrorContains(t, "threshold 4 must be between 1 and the number of shares 3", err)
+}
+
+func TestCombineSignatureShares_InvalidIndices(t *testing.T) {
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	sig := sk.Sign([]byte("hello"))
+	_, err = blst.CombineSignatureShares(nil, nil)
+	assert.ErrorContains(t, "no signature share", err)
+	_, err = blst.CombineSignatureShares([]uint64{1}, []common.Signature{sig, sig})
+	assert.ErrorContains(t, "got 1 signer indices for 2 signature shares", err)
+	_, err = blst.CombineSignatureShares([]uint64{0}, []common.Signature{sig})
+	assert.ErrorContains(t, "signer indices must be positive", err)
+	_, err = blst.CombineSignatureShares([]uint64{2, 2}, []common.Signature{sig, sig})
+	assert.ErrorContains(t, "duplicate signer index 2", err)
+}
@@ -12,7 +12,8 @@ proto_mocks_v1alpha1=(
       "$mock_path/beacon_chain_service_mock.go BeaconChain_StreamChainHeadServer,BeaconChain_StreamAttestationsServer,BeaconChain_StreamBlocksServer,BeaconChain_StreamValidatorsInfoServer,BeaconChain_StreamIndexedAttestationsServer"
       "$mock_path/beacon_validator_server_mock.go BeaconNodeValidatorServer,BeaconNodeValidator_WaitForActivationServer,BeaconNodeValidator_WaitForChainStartServer,BeaconNodeValidator_StreamDutiesServer"
       "$mock_path/beacon_validator_client_mock.go BeaconNodeValidatorClient,BeaconNodeValidator_WaitForChainStartClient,BeaconNodeValidator_WaitForActivationClient,BeaconNodeValidator_StreamDutiesClient"
-      "$mock_path/slasher_client_mock.go SlasherClient"
+      "$mock_path/slasher_client_mock.go SlasherClient,Slasher_StreamAttesterSlashingsClient,Slasher_StreamProposerSlashingsClient"
+      "$mock_path/slasher_service_mock.go Slasher_StreamAttesterSlashingsServer,Slasher_StreamProposerSlashingsServer"
       "$mock_path/node_service_mock.go NodeClient"
 )
 
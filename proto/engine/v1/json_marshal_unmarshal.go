@@ -130,6 +130,56 @@ func (e *ExecutionBlock) UnmarshalJSON(enc []byte) error {
 	return nil
 }
 
+// ExecutionPayloadBodyV1 is the response kind received by the engine_getPayloadBodiesByHashV1
+// and engine_getPayloadBodiesByRangeV1 endpoints via JSON-RPC.
+type ExecutionPayloadBodyV1 struct {
+	Transactions []hexutil.Bytes `json:"transactions"`
+	Withdrawals  []*Withdrawal   `json:"withdrawals"`
+}
+
+// ExecutionPayloadCapellaWithValue is the response kind received by the engine_getPayloadV2 endpoint
+// via JSON-RPC. It pairs the execution payload with the value, in wei, the block pays to its fee recipient.
+type ExecutionPayloadCapellaWithValue struct {
+	Payload *ExecutionPayloadCapella
+	Value   *big.Int
+}
+
+type executionPayloadCapellaWithValueJSON struct {
+	Payload *ExecutionPayloadCapella `json:"executionPayload"`
+	Value   string                   `json:"blockValue"`
+}
+
+// MarshalJSON --
+func (e *ExecutionPayloadCapellaWithValue) MarshalJSON() ([]byte, error) {
+	value := big.NewInt(0)
+	if e.Value != nil {
+		value = e.Value
+	}
+	return json.Marshal(executionPayloadCapellaWithValueJSON{
+		Payload: e.Payload,
+		Value:   hexutil.EncodeBig(value),
+	})
+}
+
+// UnmarshalJSON --
+func (e *ExecutionPayloadCapellaWithValue) UnmarshalJSON(enc []byte) error {
+	dec := executionPayloadCapellaWithValueJSON{}
+	if err := json.Unmarshal(enc, &dec); err != nil {
+		return err
+	}
+	if dec.Payload == nil {
+		return errors.New("missing required field 'executionPayload' for ExecutionPayloadCapellaWithValue")
+	}
+	value, err := hexutil.DecodeBig(dec.Value)
+	if err != nil {
+		return errors.Wrap(err, "could not decode block value")
+	}
+	*e = ExecutionPayloadCapellaWithValue{}
+	e.Payload = dec.Payload
+	e.Value = value
+	return nil
+}
+
 // UnmarshalJSON --
 func (b *PayloadIDBytes) UnmarshalJSON(enc []byte) error {
 	var res [8]byte
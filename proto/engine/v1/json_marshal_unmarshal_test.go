@@ -406,6 +406,31 @@ func TestJsonMarshalUnmarshal(t *testing.T) {
 		require.DeepEqual(t, bytesutil.PadTo([]byte("address2"), 20), payloadPb.Withdrawals[1].Address)
 		require.Equal(t, uint64(2), payloadPb.Withdrawals[1].Amount)
 	})
+	t.Run("execution payload capella with value", func(t *testing.T) {
+		want := &enginev1.ExecutionPayloadCapellaWithValue{
+			Payload: &enginev1.ExecutionPayloadCapella{
+				BlockNumber: 1,
+				GasLimit:    2,
+				Withdrawals: []*enginev1.Withdrawal{{Index: 3, ValidatorIndex: 4, Amount: 5}},
+			},
+			Value: big.NewInt(1234567890),
+		}
+		enc, err := json.Marshal(want)
+		require.NoError(t, err)
+		items := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(enc, &items))
+		require.Equal(t, "0x499602d2", items["blockValue"])
+
+		got := &enginev1.ExecutionPayloadCapellaWithValue{}
+		require.NoError(t, json.Unmarshal(enc, got))
+		require.DeepEqual(t, want.Value, got.Value)
+		require.Equal(t, want.Payload.BlockNumber, got.Payload.BlockNumber)
+		require.Equal(t, want.Payload.GasLimit, got.Payload.GasLimit)
+		require.Equal(t, 1, len(got.Payload.Withdrawals))
+		require.Equal(t, uint64(5), got.Payload.Withdrawals[0].Amount)
+
+		require.ErrorContains(t, "missing required field 'executionPayload'", json.Unmarshal([]byte(`{"blockValue":"0x1"}`), got))
+	})
 }
 
 func TestPayloadIDBytes_MarshalUnmarshalJSON(t *testing.T) {
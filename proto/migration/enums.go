@@ -20,3 +20,18 @@ func V1Alpha1PeerDirectionToV1(peerDirection eth.PeerDirection) (ethpb.PeerDirec
 	v1Value := ethpb.PeerDirection_value[alphaString]
 	return ethpb.PeerDirection(v1Value), nil
 }
+
+// V1Alpha1SlashingStatusToV1 converts the status of a slashing detected by the slasher to the status of the
+// corresponding v1 event.
+func V1Alpha1SlashingStatusToV1(slashingStatus eth.SlashingEvent_Status) (ethpb.EventDetectedSlashing_Status, error) {
+	switch slashingStatus {
+	case eth.SlashingEvent_DETECTED:
+		return ethpb.EventDetectedSlashing_DETECTED, nil
+	case eth.SlashingEvent_POOLED:
+		return ethpb.EventDetectedSlashing_POOLED, nil
+	case eth.SlashingEvent_INCLUDED:
+		return ethpb.EventDetectedSlashing_INCLUDED, nil
+	default:
+		return 0, errors.Errorf("unknown slashing status %d", slashingStatus)
+	}
+}
@@ -80,3 +80,46 @@ func TestV1Alpha1PeerDirectionToV1(t *testing.T) {
 		})
 	}
 }
+
+func TestV1Alpha1SlashingStatusToV1(t *testing.T) {
+	tests := []struct {
+		name           string
+		slashingStatus eth.SlashingEvent_Status
+		want           v1.EventDetectedSlashing_Status
+		wantErr        bool
+	}{
+		{
+			name:           "DETECTED",
+			slashingStatus: eth.SlashingEvent_DETECTED,
+			want:           v1.EventDetectedSlashing_DETECTED,
+		},
+		{
+			name:           "POOLED",
+			slashingStatus: eth.SlashingEvent_POOLED,
+			want:           v1.EventDetectedSlashing_POOLED,
+		},
+		{
+			name:           "INCLUDED",
+			slashingStatus: eth.SlashingEvent_INCLUDED,
+			want:           v1.EventDetectedSlashing_INCLUDED,
+		},
+		{
+			name:           "unknown",
+			slashingStatus: eth.SlashingEvent_Status(100),
+			want:           0,
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := V1Alpha1SlashingStatusToV1(tt.slashingStatus)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("V1Alpha1SlashingStatusToV1() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("V1Alpha1SlashingStatusToV1() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
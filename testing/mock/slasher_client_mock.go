@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1 (interfaces: SlasherClient)
+// Source: github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1 (interfaces: SlasherClient,Slasher_StreamAttesterSlashingsClient,Slasher_StreamProposerSlashingsClient)
 
 // Package mock is a generated GoMock package.
 package mock
@@ -11,6 +11,8 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	eth "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // MockSlasherClient is a mock of SlasherClient interface.
@@ -95,3 +97,289 @@ func (mr *MockSlasherClientMockRecorder) IsSlashableBlock(arg0, arg1 interface{}
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSlashableBlock", reflect.TypeOf((*MockSlasherClient)(nil).IsSlashableBlock), varargs...)
 }
+
+// StreamAttesterSlashings mocks base method.
+func (m *MockSlasherClient) StreamAttesterSlashings(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (eth.Slasher_StreamAttesterSlashingsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamAttesterSlashings", varargs...)
+	ret0, _ := ret[0].(eth.Slasher_StreamAttesterSlashingsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamAttesterSlashings indicates an expected call of StreamAttesterSlashings.
+func (mr *MockSlasherClientMockRecorder) StreamAttesterSlashings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAttesterSlashings", reflect.TypeOf((*MockSlasherClient)(nil).StreamAttesterSlashings), varargs...)
+}
+
+// StreamProposerSlashings mocks base method.
+func (m *MockSlasherClient) StreamProposerSlashings(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (eth.Slasher_StreamProposerSlashingsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamProposerSlashings", varargs...)
+	ret0, _ := ret[0].(eth.Slasher_StreamProposerSlashingsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamProposerSlashings indicates an expected call of StreamProposerSlashings.
+func (mr *MockSlasherClientMockRecorder) StreamProposerSlashings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamProposerSlashings", reflect.TypeOf((*MockSlasherClient)(nil).StreamProposerSlashings), varargs...)
+}
+
+// MockSlasher_StreamAttesterSlashingsClient is a mock of Slasher_StreamAttesterSlashingsClient interface.
+type MockSlasher_StreamAttesterSlashingsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlasher_StreamAttesterSlashingsClientMockRecorder
+}
+
+// MockSlasher_StreamAttesterSlashingsClientMockRecorder is the mock recorder for MockSlasher_StreamAttesterSlashingsClient.
+type MockSlasher_StreamAttesterSlashingsClientMockRecorder struct {
+	mock *MockSlasher_StreamAttesterSlashingsClient
+}
+
+// NewMockSlasher_StreamAttesterSlashingsClient creates a new mock instance.
+func NewMockSlasher_StreamAttesterSlashingsClient(ctrl *gomock.Controller) *MockSlasher_StreamAttesterSlashingsClient {
+	mock := &MockSlasher_StreamAttesterSlashingsClient{ctrl: ctrl}
+	mock.recorder = &MockSlasher_StreamAttesterSlashingsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlasher_StreamAttesterSlashingsClient) EXPECT() *MockSlasher_StreamAttesterSlashingsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockSlasher_StreamAttesterSlashingsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockSlasher_StreamAttesterSlashingsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSlasher_StreamAttesterSlashingsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockSlasher_StreamAttesterSlashingsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSlasher_StreamAttesterSlashingsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSlasher_StreamAttesterSlashingsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockSlasher_StreamAttesterSlashingsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockSlasher_StreamAttesterSlashingsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSlasher_StreamAttesterSlashingsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockSlasher_StreamAttesterSlashingsClient) Recv() (*eth.SlashingEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*eth.SlashingEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockSlasher_StreamAttesterSlashingsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSlasher_StreamAttesterSlashingsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockSlasher_StreamAttesterSlashingsClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSlasher_StreamAttesterSlashingsClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSlasher_StreamAttesterSlashingsClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockSlasher_StreamAttesterSlashingsClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSlasher_StreamAttesterSlashingsClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSlasher_StreamAttesterSlashingsClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockSlasher_StreamAttesterSlashingsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockSlasher_StreamAttesterSlashingsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSlasher_StreamAttesterSlashingsClient)(nil).Trailer))
+}
+
+// MockSlasher_StreamProposerSlashingsClient is a mock of Slasher_StreamProposerSlashingsClient interface.
+type MockSlasher_StreamProposerSlashingsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlasher_StreamProposerSlashingsClientMockRecorder
+}
+
+// MockSlasher_StreamProposerSlashingsClientMockRecorder is the mock recorder for MockSlasher_StreamProposerSlashingsClient.
+type MockSlasher_StreamProposerSlashingsClientMockRecorder struct {
+	mock *MockSlasher_StreamProposerSlashingsClient
+}
+
+// NewMockSlasher_StreamProposerSlashingsClient creates a new mock instance.
+func NewMockSlasher_StreamProposerSlashingsClient(ctrl *gomock.Controller) *MockSlasher_StreamProposerSlashingsClient {
+	mock := &MockSlasher_StreamProposerSlashingsClient{ctrl: ctrl}
+	mock.recorder = &MockSlasher_StreamProposerSlashingsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlasher_StreamProposerSlashingsClient) EXPECT() *MockSlasher_StreamProposerSlashingsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockSlasher_StreamProposerSlashingsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockSlasher_StreamProposerSlashingsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSlasher_StreamProposerSlashingsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockSlasher_StreamProposerSlashingsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSlasher_StreamProposerSlashingsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSlasher_StreamProposerSlashingsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockSlasher_StreamProposerSlashingsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockSlasher_StreamProposerSlashingsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSlasher_StreamProposerSlashingsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockSlasher_StreamProposerSlashingsClient) Recv() (*eth.SlashingEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*eth.SlashingEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockSlasher_StreamProposerSlashingsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSlasher_StreamProposerSlashingsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockSlasher_StreamProposerSlashingsClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSlasher_StreamProposerSlashingsClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSlasher_StreamProposerSlashingsClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockSlasher_StreamProposerSlashingsClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSlasher_StreamProposerSlashingsClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSlasher_StreamProposerSlashingsClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockSlasher_StreamProposerSlashingsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockSlasher_StreamProposerSlashingsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSlasher_StreamProposerSlashingsClient)(nil).Trailer))
+}
@@ -125,6 +125,11 @@ func SinceEpochStarts(slot types.Slot) types.Slot {
 
 // VerifyTime validates the input slot is not from the future.
 func VerifyTime(genesisTime uint64, slot types.Slot, timeTolerance time.Duration) error {
+	return VerifyTimeAt(genesisTime, slot, timeTolerance, prysmTime.Now())
+}
+
+// VerifyTimeAt validates the input slot is not from the future of the given current time.
+func VerifyTimeAt(genesisTime uint64, slot types.Slot, timeTolerance time.Duration, currentTime time.Time) error {
 	slotTime, err := ToTime(genesisTime, slot)
 	if err != nil {
 		return err
@@ -136,7 +141,6 @@ func VerifyTime(genesisTime uint64, slot types.Slot, timeTolerance time.Duration
 		return err
 	}
 
-	currentTime := prysmTime.Now()
 	diff := slotTime.Sub(currentTime)
 
 	if diff > timeTolerance {
@@ -166,7 +170,12 @@ func Since(time time.Time) types.Slot {
 // CurrentSlot returns the current slot as determined by the local clock and
 // provided genesis time.
 func CurrentSlot(genesisTimeSec uint64) types.Slot {
-	now := uint64(prysmTime.Now().Unix())
+	return SlotAt(genesisTimeSec, prysmTime.Now())
+}
+
+// SlotAt returns the slot at the given time, as determined by the provided genesis time.
+func SlotAt(genesisTimeSec uint64, t time.Time) types.Slot {
+	now := uint64(t.Unix())
 	if now < genesisTimeSec {
 		return 0
 	}
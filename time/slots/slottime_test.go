@@ -384,6 +384,14 @@ func TestVerifySlotTime(t *testing.T) {
 	}
 }
 
+func TestVerifySlotTimeAt(t *testing.T) {
+	genesisTime := uint64(prysmTime.Now().Add(-1 * 24 * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second).Unix())
+	currentTime := time.Unix(int64(genesisTime+5*params.BeaconConfig().SecondsPerSlot), 0)
+	assert.Equal(t, types.Slot(5), SlotAt(genesisTime, currentTime))
+	assert.NoError(t, VerifyTimeAt(genesisTime, 5, 0, currentTime))
+	assert.ErrorContains(t, "could not process slot from the future", VerifyTimeAt(genesisTime, 6, 0, currentTime))
+}
+
 func TestValidateSlotClock_HandlesBadSlot(t *testing.T) {
 	genTime := prysmTime.Now().Add(-1 * time.Duration(MaxSlotBuffer) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second).Unix()
 
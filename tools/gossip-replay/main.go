@@ -0,0 +1,201 @@
+/*
+Tool for replaying a gossip trace, as written by a beacon node running with --p2p-gossip-trace-dir,
+through the gossip validators of the beacon node, offline.
+
+Every traced message is validated against the given beacon database, at the time it was received
+by the node, and the replayed validation result is compared with the traced one. The database
+should be a copy of the database of the node taken before the first traced message: messages
+referring to blocks unknown to the database are ignored, and blocks already in the database are
+ignored as duplicates. With --import-blocks, the accepted blocks are imported optimistically, without
+an execution client, so that the messages which follow can refer to them. This writes to the database.
+
+Usage:
+
+	bazel run //tools/gossip-replay -- --datadir=/path/to/copy/beaconchaindata --trace=/path/to/trace/dir
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/gossiptrace"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync/gossipreplay"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	datadir         = flag.String("datadir", "", "Path to the directory of a copy of the beacon database.")
+	tracePath       = flag.String("trace", "", "Path to a gossip trace file, or to a directory of gossip trace files.")
+	network         = flag.String("network", params.MainnetName, "Name of the network of the trace: mainnet, prater or sepolia.")
+	chainConfigFile = flag.String("chain-config-file", "", "Path to a YAML chain config, overriding --network.")
+	topicFilter     = flag.String("topic", "", "Only replay the messages whose topic contains this string.")
+	importBlocks    = flag.Bool("import-blocks", false, "Import the accepted blocks into the database, so that the messages which follow can refer to them.")
+	verbose         = flag.Bool("verbose", false, "Print every replayed message.")
+)
+
+// topicStats counts the traced and replayed validation results of a topic.
+type topicStats struct {
+	total      int
+	matched    int
+	duplicates int
+	mismatches map[string]int
+}
+
+func main() {
+	flag.Parse()
+	if *datadir == "" || *tracePath == "" {
+		log.Fatal("Must provide --datadir and --trace")
+	}
+	if err := setChainConfig(); err != nil {
+		log.WithError(err).Fatal("Could not set chain config")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, err := kv.NewKVStore(ctx, *datadir)
+	if err != nil {
+		log.WithError(err).Fatal("Could not open database")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	replayer, err := gossipreplay.NewReplayer(ctx, db, *importBlocks)
+	if err != nil {
+		log.WithError(err).Fatal("Could not start replay services")
+	}
+
+	files, err := gossiptrace.Files(*tracePath)
+	if err != nil {
+		log.WithError(err).Fatal("Could not list trace files")
+	}
+	stats := make(map[string]*topicStats)
+	for _, name := range files {
+		if err := replayFile(ctx, name, replayer, stats); err != nil {
+			log.WithError(err).WithField("file", name).Error("Could not replay trace file")
+		}
+	}
+	printStats(stats)
+}
+
+func setChainConfig() error {
+	if *chainConfigFile != "" {
+		return params.LoadChainConfigFile(*chainConfigFile, nil)
+	}
+	cfg, err := params.ByName(*network)
+	if err != nil {
+		return err
+	}
+	return params.SetActive(cfg.Copy())
+}
+
+func replayFile(ctx context.Context, name string, replayer *gossipreplay.Replayer, stats map[string]*topicStats) error {
+	f, err := os.Open(name) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close trace file")
+		}
+	}()
+	r, err := gossiptrace.NewReader(f)
+	if err != nil {
+		return err
+	}
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err == io.ErrUnexpectedEOF {
+			log.WithField("file", name).Warn("Trace file ends with a truncated record")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if *topicFilter != "" && !strings.Contains(rec.Topic, *topicFilter) {
+			continue
+		}
+		replayRecord(ctx, rec, replayer, stats)
+	}
+}
+
+func replayRecord(ctx context.Context, rec *gossiptrace.Record, replayer *gossipreplay.Replayer, stats map[string]*topicStats) {
+	name := topicName(rec.Topic)
+	s, ok := stats[name]
+	if !ok {
+		s = &topicStats{mismatches: make(map[string]int)}
+		stats[name] = s
+	}
+	s.total++
+	replayed, err := replayer.Replay(ctx, rec)
+	if replayed == gossiptrace.ResultDuplicate {
+		s.duplicates++
+		return
+	}
+	if replayed == rec.Result {
+		s.matched++
+	} else {
+		s.mismatches[fmt.Sprintf("%s -> %s", rec.Result, replayed)]++
+	}
+	if *verbose {
+		fields := log.Fields{
+			"arrival":  rec.Arrival.UTC().Format(time.RFC3339Nano),
+			"topic":    rec.Topic,
+			"peer":     rec.From.String(),
+			"traced":   rec.Result.String(),
+			"replayed": replayed.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		}
+		log.WithFields(fields).Info("Replayed gossip message")
+	}
+}
+
+// topicName returns the name of the gossip topic, without the fork digest, subnet index and encoding.
+func topicName(topic string) string {
+	parts := strings.Split(topic, "/")
+	if len(parts) < 4 {
+		return topic
+	}
+	name := parts[3]
+	// Attestation and sync committee subnets.
+	if i := strings.LastIndex(name, "_"); i >= 0 && i+1 < len(name) && strings.Trim(name[i+1:], "0123456789") == "" {
+		name = name[:i]
+	}
+	return name
+}
+
+func printStats(stats map[string]*topicStats) {
+	names := make([]string, 0, len(stats))
+	for name := range stats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Printf("%-40s %10s %10s %10s %10s\n", "topic", "messages", "duplicates", "matched", "mismatched")
+	for _, name := range names {
+		s := stats[name]
+		fmt.Printf("%-40s %10d %10d %10d %10d\n", name, s.total, s.duplicates, s.matched, s.total-s.duplicates-s.matched)
+		mismatches := make([]string, 0, len(s.mismatches))
+		for m := range s.mismatches {
+			mismatches = append(mismatches, m)
+		}
+		sort.Strings(mismatches)
+		for _, m := range mismatches {
+			fmt.Printf("    %-36s %10d\n", m, s.mismatches[m])
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	validatorHelpers "github.com/prysmaticlabs/prysm/v3/validator/helpers"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
 	"google.golang.org/grpc"
 )
 
@@ -35,6 +36,7 @@ type AccountsCLIManager struct {
 	keymanager           keymanager.IKeymanager
 	keymanagerKind       keymanager.Kind
 	keymanagerOpts       *remote.KeymanagerOpts
+	thresholdOpts        *threshold.KeymanagerOpts
 	showDepositData      bool
 	showPrivateKeys      bool
 	listValidatorIndices bool
@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
 	"google.golang.org/grpc"
 )
 
@@ -45,6 +46,14 @@ func WithKeymanagerOpts(kmo *remote.KeymanagerOpts) Option {
 	}
 }
 
+// WithThresholdKeymanagerOpts provides a threshold keymanager configuration to the accounts cli manager.
+func WithThresholdKeymanagerOpts(opts *threshold.KeymanagerOpts) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.thresholdOpts = opts
+		return nil
+	}
+}
+
 // WithShowDepositData enables displaying deposit data in the accounts cli manager.
 func WithShowDepositData() Option {
 	return func(acc *AccountsCLIManager) error {
@@ -5,12 +5,15 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
 )
 
 // InitKeymanagerConfig defines configuration options for initializing a keymanager.
 type InitKeymanagerConfig struct {
 	ListenForChanges bool
 	Web3SignerConfig *remoteweb3signer.SetupConfig
+	// SlashingProtectionDB is required by the threshold keymanager to sign blocks and attestations.
+	SlashingProtectionDB threshold.SlashingProtectionDB
 }
 
 // Wallet defines a struct which has capabilities and knowledge of how
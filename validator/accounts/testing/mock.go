@@ -83,6 +83,7 @@ func (_ *Wallet) InitializeKeymanager(_ context.Context, _ iface.InitKeymanagerC
 
 type MockValidator struct {
 	Km               keymanager.IKeymanager
+	GraffitiRet      []byte
 	proposerSettings *validatorserviceconfig.ProposerSettings
 }
 
@@ -190,6 +191,11 @@ func (_ MockValidator) PushProposerSettings(_ context.Context, _ keymanager.IKey
 	panic("implement me")
 }
 
+// PushProposerSettingsForKeys for mocking
+func (_ MockValidator) PushProposerSettingsForKeys(_ context.Context, _ keymanager.IKeymanager, _ [][48]byte) error {
+	return nil
+}
+
 // SetPubKeyToValidatorIndexMap for mocking
 func (_ MockValidator) SetPubKeyToValidatorIndexMap(_ context.Context, _ keymanager.IKeymanager) error {
 	panic("implement me")
@@ -209,3 +215,8 @@ func (m *MockValidator) ProposerSettings() *validatorserviceconfig.ProposerSetti
 func (m *MockValidator) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
 	m.proposerSettings = settings
 }
+
+// Graffiti for mocking
+func (m *MockValidator) Graffiti(_ context.Context, _ [48]byte) ([]byte, error) {
+	return m.GraffitiRet, nil
+}
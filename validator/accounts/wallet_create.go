@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
 )
 
 // WalletCreate creates wallet specified by configuration options.
@@ -71,6 +72,13 @@ func (acm *AccountsCLIManager) WalletCreate(ctx context.Context) (*wallet.Wallet
 		log.WithField("--wallet-dir", acm.walletDir).Info(
 			"Successfully created wallet with remote keymanager configuration",
 		)
+	case keymanager.Threshold:
+		if err = createThresholdKeymanagerWallet(ctx, w, acm.thresholdOpts); err != nil {
+			return nil, errors.Wrap(err, "could not initialize wallet")
+		}
+		log.WithField("--wallet-dir", acm.walletDir).Info(
+			"Successfully created wallet with threshold keymanager configuration",
+		)
 	case keymanager.Web3Signer:
 		return nil, errors.New("web3signer keymanager does not require persistent wallets.")
 	default:
@@ -133,3 +141,20 @@ func createRemoteKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, op
 	}
 	return nil
 }
+
+func createThresholdKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, opts *threshold.KeymanagerOpts) error {
+	if err := opts.Validate(); err != nil {
+		return errors.Wrap(err, "invalid threshold keymanager config")
+	}
+	keymanagerConfig, err := threshold.MarshalOptionsFile(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config file")
+	}
+	if err := wallet.SaveWallet(); err != nil {
+		return errors.Wrap(err, "could not save wallet to disk")
+	}
+	if err := wallet.WriteKeymanagerConfigToDisk(ctx, keymanagerConfig); err != nil {
+		return errors.Wrap(err, "could not write keymanager config to disk")
+	}
+	return nil
+}
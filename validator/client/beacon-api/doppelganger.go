@@ -0,0 +1,226 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+type doppelGangerInfo struct {
+	validatorEpoch types.Epoch
+	response       *ethpb.DoppelGangerResponse_ValidatorResponse
+}
+
+func (c *beaconApiValidatorClient) checkDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+	// Check if there is any doppelganger validator for the last 2 epochs.
+	// - Check if the beacon node is synced
+	// - If we are in Phase0, we consider there is no doppelganger.
+	// - If all validators we want to check doppelganger existence were live in local antislashing
+	//   database for the last 2 epochs, we consider there is no doppelganger.
+	//   This is typically the case when we reboot the validator client.
+	// - If some validators we want to check doppelganger existence were NOT live
+	//   in local antislashing for the last two epochs, then we check onchain if there is
+	//   some liveness for these validators. If yes, we consider there is a doppelganger.
+
+	// Check inputs are correct.
+	if in == nil || in.ValidatorRequests == nil || len(in.ValidatorRequests) == 0 {
+		return &ethpb.DoppelGangerResponse{
+			Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{},
+		}, nil
+	}
+
+	validatorRequests := in.ValidatorRequests
+
+	// Prepare response.
+	stringPubKeys := make([]string, len(validatorRequests))
+	stringPubKeyToDoppelGangerInfo := make(map[string]doppelGangerInfo, len(validatorRequests))
+
+	for i, vr := range validatorRequests {
+		if vr == nil {
+			return nil, errors.New("validator request is nil")
+		}
+
+		pubKey := vr.PublicKey
+		stringPubKey := hexutil.Encode(pubKey)
+		stringPubKeys[i] = stringPubKey
+
+		stringPubKeyToDoppelGangerInfo[stringPubKey] = doppelGangerInfo{
+			validatorEpoch: vr.Epoch,
+			response: &ethpb.DoppelGangerResponse_ValidatorResponse{
+				PublicKey:       pubKey,
+				DuplicateExists: false,
+			},
+		}
+	}
+
+	// Check if the beacon node is synced, and retrieve the current epoch from its head slot.
+	syncingData, err := c.getSyncing(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get beacon node sync status")
+	}
+
+	if syncingData.IsSyncing {
+		return nil, errors.New("beacon node not synced")
+	}
+
+	headSlot, err := strconv.ParseUint(syncingData.HeadSlot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse head slot `%s`", syncingData.HeadSlot)
+	}
+
+	currentEpoch := slots.ToEpoch(types.Slot(headSlot))
+
+	// Return early if we are in phase0.
+	if currentEpoch < params.BeaconConfig().AltairForkEpoch {
+		log.Info("Skipping doppelganger check for Phase 0")
+		return buildDoppelGangerResponse(stringPubKeys, stringPubKeyToDoppelGangerInfo), nil
+	}
+
+	// Extract input pubkeys we did not validate for the 2 last epochs.
+	// If we detect onchain liveness for these keys during the 2 last epochs, a doppelganger may exist somewhere.
+	var notRecentStringPubKeys []string
+	for _, spk := range stringPubKeys {
+		dph := stringPubKeyToDoppelGangerInfo[spk]
+		if dph.validatorEpoch+2 < currentEpoch {
+			notRecentStringPubKeys = append(notRecentStringPubKeys, spk)
+		}
+	}
+
+	// If all provided keys are recent (aka `notRecentPubKeys` is empty) we return early
+	// as we are unable to effectively determine if a doppelganger is active.
+	if len(notRecentStringPubKeys) == 0 {
+		return buildDoppelGangerResponse(stringPubKeys, stringPubKeyToDoppelGangerInfo), nil
+	}
+
+	// Retrieve correspondence between validator pubkey and index.
+	stateValidators, err := c.stateValidatorsProvider.GetStateValidators(ctx, notRecentStringPubKeys, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get state validators")
+	}
+
+	validators := stateValidators.Data
+	indexes := make([]string, len(validators))
+	indexToStringPubKey := make(map[string]string, len(validators))
+
+	for i, v := range validators {
+		if v == nil {
+			return nil, errors.New("validator container is nil")
+		}
+
+		index := v.Index
+
+		if v.Validator == nil {
+			return nil, errors.New("validator is nil")
+		}
+
+		indexes[i] = index
+		indexToStringPubKey[index] = v.Validator.PublicKey
+	}
+
+	// Get validators liveness for the last epoch.
+	// We request a state 1 epoch ago. We are guaranteed to have currentEpoch > 2
+	// since we assume that we are not in phase0.
+	previousEpoch := currentEpoch - 1
+
+	indexToPreviousLiveness, err := c.getIndexToLiveness(ctx, previousEpoch, indexes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get map from validator index to liveness for previous epoch %d", previousEpoch)
+	}
+
+	// Get validators liveness for the current epoch.
+	indexToCurrentLiveness, err := c.getIndexToLiveness(ctx, currentEpoch, indexes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get map from validator index to liveness for current epoch %d", currentEpoch)
+	}
+
+	// Set `DuplicateExists` to `true` if needed.
+	for _, index := range indexes {
+		previousLiveness, ok := indexToPreviousLiveness[index]
+		if !ok {
+			return nil, errors.Errorf("failed to retrieve liveness for previous epoch `%d` for validator index `%s`", previousEpoch, index)
+		}
+
+		currentLiveness, ok := indexToCurrentLiveness[index]
+		if !ok {
+			return nil, errors.Errorf("failed to retrieve liveness for current epoch `%d` for validator index `%s`", currentEpoch, index)
+		}
+
+		if previousLiveness || currentLiveness {
+			stringPubKey, ok := indexToStringPubKey[index]
+			if !ok {
+				return nil, errors.Errorf("failed to retrieve public key for validator index `%s`", index)
+			}
+
+			info, ok := stringPubKeyToDoppelGangerInfo[stringPubKey]
+			if !ok {
+				return nil, errors.Errorf("failed to retrieve doppelganger info for public key `%s`", stringPubKey)
+			}
+
+			info.response.DuplicateExists = true
+		}
+	}
+
+	return buildDoppelGangerResponse(stringPubKeys, stringPubKeyToDoppelGangerInfo), nil
+}
+
+func buildDoppelGangerResponse(stringPubKeys []string, stringPubKeyToDoppelGangerInfo map[string]doppelGangerInfo) *ethpb.DoppelGangerResponse {
+	responses := make([]*ethpb.DoppelGangerResponse_ValidatorResponse, len(stringPubKeys))
+
+	for i, spk := range stringPubKeys {
+		responses[i] = stringPubKeyToDoppelGangerInfo[spk].response
+	}
+
+	return &ethpb.DoppelGangerResponse{
+		Responses: responses,
+	}
+}
+
+func (c *beaconApiValidatorClient) getIndexToLiveness(ctx context.Context, epoch types.Epoch, indexes []string) (map[string]bool, error) {
+	livenessResponse, err := c.getLiveness(ctx, epoch, indexes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get liveness for epoch %d", epoch)
+	}
+
+	if livenessResponse.Data == nil {
+		return nil, errors.Errorf("liveness data is nil for epoch %d", epoch)
+	}
+
+	indexToLiveness := make(map[string]bool, len(livenessResponse.Data))
+
+	for _, liveness := range livenessResponse.Data {
+		if liveness == nil {
+			return nil, errors.New("liveness is nil")
+		}
+
+		indexToLiveness[liveness.Index] = liveness.IsLive
+	}
+
+	return indexToLiveness, nil
+}
+
+func (c *beaconApiValidatorClient) getLiveness(ctx context.Context, epoch types.Epoch, validatorIndexes []string) (*apimiddleware.LivenessResponseJson, error) {
+	url := fmt.Sprintf("/eth/v1/validator/liveness/%d", epoch)
+
+	livenessResponseJson := &apimiddleware.LivenessResponseJson{}
+
+	marshalledJsonValidatorIndexes, err := json.Marshal(validatorIndexes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal validator indexes")
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, url, nil, bytes.NewBuffer(marshalledJsonValidatorIndexes), livenessResponseJson); err != nil {
+		return nil, errors.Wrapf(err, "failed to send POST data to `%s` REST URL", url)
+	}
+
+	return livenessResponseJson, nil
+}
@@ -0,0 +1,240 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api/mock"
+)
+
+type livenessJson = struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
+
+func TestCheckDoppelGanger_Nominal(t *testing.T) {
+	const stringPubKey1 = "0x80000e851c0f53c3246ff726d7ff7766661ca5e12a07c45c114d208d54f0f8233d4380b2e9aff759d69795d1df905526"
+	const stringPubKey2 = "0x80002662ef8d4d33a5ae3a7b4c6ce4a46dc7ce4883e54e3ee3b0c07d1e98d66d9bfbbd7d1da9a4a5fb3d5c0fa1d3fe1b"
+	const stringPubKey3 = "0x80003a1c67216514e4ab257738e59ef38063edf43bc4a2ef9d38633bdde117384401684c6cf81aa04cf18890e75ab52c"
+
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	pubKey1, err := hexutil.Decode(stringPubKey1)
+	require.NoError(t, err)
+	pubKey2, err := hexutil.Decode(stringPubKey2)
+	require.NoError(t, err)
+	pubKey3, err := hexutil.Decode(stringPubKey3)
+	require.NoError(t, err)
+
+	// Head slot 320 corresponds to epoch 10
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/node/syncing",
+		&apimiddleware.SyncingResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.SyncingResponseJson{Data: &helpers.SyncDetailsJson{HeadSlot: "320", IsSyncing: false}},
+	).Times(1)
+
+	// Only the keys which were not used during the 2 last epochs are checked
+	stateValidatorsProvider := mock.NewMockstateValidatorsProvider(ctrl)
+	stateValidatorsProvider.EXPECT().GetStateValidators(
+		ctx,
+		[]string{stringPubKey1, stringPubKey2},
+		nil,
+		nil,
+	).Return(
+		&apimiddleware.StateValidatorsResponseJson{
+			Data: []*apimiddleware.ValidatorContainerJson{
+				{Index: "1", Validator: &apimiddleware.ValidatorJson{PublicKey: stringPubKey1}},
+				{Index: "2", Validator: &apimiddleware.ValidatorJson{PublicKey: stringPubKey2}},
+			},
+		},
+		nil,
+	).Times(1)
+
+	marshalledIndexes, err := json.Marshal([]string{"1", "2"})
+	require.NoError(t, err)
+
+	jsonRestHandler.EXPECT().PostRestJson(
+		ctx,
+		"/eth/v1/validator/liveness/9",
+		nil,
+		bytes.NewBuffer(marshalledIndexes),
+		&apimiddleware.LivenessResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		4,
+		apimiddleware.LivenessResponseJson{
+			Data: []*livenessJson{
+				{Index: "1", IsLive: false},
+				{Index: "2", IsLive: false},
+			},
+		},
+	).Times(1)
+
+	jsonRestHandler.EXPECT().PostRestJson(
+		ctx,
+		"/eth/v1/validator/liveness/10",
+		nil,
+		bytes.NewBuffer(marshalledIndexes),
+		&apimiddleware.LivenessResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		4,
+		apimiddleware.LivenessResponseJson{
+			Data: []*livenessJson{
+				{Index: "1", IsLive: true},
+				{Index: "2", IsLive: false},
+			},
+		},
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{
+		jsonRestHandler:         jsonRestHandler,
+		stateValidatorsProvider: stateValidatorsProvider,
+	}
+
+	doppelGangerResponse, err := validatorClient.CheckDoppelGanger(ctx, &ethpb.DoppelGangerRequest{
+		ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{
+			{PublicKey: pubKey1, Epoch: 1},
+			{PublicKey: pubKey2, Epoch: 1},
+			{PublicKey: pubKey3, Epoch: 9},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.DeepEqual(t, &ethpb.DoppelGangerResponse{
+		Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{
+			{PublicKey: pubKey1, DuplicateExists: true},
+			{PublicKey: pubKey2, DuplicateExists: false},
+			{PublicKey: pubKey3, DuplicateExists: false},
+		},
+	}, doppelGangerResponse)
+}
+
+func TestCheckDoppelGanger_Phase0(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 100
+	params.OverrideBeaconConfig(cfg)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	pubKey, err := hexutil.Decode(stringPubKey)
+	require.NoError(t, err)
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/node/syncing",
+		&apimiddleware.SyncingResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.SyncingResponseJson{Data: &helpers.SyncDetailsJson{HeadSlot: "320"}},
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	doppelGangerResponse, err := validatorClient.CheckDoppelGanger(ctx, &ethpb.DoppelGangerRequest{
+		ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{{PublicKey: pubKey, Epoch: 1}},
+	})
+	require.NoError(t, err)
+
+	assert.DeepEqual(t, &ethpb.DoppelGangerResponse{
+		Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{{PublicKey: pubKey, DuplicateExists: false}},
+	}, doppelGangerResponse)
+}
+
+func TestCheckDoppelGanger_Errors(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		syncingResponse      *helpers.SyncDetailsJson
+		validatorRequests    []*ethpb.DoppelGangerRequest_ValidatorRequest
+		expectedErrorMessage string
+	}{
+		{
+			name:                 "nil validator request",
+			validatorRequests:    []*ethpb.DoppelGangerRequest_ValidatorRequest{nil},
+			expectedErrorMessage: "validator request is nil",
+		},
+		{
+			name:                 "beacon node syncing",
+			syncingResponse:      &helpers.SyncDetailsJson{HeadSlot: "320", IsSyncing: true},
+			validatorRequests:    []*ethpb.DoppelGangerRequest_ValidatorRequest{{Epoch: 1}},
+			expectedErrorMessage: "beacon node not synced",
+		},
+		{
+			name:                 "invalid head slot",
+			syncingResponse:      &helpers.SyncDetailsJson{HeadSlot: "foo"},
+			validatorRequests:    []*ethpb.DoppelGangerRequest_ValidatorRequest{{Epoch: 1}},
+			expectedErrorMessage: "failed to parse head slot `foo`",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+
+			jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+			if testCase.syncingResponse != nil {
+				jsonRestHandler.EXPECT().GetRestJsonResponse(
+					ctx,
+					"/eth/v1/node/syncing",
+					&apimiddleware.SyncingResponseJson{},
+				).Return(
+					nil,
+					nil,
+				).SetArg(
+					2,
+					apimiddleware.SyncingResponseJson{Data: testCase.syncingResponse},
+				).Times(1)
+			}
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+			_, err := validatorClient.CheckDoppelGanger(ctx, &ethpb.DoppelGangerRequest{ValidatorRequests: testCase.validatorRequests})
+			assert.ErrorContains(t, testCase.expectedErrorMessage, err)
+		})
+	}
+}
+
+func TestCheckDoppelGanger_EmptyRequest(t *testing.T) {
+	validatorClient := &beaconApiValidatorClient{}
+	doppelGangerResponse, err := validatorClient.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.DoppelGangerResponse{Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{}}, doppelGangerResponse)
+}
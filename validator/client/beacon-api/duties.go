@@ -0,0 +1,380 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	neturl "net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"google.golang.org/grpc"
+)
+
+type dutiesProvider interface {
+	GetAttesterDuties(ctx context.Context, epoch types.Epoch, validatorIndices []types.ValidatorIndex) ([]*apimiddleware.AttesterDutyJson, error)
+	GetProposerDuties(ctx context.Context, epoch types.Epoch) ([]*apimiddleware.ProposerDutyJson, error)
+	GetSyncDuties(ctx context.Context, epoch types.Epoch, validatorIndices []types.ValidatorIndex) ([]*apimiddleware.SyncCommitteeDuty, error)
+	GetCommittees(ctx context.Context, epoch types.Epoch) ([]*apimiddleware.CommitteeJson, error)
+}
+
+type beaconApiDutiesProvider struct {
+	jsonRestHandler jsonRestHandler
+}
+
+type committeeIndexSlotPair struct {
+	committeeIndex types.CommitteeIndex
+	slot           types.Slot
+}
+
+func (c beaconApiValidatorClient) getDuties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	if in == nil {
+		return nil, errors.New("duties request is nil")
+	}
+
+	multipleValidatorStatus, err := c.multipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{PublicKeys: in.PublicKeys})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validator status")
+	}
+
+	currentEpochDuties, err := c.getDutiesForEpoch(ctx, in.Epoch, multipleValidatorStatus, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get duties for current epoch `%d`", in.Epoch)
+	}
+
+	// The next epoch has no lookup for proposer duties, the same way as the gRPC implementation
+	nextEpochDuties, err := c.getDutiesForEpoch(ctx, in.Epoch+1, multipleValidatorStatus, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get duties for next epoch `%d`", in.Epoch+1)
+	}
+
+	return &ethpb.DutiesResponse{
+		Duties:             currentEpochDuties,
+		CurrentEpochDuties: currentEpochDuties,
+		NextEpochDuties:    nextEpochDuties,
+	}, nil
+}
+
+func (c beaconApiValidatorClient) getDutiesForEpoch(
+	ctx context.Context,
+	epoch types.Epoch,
+	multipleValidatorStatus *ethpb.MultipleValidatorStatusResponse,
+	fetchProposerDuties bool,
+) ([]*ethpb.DutiesResponse_Duty, error) {
+	// Only the validators known by the beacon node can have duties
+	knownValidatorIndices := make([]types.ValidatorIndex, 0, len(multipleValidatorStatus.Indices))
+	for index, validatorIndex := range multipleValidatorStatus.Indices {
+		if multipleValidatorStatus.Statuses[index].Status != ethpb.ValidatorStatus_UNKNOWN_STATUS {
+			knownValidatorIndices = append(knownValidatorIndices, validatorIndex)
+		}
+	}
+
+	attesterDutiesMap := make(map[types.ValidatorIndex]*apimiddleware.AttesterDutyJson)
+	proposerDutySlots := make(map[types.ValidatorIndex][]types.Slot)
+	syncDutiesMap := make(map[types.ValidatorIndex]struct{})
+	committeeMap := make(map[committeeIndexSlotPair][]types.ValidatorIndex)
+
+	if len(knownValidatorIndices) > 0 {
+		attesterDuties, err := c.dutiesProvider.GetAttesterDuties(ctx, epoch, knownValidatorIndices)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get attester duties for epoch `%d`", epoch)
+		}
+
+		for _, attesterDuty := range attesterDuties {
+			validatorIndex, err := strconv.ParseUint(attesterDuty.ValidatorIndex, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse attester validator index `%s`", attesterDuty.ValidatorIndex)
+			}
+			attesterDutiesMap[types.ValidatorIndex(validatorIndex)] = attesterDuty
+		}
+
+		if fetchProposerDuties {
+			proposerDuties, err := c.dutiesProvider.GetProposerDuties(ctx, epoch)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get proposer duties for epoch `%d`", epoch)
+			}
+
+			for _, proposerDuty := range proposerDuties {
+				validatorIndex, err := strconv.ParseUint(proposerDuty.ValidatorIndex, 10, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse proposer validator index `%s`", proposerDuty.ValidatorIndex)
+				}
+
+				slot, err := strconv.ParseUint(proposerDuty.Slot, 10, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse proposer slot `%s`", proposerDuty.Slot)
+				}
+
+				proposerDutySlots[types.ValidatorIndex(validatorIndex)] = append(proposerDutySlots[types.ValidatorIndex(validatorIndex)], types.Slot(slot))
+			}
+		}
+
+		// Sync committees only exist from Altair onwards
+		if epoch >= params.BeaconConfig().AltairForkEpoch {
+			syncDuties, err := c.dutiesProvider.GetSyncDuties(ctx, epoch, knownValidatorIndices)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get sync duties for epoch `%d`", epoch)
+			}
+
+			for _, syncDuty := range syncDuties {
+				validatorIndex, err := strconv.ParseUint(syncDuty.ValidatorIndex, 10, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse sync validator index `%s`", syncDuty.ValidatorIndex)
+				}
+				syncDutiesMap[types.ValidatorIndex(validatorIndex)] = struct{}{}
+			}
+		}
+
+		committees, err := c.dutiesProvider.GetCommittees(ctx, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get committees for epoch `%d`", epoch)
+		}
+
+		for _, committee := range committees {
+			committeeIndex, err := strconv.ParseUint(committee.Index, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse committee index `%s`", committee.Index)
+			}
+
+			slot, err := strconv.ParseUint(committee.Slot, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse committee slot `%s`", committee.Slot)
+			}
+
+			validatorIndices := make([]types.ValidatorIndex, len(committee.Validators))
+			for index, stringValidatorIndex := range committee.Validators {
+				validatorIndex, err := strconv.ParseUint(stringValidatorIndex, 10, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse committee validator index `%s`", stringValidatorIndex)
+				}
+				validatorIndices[index] = types.ValidatorIndex(validatorIndex)
+			}
+
+			key := committeeIndexSlotPair{
+				committeeIndex: types.CommitteeIndex(committeeIndex),
+				slot:           types.Slot(slot),
+			}
+			committeeMap[key] = validatorIndices
+		}
+	}
+
+	duties := make([]*ethpb.DutiesResponse_Duty, len(multipleValidatorStatus.Statuses))
+	for index, validatorStatus := range multipleValidatorStatus.Statuses {
+		pubkey := multipleValidatorStatus.PublicKeys[index]
+		duty := &ethpb.DutiesResponse_Duty{
+			PublicKey: pubkey,
+			Status:    validatorStatus.Status,
+		}
+
+		if validatorStatus.Status == ethpb.ValidatorStatus_UNKNOWN_STATUS {
+			duties[index] = duty
+			continue
+		}
+
+		validatorIndex := multipleValidatorStatus.Indices[index]
+		duty.ValidatorIndex = validatorIndex
+		duty.ProposerSlots = proposerDutySlots[validatorIndex]
+		_, duty.IsSyncCommittee = syncDutiesMap[validatorIndex]
+
+		if attesterDuty, ok := attesterDutiesMap[validatorIndex]; ok {
+			attesterSlot, err := strconv.ParseUint(attesterDuty.Slot, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse attester slot `%s`", attesterDuty.Slot)
+			}
+
+			committeeIndex, err := strconv.ParseUint(attesterDuty.CommitteeIndex, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse attester committee index `%s`", attesterDuty.CommitteeIndex)
+			}
+
+			key := committeeIndexSlotPair{
+				committeeIndex: types.CommitteeIndex(committeeIndex),
+				slot:           types.Slot(attesterSlot),
+			}
+
+			committee, ok := committeeMap[key]
+			if !ok {
+				return nil, errors.Errorf("failed to find committee with index `%d` for slot `%d`", committeeIndex, attesterSlot)
+			}
+
+			duty.AttesterSlot = types.Slot(attesterSlot)
+			duty.CommitteeIndex = types.CommitteeIndex(committeeIndex)
+			duty.Committee = committee
+		}
+
+		duties[index] = duty
+	}
+
+	return duties, nil
+}
+
+// GetAttesterDuties retrieves the attester duties for the given epoch and validatorIndices
+func (c beaconApiDutiesProvider) GetAttesterDuties(ctx context.Context, epoch types.Epoch, validatorIndices []types.ValidatorIndex) ([]*apimiddleware.AttesterDutyJson, error) {
+	jsonValidatorIndices := make([]string, len(validatorIndices))
+	for index, validatorIndex := range validatorIndices {
+		jsonValidatorIndices[index] = uint64ToString(validatorIndex)
+	}
+
+	validatorIndicesBytes, err := json.Marshal(jsonValidatorIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal validator indices")
+	}
+
+	attesterDuties := &apimiddleware.AttesterDutiesResponseJson{}
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch), nil, bytes.NewBuffer(validatorIndicesBytes), attesterDuties); err != nil {
+		return nil, errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	for index, attesterDuty := range attesterDuties.Data {
+		if attesterDuty == nil {
+			return nil, errors.Errorf("attester duty at index `%d` is nil", index)
+		}
+	}
+
+	return attesterDuties.Data, nil
+}
+
+// GetProposerDuties retrieves the proposer duties for the given epoch
+func (c beaconApiDutiesProvider) GetProposerDuties(ctx context.Context, epoch types.Epoch) ([]*apimiddleware.ProposerDutyJson, error) {
+	proposerDuties := apimiddleware.ProposerDutiesResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), &proposerDuties); err != nil {
+		return nil, errors.Wrap(err, "failed to query proposer duties for epoch")
+	}
+
+	if proposerDuties.Data == nil {
+		return nil, errors.New("proposer duties data is nil")
+	}
+
+	for index, proposerDuty := range proposerDuties.Data {
+		if proposerDuty == nil {
+			return nil, errors.Errorf("proposer duty at index `%d` is nil", index)
+		}
+	}
+
+	return proposerDuties.Data, nil
+}
+
+// GetSyncDuties retrieves the sync committee duties for the given epoch and validatorIndices
+func (c beaconApiDutiesProvider) GetSyncDuties(ctx context.Context, epoch types.Epoch, validatorIndices []types.ValidatorIndex) ([]*apimiddleware.SyncCommitteeDuty, error) {
+	jsonValidatorIndices := make([]string, len(validatorIndices))
+	for index, validatorIndex := range validatorIndices {
+		jsonValidatorIndices[index] = uint64ToString(validatorIndex)
+	}
+
+	validatorIndicesBytes, err := json.Marshal(jsonValidatorIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal validator indices")
+	}
+
+	syncDuties := apimiddleware.SyncCommitteeDutiesResponseJson{}
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, fmt.Sprintf("/eth/v1/validator/duties/sync/%d", epoch), nil, bytes.NewBuffer(validatorIndicesBytes), &syncDuties); err != nil {
+		return nil, errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	if syncDuties.Data == nil {
+		return nil, errors.New("sync duties data is nil")
+	}
+
+	for index, syncDuty := range syncDuties.Data {
+		if syncDuty == nil {
+			return nil, errors.Errorf("sync duty at index `%d` is nil", index)
+		}
+	}
+
+	return syncDuties.Data, nil
+}
+
+// GetCommittees retrieves the committees for the given epoch
+func (c beaconApiDutiesProvider) GetCommittees(ctx context.Context, epoch types.Epoch) ([]*apimiddleware.CommitteeJson, error) {
+	committeeParams := neturl.Values{}
+	committeeParams.Add("epoch", uint64ToString(epoch))
+	committeesRequest := buildURL("/eth/v1/beacon/states/head/committees", committeeParams)
+
+	var stateCommittees apimiddleware.StateCommitteesResponseJson
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, committeesRequest, &stateCommittees); err != nil {
+		return nil, errors.Wrapf(err, "failed to query committees for epoch `%d`", epoch)
+	}
+
+	if stateCommittees.Data == nil {
+		return nil, errors.New("state committees data is nil")
+	}
+
+	for index, committee := range stateCommittees.Data {
+		if committee == nil {
+			return nil, errors.Errorf("committee at index `%d` is nil", index)
+		}
+	}
+
+	return stateCommittees.Data, nil
+}
+
+func (c beaconApiValidatorClient) streamDuties(ctx context.Context, in *ethpb.DutiesRequest) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	return &streamDutiesClient{
+		ctx:                      ctx,
+		beaconApiValidatorClient: c,
+		dutiesRequest:            in,
+	}, nil
+}
+
+// streamDutiesClient emulates the StreamDuties gRPC stream by polling the duties of the current epoch
+// from the Beacon API every time a new epoch starts.
+type streamDutiesClient struct {
+	grpc.ClientStream
+	ctx context.Context
+	beaconApiValidatorClient
+	dutiesRequest *ethpb.DutiesRequest
+	genesisTime   uint64
+	lastRecvEpoch *types.Epoch
+}
+
+func (c *streamDutiesClient) Recv() (*ethpb.DutiesResponse, error) {
+	if c.genesisTime == 0 {
+		genesis, _, err := c.genesisProvider.GetGenesis(c.ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get genesis")
+		}
+
+		genesisTime, err := strconv.ParseUint(genesis.GenesisTime, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse genesis time: %s", genesis.GenesisTime)
+		}
+		c.genesisTime = genesisTime
+	}
+
+	currentEpoch := slots.EpochsSinceGenesis(time.Unix(int64(c.genesisTime), 0))
+
+	// After the first response, wait until the beginning of the next epoch to send the new duties
+	if c.lastRecvEpoch != nil && currentEpoch <= *c.lastRecvEpoch {
+		nextEpochStartSlot, err := slots.EpochStart(*c.lastRecvEpoch + 1)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get next epoch start slot")
+		}
+
+		select {
+		case <-time.After(time.Until(slots.StartTime(c.genesisTime, nextEpochStartSlot))):
+			currentEpoch = *c.lastRecvEpoch + 1
+		case <-c.ctx.Done():
+			return nil, errors.New("context canceled")
+		}
+	}
+
+	dutiesRequest := &ethpb.DutiesRequest{
+		Epoch:      currentEpoch,
+		PublicKeys: c.dutiesRequest.PublicKeys,
+	}
+
+	duties, err := c.getDuties(c.ctx, dutiesRequest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get duties for epoch `%d`", currentEpoch)
+	}
+
+	c.lastRecvEpoch = &currentEpoch
+	return duties, nil
+}
@@ -0,0 +1,365 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api/mock"
+)
+
+const getAttesterDutiesTestEndpoint = "/eth/v1/validator/duties/attester"
+const getProposerDutiesTestEndpoint = "/eth/v1/validator/duties/proposer"
+const getSyncDutiesTestEndpoint = "/eth/v1/validator/duties/sync"
+const getCommitteesTestEndpoint = "/eth/v1/beacon/states/head/committees"
+
+func TestGetAttesterDuties_Valid(t *testing.T) {
+	const epoch = types.Epoch(1)
+	validatorIndices := []types.ValidatorIndex{2, 9}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validatorIndicesBytes, err := json.Marshal([]string{"2", "9"})
+	require.NoError(t, err)
+
+	expectedAttesterDuties := apimiddleware.AttesterDutiesResponseJson{
+		Data: []*apimiddleware.AttesterDutyJson{
+			{
+				Pubkey:                  hexutil.Encode([]byte{1}),
+				ValidatorIndex:          "2",
+				CommitteeIndex:          "3",
+				CommitteeLength:         "4",
+				CommitteesAtSlot:        "5",
+				ValidatorCommitteeIndex: "6",
+				Slot:                    "7",
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostRestJson(
+		ctx,
+		fmt.Sprintf("%s/%d", getAttesterDutiesTestEndpoint, epoch),
+		nil,
+		bytes.NewBuffer(validatorIndicesBytes),
+		&apimiddleware.AttesterDutiesResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		4,
+		expectedAttesterDuties,
+	).Times(1)
+
+	dutiesProvider := &beaconApiDutiesProvider{jsonRestHandler: jsonRestHandler}
+	attesterDuties, err := dutiesProvider.GetAttesterDuties(ctx, epoch, validatorIndices)
+	require.NoError(t, err)
+	assert.DeepEqual(t, expectedAttesterDuties.Data, attesterDuties)
+}
+
+func TestGetAttesterDuties_HttpError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostRestJson(
+		ctx,
+		fmt.Sprintf("%s/%d", getAttesterDutiesTestEndpoint, 1),
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(
+		nil,
+		errors.New("foo error"),
+	).Times(1)
+
+	dutiesProvider := &beaconApiDutiesProvider{jsonRestHandler: jsonRestHandler}
+	_, err := dutiesProvider.GetAttesterDuties(ctx, 1, nil)
+	assert.ErrorContains(t, "foo error", err)
+	assert.ErrorContains(t, "failed to send POST data to REST endpoint", err)
+}
+
+func TestGetProposerDuties_NilData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		fmt.Sprintf("%s/%d", getProposerDutiesTestEndpoint, 1),
+		&apimiddleware.ProposerDutiesResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.ProposerDutiesResponseJson{Data: nil},
+	).Times(1)
+
+	dutiesProvider := &beaconApiDutiesProvider{jsonRestHandler: jsonRestHandler}
+	_, err := dutiesProvider.GetProposerDuties(ctx, 1)
+	assert.ErrorContains(t, "proposer duties data is nil", err)
+}
+
+func TestGetSyncDuties_NilSyncDuty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostRestJson(
+		ctx,
+		fmt.Sprintf("%s/%d", getSyncDutiesTestEndpoint, 1),
+		nil,
+		gomock.Any(),
+		&apimiddleware.SyncCommitteeDutiesResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		4,
+		apimiddleware.SyncCommitteeDutiesResponseJson{Data: []*apimiddleware.SyncCommitteeDuty{nil}},
+	).Times(1)
+
+	dutiesProvider := &beaconApiDutiesProvider{jsonRestHandler: jsonRestHandler}
+	_, err := dutiesProvider.GetSyncDuties(ctx, 1, []types.ValidatorIndex{1})
+	assert.ErrorContains(t, "sync duty at index `0` is nil", err)
+}
+
+func TestGetCommittees_Valid(t *testing.T) {
+	const epoch = types.Epoch(1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedCommittees := apimiddleware.StateCommitteesResponseJson{
+		Data: []*apimiddleware.CommitteeJson{
+			{
+				Index:      "1",
+				Slot:       "2",
+				Validators: []string{"3", "4"},
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		fmt.Sprintf("%s?epoch=%d", getCommitteesTestEndpoint, epoch),
+		&apimiddleware.StateCommitteesResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		expectedCommittees,
+	).Times(1)
+
+	dutiesProvider := &beaconApiDutiesProvider{jsonRestHandler: jsonRestHandler}
+	committees, err := dutiesProvider.GetCommittees(ctx, epoch)
+	require.NoError(t, err)
+	assert.DeepEqual(t, expectedCommittees.Data, committees)
+}
+
+func TestGetDuties_Valid(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	const epoch = types.Epoch(1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	knownPubKey, err := hexutil.Decode(stringPubKey)
+	require.NoError(t, err)
+	unknownPubKey := make([]byte, 48)
+
+	stateValidatorsProvider := mock.NewMockstateValidatorsProvider(ctrl)
+	stateValidatorsProvider.EXPECT().GetStateValidators(
+		ctx,
+		[]string{stringPubKey, hexutil.Encode(unknownPubKey)},
+		nil,
+		nil,
+	).Return(
+		&apimiddleware.StateValidatorsResponseJson{
+			Data: []*apimiddleware.ValidatorContainerJson{
+				{
+					Index:  "5",
+					Status: "active_ongoing",
+					Validator: &apimiddleware.ValidatorJson{
+						PublicKey:       stringPubKey,
+						ActivationEpoch: "0",
+					},
+				},
+			},
+		},
+		nil,
+	).Times(1)
+
+	dutiesProvider := mock.NewMockdutiesProvider(ctrl)
+
+	// Current epoch
+	dutiesProvider.EXPECT().GetAttesterDuties(ctx, epoch, []types.ValidatorIndex{5}).Return(
+		[]*apimiddleware.AttesterDutyJson{{ValidatorIndex: "5", CommitteeIndex: "2", Slot: "40"}},
+		nil,
+	).Times(1)
+	dutiesProvider.EXPECT().GetProposerDuties(ctx, epoch).Return(
+		[]*apimiddleware.ProposerDutyJson{
+			{ValidatorIndex: "5", Slot: "33"},
+			{ValidatorIndex: "6", Slot: "34"},
+			{ValidatorIndex: "5", Slot: "35"},
+		},
+		nil,
+	).Times(1)
+	dutiesProvider.EXPECT().GetSyncDuties(ctx, epoch, []types.ValidatorIndex{5}).Return(
+		[]*apimiddleware.SyncCommitteeDuty{{ValidatorIndex: "5"}},
+		nil,
+	).Times(1)
+	dutiesProvider.EXPECT().GetCommittees(ctx, epoch).Return(
+		[]*apimiddleware.CommitteeJson{{Index: "2", Slot: "40", Validators: []string{"4", "5"}}},
+		nil,
+	).Times(1)
+
+	// Next epoch
+	dutiesProvider.EXPECT().GetAttesterDuties(ctx, epoch+1, []types.ValidatorIndex{5}).Return(
+		[]*apimiddleware.AttesterDutyJson{{ValidatorIndex: "5", CommitteeIndex: "1", Slot: "70"}},
+		nil,
+	).Times(1)
+	dutiesProvider.EXPECT().GetSyncDuties(ctx, epoch+1, []types.ValidatorIndex{5}).Return(
+		[]*apimiddleware.SyncCommitteeDuty{},
+		nil,
+	).Times(1)
+	dutiesProvider.EXPECT().GetCommittees(ctx, epoch+1).Return(
+		[]*apimiddleware.CommitteeJson{{Index: "1", Slot: "70", Validators: []string{"5", "7"}}},
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{
+		stateValidatorsProvider: stateValidatorsProvider,
+		dutiesProvider:          dutiesProvider,
+	}
+
+	duties, err := validatorClient.GetDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:      epoch,
+		PublicKeys: [][]byte{knownPubKey, unknownPubKey},
+	})
+	require.NoError(t, err)
+
+	expectedCurrentEpochDuties := []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:       knownPubKey,
+			ValidatorIndex:  5,
+			Status:          ethpb.ValidatorStatus_ACTIVE,
+			AttesterSlot:    40,
+			CommitteeIndex:  2,
+			Committee:       []types.ValidatorIndex{4, 5},
+			ProposerSlots:   []types.Slot{33, 35},
+			IsSyncCommittee: true,
+		},
+		{
+			PublicKey: unknownPubKey,
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		},
+	}
+
+	expectedNextEpochDuties := []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      knownPubKey,
+			ValidatorIndex: 5,
+			Status:         ethpb.ValidatorStatus_ACTIVE,
+			AttesterSlot:   70,
+			CommitteeIndex: 1,
+			Committee:      []types.ValidatorIndex{5, 7},
+		},
+		{
+			PublicKey: unknownPubKey,
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		},
+	}
+
+	assert.DeepEqual(t, expectedCurrentEpochDuties, duties.Duties)
+	assert.DeepEqual(t, expectedCurrentEpochDuties, duties.CurrentEpochDuties)
+	assert.DeepEqual(t, expectedNextEpochDuties, duties.NextEpochDuties)
+}
+
+func TestGetDuties_MissingCommittee(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 10
+	params.OverrideBeaconConfig(cfg)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	knownPubKey, err := hexutil.Decode(stringPubKey)
+	require.NoError(t, err)
+
+	stateValidatorsProvider := mock.NewMockstateValidatorsProvider(ctrl)
+	stateValidatorsProvider.EXPECT().GetStateValidators(ctx, gomock.Any(), nil, nil).Return(
+		&apimiddleware.StateValidatorsResponseJson{
+			Data: []*apimiddleware.ValidatorContainerJson{
+				{
+					Index:  "5",
+					Status: "active_ongoing",
+					Validator: &apimiddleware.ValidatorJson{
+						PublicKey:       stringPubKey,
+						ActivationEpoch: "0",
+					},
+				},
+			},
+		},
+		nil,
+	).Times(1)
+
+	dutiesProvider := mock.NewMockdutiesProvider(ctrl)
+	dutiesProvider.EXPECT().GetAttesterDuties(ctx, types.Epoch(1), []types.ValidatorIndex{5}).Return(
+		[]*apimiddleware.AttesterDutyJson{{ValidatorIndex: "5", CommitteeIndex: "2", Slot: "40"}},
+		nil,
+	).Times(1)
+	dutiesProvider.EXPECT().GetProposerDuties(ctx, types.Epoch(1)).Return(
+		[]*apimiddleware.ProposerDutyJson{},
+		nil,
+	).Times(1)
+	dutiesProvider.EXPECT().GetCommittees(ctx, types.Epoch(1)).Return(
+		[]*apimiddleware.CommitteeJson{},
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{
+		stateValidatorsProvider: stateValidatorsProvider,
+		dutiesProvider:          dutiesProvider,
+	}
+
+	_, err = validatorClient.GetDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:      1,
+		PublicKeys: [][]byte{knownPubKey},
+	})
+	assert.ErrorContains(t, "failed to find committee with index `2` for slot `40`", err)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/pkg/errors"
@@ -15,6 +16,16 @@ type jsonRestHandler interface {
 	PostRestJson(ctx context.Context, apiEndpoint string, headers map[string]string, data *bytes.Buffer, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error)
 }
 
+// HttpError is returned when the beacon node answers a request with an HTTP error status.
+type HttpError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *HttpError) Error() string {
+	return fmt.Sprintf("error %d: %s", e.StatusCode, e.Message)
+}
+
 type beaconApiJsonRestHandler struct {
 	httpClient http.Client
 	host       string
@@ -87,10 +98,11 @@ func decodeJsonResp(resp *http.Response, responseJson interface{}) (*apimiddlewa
 	if resp.StatusCode != http.StatusOK {
 		errorJson := &apimiddleware.DefaultErrorJson{}
 		if err := decoder.Decode(errorJson); err != nil {
-			return nil, errors.Wrapf(err, "failed to decode error json for %s", resp.Request.URL)
+			httpErr := &HttpError{StatusCode: resp.StatusCode, Message: err.Error()}
+			return nil, errors.Wrapf(httpErr, "failed to decode error json for %s", resp.Request.URL)
 		}
 
-		return errorJson, errors.Errorf("error %d: %s", errorJson.Code, errorJson.Message)
+		return errorJson, &HttpError{StatusCode: resp.StatusCode, Message: errorJson.Message}
 	}
 
 	if responseJson != nil {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -13,6 +14,21 @@ import (
 )
 
 func (c *beaconApiValidatorClient) prepareBeaconProposer(ctx context.Context, recipients []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer) error {
+	// The standard endpoint has no way to choose the relays of a validator, so the Prysm endpoint is tried first.
+	err := c.prepareBeaconProposerWithRelays(ctx, recipients)
+	if err == nil {
+		return nil
+	}
+	httpErr := &HttpError{}
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusNotFound {
+		return err
+	}
+	for _, recipient := range recipients {
+		if len(recipient.Relays) > 0 {
+			return errors.Wrap(err, "builder relays are configured but the beacon node does not support choosing them")
+		}
+	}
+
 	jsonRecipients := make([]*apimiddleware.FeeRecipientJson, len(recipients))
 	for index, recipient := range recipients {
 		jsonRecipients[index] = &apimiddleware.FeeRecipientJson{
@@ -32,3 +48,25 @@ func (c *beaconApiValidatorClient) prepareBeaconProposer(ctx context.Context, re
 
 	return nil
 }
+
+func (c *beaconApiValidatorClient) prepareBeaconProposerWithRelays(ctx context.Context, recipients []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer) error {
+	jsonRecipients := make([]*apimiddleware.FeeRecipientWithRelaysJson, len(recipients))
+	for index, recipient := range recipients {
+		jsonRecipients[index] = &apimiddleware.FeeRecipientWithRelaysJson{
+			FeeRecipient:   hexutil.Encode(recipient.FeeRecipient),
+			ValidatorIndex: strconv.FormatUint(uint64(recipient.ValidatorIndex), 10),
+			Relays:         recipient.Relays,
+		}
+	}
+
+	marshalledJsonRecipients, err := json.Marshal(jsonRecipients)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal recipients")
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, "/prysm/v1/validator/prepare_beacon_proposer", nil, bytes.NewBuffer(marshalledJsonRecipients), nil); err != nil {
+		return errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -17,6 +18,7 @@ import (
 )
 
 const prepareBeaconProposerTestEndpoint = "/eth/v1/validator/prepare_beacon_proposer"
+const prepareBeaconProposerWithRelaysTestEndpoint = "/prysm/v1/validator/prepare_beacon_proposer"
 
 func TestPrepareBeaconProposer_Valid(t *testing.T) {
 	const feeRecipient1 = "0xca008b199c03a2a2f6bc2ed52d6404c4d8510b35"
@@ -28,10 +30,11 @@ func TestPrepareBeaconProposer_Valid(t *testing.T) {
 
 	ctx := context.Background()
 
-	jsonRecipients := []*apimiddleware.FeeRecipientJson{
+	jsonRecipients := []*apimiddleware.FeeRecipientWithRelaysJson{
 		{
 			ValidatorIndex: "1",
 			FeeRecipient:   feeRecipient1,
+			Relays:         []string{"https://relay-a.example.com"},
 		},
 		{
 			ValidatorIndex: "2",
@@ -49,7 +52,7 @@ func TestPrepareBeaconProposer_Valid(t *testing.T) {
 	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
 	jsonRestHandler.EXPECT().PostRestJson(
 		ctx,
-		prepareBeaconProposerTestEndpoint,
+		prepareBeaconProposerWithRelaysTestEndpoint,
 		nil,
 		bytes.NewBuffer(marshalledJsonRecipients),
 		nil,
@@ -69,6 +72,7 @@ func TestPrepareBeaconProposer_Valid(t *testing.T) {
 		{
 			ValidatorIndex: 1,
 			FeeRecipient:   decodedFeeRecipient1,
+			Relays:         []string{"https://relay-a.example.com"},
 		},
 		{
 			ValidatorIndex: 2,
@@ -85,17 +89,78 @@ func TestPrepareBeaconProposer_Valid(t *testing.T) {
 	require.NoError(t, err)
 }
 
-func TestPrepareBeaconProposer_BadRequest(t *testing.T) {
+func TestPrepareBeaconProposer_RelaysNotSupported(t *testing.T) {
+	const feeRecipient = "0xca008b199c03a2a2f6bc2ed52d6404c4d8510b35"
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	ctx := context.Background()
 
+	jsonRecipients := []*apimiddleware.FeeRecipientJson{
+		{
+			ValidatorIndex: "1",
+			FeeRecipient:   feeRecipient,
+		},
+	}
+
+	marshalledJsonRecipients, err := json.Marshal(jsonRecipients)
+	require.NoError(t, err)
+
 	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostRestJson(
+		ctx,
+		prepareBeaconProposerWithRelaysTestEndpoint,
+		nil,
+		gomock.Any(),
+		nil,
+	).Return(
+		nil,
+		&HttpError{StatusCode: http.StatusNotFound, Message: "Not Found"},
+	).Times(2)
 	jsonRestHandler.EXPECT().PostRestJson(
 		ctx,
 		prepareBeaconProposerTestEndpoint,
 		nil,
+		bytes.NewBuffer(marshalledJsonRecipients),
+		nil,
+	).Return(
+		nil,
+		nil,
+	).Times(1)
+
+	decodedFeeRecipient, err := hexutil.Decode(feeRecipient)
+	require.NoError(t, err)
+
+	protoRecipients := []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+		{
+			ValidatorIndex: 1,
+			FeeRecipient:   decodedFeeRecipient,
+		},
+	}
+
+	// Without relays, the standard endpoint is used instead.
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	err = validatorClient.prepareBeaconProposer(ctx, protoRecipients)
+	require.NoError(t, err)
+
+	// With relays, the fee recipients are not sent without them.
+	protoRecipients[0].Relays = []string{"https://relay-a.example.com"}
+	err = validatorClient.prepareBeaconProposer(ctx, protoRecipients)
+	assert.ErrorContains(t, "builder relays are configured but the beacon node does not support choosing them", err)
+}
+
+func TestPrepareBeaconProposer_BadRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostRestJson(
+		ctx,
+		prepareBeaconProposerWithRelaysTestEndpoint,
+		nil,
 		gomock.Any(),
 		nil,
 	).Return(
@@ -0,0 +1,200 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+type abstractSignedBlockResponseJson struct {
+	Version             string          `json:"version" enum:"true"`
+	ExecutionOptimistic bool            `json:"execution_optimistic"`
+	Finalized           bool            `json:"finalized"`
+	Data                json.RawMessage `json:"data"`
+}
+
+// streamBlocksAltairClient emulates the StreamBlocksAltair gRPC stream by polling the head block
+// from the Beacon API until a block with a slot higher than the previously received one is found.
+type streamBlocksAltairClient struct {
+	grpc.ClientStream
+	ctx                      context.Context
+	beaconApiValidatorClient *beaconApiValidatorClient
+	prevBlockSlot            types.Slot
+	pingDelay                time.Duration
+}
+
+type headSignedBeaconBlockResult struct {
+	streamBlocksResponse *ethpb.StreamBlocksResponse
+	slot                 types.Slot
+}
+
+// streamBlocks only returns blocks that have been verified by the beacon node, since the head block is always verified.
+func (c *beaconApiValidatorClient) streamBlocks(ctx context.Context, pingDelay time.Duration) ethpb.BeaconNodeValidator_StreamBlocksAltairClient {
+	return &streamBlocksAltairClient{
+		ctx:                      ctx,
+		beaconApiValidatorClient: c,
+		pingDelay:                pingDelay,
+	}
+}
+
+func (c *streamBlocksAltairClient) Recv() (*ethpb.StreamBlocksResponse, error) {
+	result, err := c.beaconApiValidatorClient.getHeadSignedBeaconBlock(c.ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest signed block")
+	}
+
+	// We keep querying the beacon chain for the latest block until we receive a new slot
+	for c.prevBlockSlot == result.slot {
+		select {
+		case <-time.After(c.pingDelay):
+			result, err = c.beaconApiValidatorClient.getHeadSignedBeaconBlock(c.ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get latest signed block")
+			}
+		case <-c.ctx.Done():
+			return nil, errors.New("context canceled")
+		}
+	}
+
+	c.prevBlockSlot = result.slot
+	return result.streamBlocksResponse, nil
+}
+
+func (c *beaconApiValidatorClient) getHeadSignedBeaconBlock(ctx context.Context) (*headSignedBeaconBlockResult, error) {
+	// Since we don't know yet what the json looks like, we unmarshal into an abstract structure that has only a version
+	// and a blob of data
+	signedBlockResponseJson := abstractSignedBlockResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, "/eth/v2/beacon/blocks/head", &signedBlockResponseJson); err != nil {
+		return nil, errors.Wrap(err, "failed to query GET REST endpoint")
+	}
+
+	// Once we know what the consensus version is, we can go ahead and unmarshal into the specific structs unique to each version
+	decoder := json.NewDecoder(bytes.NewReader(signedBlockResponseJson.Data))
+	decoder.DisallowUnknownFields()
+
+	response := &ethpb.StreamBlocksResponse{}
+	var slot types.Slot
+
+	switch signedBlockResponseJson.Version {
+	case "phase0":
+		jsonPhase0Block := apimiddleware.SignedBeaconBlockContainerJson{}
+		if err := decoder.Decode(&jsonPhase0Block); err != nil {
+			return nil, errors.Wrap(err, "failed to decode signed phase0 block response json")
+		}
+
+		if jsonPhase0Block.Message == nil {
+			return nil, errors.New("signed phase0 block message is nil")
+		}
+
+		phase0Block, err := convertRESTPhase0BlockToProto(jsonPhase0Block.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get signed phase0 block")
+		}
+
+		decodedSignature, err := hexutil.Decode(jsonPhase0Block.Signature)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode phase0 block signature `%s`", jsonPhase0Block.Signature)
+		}
+
+		response.Block = &ethpb.StreamBlocksResponse_Phase0Block{
+			Phase0Block: &ethpb.SignedBeaconBlock{
+				Block:     phase0Block.Phase0,
+				Signature: decodedSignature,
+			},
+		}
+
+		slot = phase0Block.Phase0.Slot
+
+	case "altair":
+		jsonAltairBlock := apimiddleware.SignedBeaconBlockAltairContainerJson{}
+		if err := decoder.Decode(&jsonAltairBlock); err != nil {
+			return nil, errors.Wrap(err, "failed to decode signed altair block response json")
+		}
+
+		if jsonAltairBlock.Message == nil {
+			return nil, errors.New("signed altair block message is nil")
+		}
+
+		altairBlock, err := convertRESTAltairBlockToProto(jsonAltairBlock.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get signed altair block")
+		}
+
+		decodedSignature, err := hexutil.Decode(jsonAltairBlock.Signature)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode altair block signature `%s`", jsonAltairBlock.Signature)
+		}
+
+		response.Block = &ethpb.StreamBlocksResponse_AltairBlock{
+			AltairBlock: &ethpb.SignedBeaconBlockAltair{
+				Block:     altairBlock.Altair,
+				Signature: decodedSignature,
+			},
+		}
+
+		slot = altairBlock.Altair.Slot
+
+	case "bellatrix":
+		jsonBellatrixBlock := apimiddleware.SignedBeaconBlockBellatrixContainerJson{}
+		if err := decoder.Decode(&jsonBellatrixBlock); err != nil {
+			return nil, errors.Wrap(err, "failed to decode signed bellatrix block response json")
+		}
+
+		if jsonBellatrixBlock.Message == nil {
+			return nil, errors.New("signed bellatrix block message is nil")
+		}
+
+		bellatrixBlock, err := convertRESTBellatrixBlockToProto(jsonBellatrixBlock.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get signed bellatrix block")
+		}
+
+		decodedSignature, err := hexutil.Decode(jsonBellatrixBlock.Signature)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode bellatrix block signature `%s`", jsonBellatrixBlock.Signature)
+		}
+
+		response.Block = &ethpb.StreamBlocksResponse_BellatrixBlock{
+			BellatrixBlock: &ethpb.SignedBeaconBlockBellatrix{
+				Block:     bellatrixBlock.Bellatrix,
+				Signature: decodedSignature,
+			},
+		}
+
+		slot = bellatrixBlock.Bellatrix.Slot
+
+	case "capella":
+		jsonCapellaBlock := apimiddleware.SignedBeaconBlockCapellaContainerJson{}
+		if err := decoder.Decode(&jsonCapellaBlock); err != nil {
+			return nil, errors.Wrap(err, "failed to decode signed capella block response json")
+		}
+
+		if jsonCapellaBlock.Message == nil {
+			return nil, errors.New("signed capella block message is nil")
+		}
+
+		capellaBlock, err := convertRESTCapellaBlockToProto(jsonCapellaBlock.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get signed capella block")
+		}
+
+		// StreamBlocksResponse has no capella variant, so only the slot is reported, the same way as the gRPC implementation
+		slot = capellaBlock.Capella.Slot
+
+	default:
+		return nil, errors.Errorf("unsupported consensus version `%s`", signedBlockResponseJson.Version)
+	}
+
+	return &headSignedBeaconBlockResult{
+		streamBlocksResponse: response,
+		slot:                 slot,
+	}, nil
+}
@@ -0,0 +1,169 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api/mock"
+	test_helpers "github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api/test-helpers"
+)
+
+func TestStreamBlocks_Phase0Valid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	signature := test_helpers.FillByteSlice(96, 110)
+
+	// First block
+	jsonBlock1 := test_helpers.GenerateJsonPhase0BeaconBlock()
+	jsonBlock1.Slot = "1"
+	marshalledBlock1, err := json.Marshal(apimiddleware.SignedBeaconBlockContainerJson{
+		Message:   jsonBlock1,
+		Signature: test_helpers.FillEncodedByteSlice(96, 110),
+	})
+	require.NoError(t, err)
+
+	// Second block, which is only returned once the head moves past the first one
+	jsonBlock2 := test_helpers.GenerateJsonPhase0BeaconBlock()
+	jsonBlock2.Slot = "2"
+	marshalledBlock2, err := json.Marshal(apimiddleware.SignedBeaconBlockContainerJson{
+		Message:   jsonBlock2,
+		Signature: test_helpers.FillEncodedByteSlice(96, 110),
+	})
+	require.NoError(t, err)
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	gomock.InOrder(
+		jsonRestHandler.EXPECT().GetRestJsonResponse(
+			ctx,
+			"/eth/v2/beacon/blocks/head",
+			&abstractSignedBlockResponseJson{},
+		).Return(
+			nil,
+			nil,
+		).SetArg(
+			2,
+			abstractSignedBlockResponseJson{Version: "phase0", Data: marshalledBlock1},
+		).Times(2),
+		jsonRestHandler.EXPECT().GetRestJsonResponse(
+			ctx,
+			"/eth/v2/beacon/blocks/head",
+			&abstractSignedBlockResponseJson{},
+		).Return(
+			nil,
+			nil,
+		).SetArg(
+			2,
+			abstractSignedBlockResponseJson{Version: "phase0", Data: marshalledBlock2},
+		).Times(1),
+	)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	streamBlocksClient := validatorClient.streamBlocks(ctx, time.Millisecond)
+
+	expectedBlock1 := test_helpers.GenerateProtoPhase0BeaconBlock()
+	expectedBlock1.Slot = 1
+
+	streamBlocksResponse1, err := streamBlocksClient.Recv()
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.StreamBlocksResponse{
+		Block: &ethpb.StreamBlocksResponse_Phase0Block{
+			Phase0Block: &ethpb.SignedBeaconBlock{
+				Block:     expectedBlock1,
+				Signature: signature,
+			},
+		},
+	}, streamBlocksResponse1)
+
+	expectedBlock2 := test_helpers.GenerateProtoPhase0BeaconBlock()
+	expectedBlock2.Slot = 2
+
+	streamBlocksResponse2, err := streamBlocksClient.Recv()
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.StreamBlocksResponse{
+		Block: &ethpb.StreamBlocksResponse_Phase0Block{
+			Phase0Block: &ethpb.SignedBeaconBlock{
+				Block:     expectedBlock2,
+				Signature: signature,
+			},
+		},
+	}, streamBlocksResponse2)
+}
+
+func TestStreamBlocks_Error(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		signedBlockResponse  abstractSignedBlockResponseJson
+		restError            error
+		expectedErrorMessage string
+	}{
+		{
+			name:                 "query failed",
+			restError:            errors.New("foo error"),
+			expectedErrorMessage: "failed to query GET REST endpoint: foo error",
+		},
+		{
+			name:                 "unsupported version",
+			signedBlockResponse:  abstractSignedBlockResponseJson{Version: "foo"},
+			expectedErrorMessage: "unsupported consensus version `foo`",
+		},
+		{
+			name:                 "nil phase0 message",
+			signedBlockResponse:  abstractSignedBlockResponseJson{Version: "phase0", Data: []byte(`{}`)},
+			expectedErrorMessage: "signed phase0 block message is nil",
+		},
+		{
+			name:                 "nil altair message",
+			signedBlockResponse:  abstractSignedBlockResponseJson{Version: "altair", Data: []byte(`{}`)},
+			expectedErrorMessage: "signed altair block message is nil",
+		},
+		{
+			name:                 "nil bellatrix message",
+			signedBlockResponse:  abstractSignedBlockResponseJson{Version: "bellatrix", Data: []byte(`{}`)},
+			expectedErrorMessage: "signed bellatrix block message is nil",
+		},
+		{
+			name:                 "nil capella message",
+			signedBlockResponse:  abstractSignedBlockResponseJson{Version: "capella", Data: []byte(`{}`)},
+			expectedErrorMessage: "signed capella block message is nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+
+			jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+			jsonRestHandler.EXPECT().GetRestJsonResponse(
+				ctx,
+				"/eth/v2/beacon/blocks/head",
+				&abstractSignedBlockResponseJson{},
+			).Return(
+				nil,
+				testCase.restError,
+			).SetArg(
+				2,
+				testCase.signedBlockResponse,
+			).Times(1)
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+			streamBlocksClient := validatorClient.streamBlocks(ctx, time.Millisecond)
+			_, err := streamBlocksClient.Recv()
+			assert.ErrorContains(t, "failed to get latest signed block", err)
+			assert.ErrorContains(t, testCase.expectedErrorMessage, err)
+		})
+	}
+}
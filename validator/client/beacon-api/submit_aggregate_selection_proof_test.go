@@ -0,0 +1,229 @@
+package beacon_api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/eth/helpers"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api/mock"
+	test_helpers "github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api/test-helpers"
+)
+
+func TestSubmitAggregateSelectionProof(t *testing.T) {
+	const slot = types.Slot(123)
+	const committeeIndex = types.CommitteeIndex(1)
+
+	pubKey, err := hexutil.Decode(stringPubKey)
+	require.NoError(t, err)
+	slotSignature := test_helpers.FillByteSlice(96, 82)
+
+	jsonAttestationData := &apimiddleware.AttestationDataJson{
+		Slot:            "123",
+		CommitteeIndex:  "1",
+		BeaconBlockRoot: test_helpers.FillEncodedByteSlice(32, 2),
+		Source: &apimiddleware.CheckpointJson{
+			Epoch: "3",
+			Root:  test_helpers.FillEncodedByteSlice(32, 4),
+		},
+		Target: &apimiddleware.CheckpointJson{
+			Epoch: "5",
+			Root:  test_helpers.FillEncodedByteSlice(32, 6),
+		},
+	}
+
+	attestationData, err := convertAttestationDataToProto(jsonAttestationData)
+	require.NoError(t, err)
+
+	attestationDataRoot, err := attestationData.HashTreeRoot()
+	require.NoError(t, err)
+
+	jsonAggregateAttestation := &apimiddleware.AttestationJson{
+		AggregationBits: "0x0103",
+		Data:            jsonAttestationData,
+		Signature:       test_helpers.FillEncodedByteSlice(96, 7),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/node/syncing",
+		&apimiddleware.SyncingResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.SyncingResponseJson{Data: &helpers.SyncDetailsJson{IsOptimistic: false}},
+	).Times(1)
+
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/validator/attestation_data?committee_index=1&slot=123",
+		&apimiddleware.ProduceAttestationDataResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.ProduceAttestationDataResponseJson{Data: jsonAttestationData},
+	).Times(1)
+
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/validator/aggregate_attestation?attestation_data_root="+hexutil.Encode(attestationDataRoot[:])+"&slot=123",
+		&apimiddleware.AggregateAttestationResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.AggregateAttestationResponseJson{Data: jsonAggregateAttestation},
+	).Times(1)
+
+	stateValidatorsProvider := mock.NewMockstateValidatorsProvider(ctrl)
+	stateValidatorsProvider.EXPECT().GetStateValidators(
+		ctx,
+		[]string{stringPubKey},
+		nil,
+		nil,
+	).Return(
+		&apimiddleware.StateValidatorsResponseJson{
+			Data: []*apimiddleware.ValidatorContainerJson{
+				{
+					Index:     "55293",
+					Validator: &apimiddleware.ValidatorJson{PublicKey: stringPubKey},
+				},
+			},
+		},
+		nil,
+	).Times(1)
+
+	// A committee of one validator always contains an aggregator
+	dutiesProvider := mock.NewMockdutiesProvider(ctrl)
+	dutiesProvider.EXPECT().GetAttesterDuties(
+		ctx,
+		types.Epoch(3),
+		[]types.ValidatorIndex{55293},
+	).Return(
+		[]*apimiddleware.AttesterDutyJson{
+			{
+				ValidatorIndex:  "55293",
+				CommitteeIndex:  "1",
+				CommitteeLength: "1",
+				Slot:            "123",
+			},
+		},
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{
+		jsonRestHandler:         jsonRestHandler,
+		stateValidatorsProvider: stateValidatorsProvider,
+		dutiesProvider:          dutiesProvider,
+	}
+
+	expectedAggregate, err := convertAttestationsToProto([]*apimiddleware.AttestationJson{jsonAggregateAttestation})
+	require.NoError(t, err)
+
+	aggregateSelectionResponse, err := validatorClient.SubmitAggregateSelectionProof(ctx, &ethpb.AggregateSelectionRequest{
+		Slot:           slot,
+		CommitteeIndex: committeeIndex,
+		PublicKey:      pubKey,
+		SlotSignature:  slotSignature,
+	})
+	require.NoError(t, err)
+
+	assert.DeepEqual(t, &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: 55293,
+			Aggregate:       expectedAggregate[0],
+			SelectionProof:  slotSignature,
+		},
+	}, aggregateSelectionResponse)
+}
+
+func TestSubmitAggregateSelectionProof_Optimistic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/node/syncing",
+		&apimiddleware.SyncingResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.SyncingResponseJson{Data: &helpers.SyncDetailsJson{IsOptimistic: true}},
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	_, err := validatorClient.SubmitAggregateSelectionProof(ctx, &ethpb.AggregateSelectionRequest{})
+	assert.ErrorContains(t, "the node is currently optimistic and cannot serve validators", err)
+}
+
+func TestSubmitAggregateSelectionProof_NoAttesterDuty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	pubKey, err := hexutil.Decode(stringPubKey)
+	require.NoError(t, err)
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/node/syncing",
+		&apimiddleware.SyncingResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.SyncingResponseJson{Data: &helpers.SyncDetailsJson{}},
+	).Times(1)
+
+	stateValidatorsProvider := mock.NewMockstateValidatorsProvider(ctrl)
+	stateValidatorsProvider.EXPECT().GetStateValidators(ctx, gomock.Any(), nil, nil).Return(
+		&apimiddleware.StateValidatorsResponseJson{
+			Data: []*apimiddleware.ValidatorContainerJson{
+				{
+					Index:     "55293",
+					Validator: &apimiddleware.ValidatorJson{PublicKey: stringPubKey},
+				},
+			},
+		},
+		nil,
+	).Times(1)
+
+	dutiesProvider := mock.NewMockdutiesProvider(ctrl)
+	dutiesProvider.EXPECT().GetAttesterDuties(ctx, gomock.Any(), gomock.Any()).Return(
+		[]*apimiddleware.AttesterDutyJson{},
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{
+		jsonRestHandler:         jsonRestHandler,
+		stateValidatorsProvider: stateValidatorsProvider,
+		dutiesProvider:          dutiesProvider,
+	}
+	_, err = validatorClient.SubmitAggregateSelectionProof(ctx, &ethpb.AggregateSelectionRequest{Slot: 123, PublicKey: pubKey})
+	assert.ErrorContains(t, "no attester duty for the given slot 123", err)
+}
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/url"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
 )
 
 func (c *beaconApiValidatorClient) submitSyncMessage(ctx context.Context, syncMessage *ethpb.SyncCommitteeMessage) error {
@@ -33,3 +36,97 @@ func (c *beaconApiValidatorClient) submitSyncMessage(ctx context.Context, syncMe
 
 	return nil
 }
+
+func (c *beaconApiValidatorClient) getSyncCommitteeContribution(
+	ctx context.Context,
+	req *ethpb.SyncCommitteeContributionRequest,
+) (*ethpb.SyncCommitteeContribution, error) {
+	blockRootResponse, err := c.getSyncMessageBlockRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sync message block root")
+	}
+
+	params := url.Values{}
+	params.Add("slot", uint64ToString(req.Slot))
+	params.Add("subcommittee_index", strconv.FormatUint(req.SubnetId, 10))
+	params.Add("beacon_block_root", hexutil.Encode(blockRootResponse.Root))
+
+	query := buildURL("/eth/v1/validator/sync_committee_contribution", params)
+
+	var resp apimiddleware.ProduceSyncCommitteeContributionResponseJson
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, query, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to query GET REST endpoint")
+	}
+
+	return convertSyncContributionJsonToProto(resp.Data)
+}
+
+func (c *beaconApiValidatorClient) getSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	validatorIndexResponse, err := c.validatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validator index")
+	}
+
+	// If the next slot belongs to a new sync committee period, the indices of the next sync committee are used,
+	// the same way as the gRPC implementation
+	syncDuties, err := c.dutiesProvider.GetSyncDuties(ctx, slots.ToEpoch(in.Slot+1), []types.ValidatorIndex{validatorIndexResponse.Index})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sync committee duties")
+	}
+
+	var indices []types.CommitteeIndex
+	for _, syncDuty := range syncDuties {
+		if syncDuty.ValidatorIndex != uint64ToString(validatorIndexResponse.Index) {
+			continue
+		}
+
+		for _, stringSyncCommitteeIndex := range syncDuty.ValidatorSyncCommitteeIndices {
+			syncCommitteeIndex, err := strconv.ParseUint(stringSyncCommitteeIndex, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse validator sync committee index `%s`", stringSyncCommitteeIndex)
+			}
+			indices = append(indices, types.CommitteeIndex(syncCommitteeIndex))
+		}
+	}
+
+	return &ethpb.SyncSubcommitteeIndexResponse{Indices: indices}, nil
+}
+
+func convertSyncContributionJsonToProto(contribution *apimiddleware.SyncCommitteeContributionJson) (*ethpb.SyncCommitteeContribution, error) {
+	if contribution == nil {
+		return nil, errors.New("sync committee contribution is nil")
+	}
+
+	slot, err := strconv.ParseUint(contribution.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse slot `%s`", contribution.Slot)
+	}
+
+	blockRoot, err := hexutil.Decode(contribution.BeaconBlockRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode beacon block root `%s`", contribution.BeaconBlockRoot)
+	}
+
+	subcommitteeIdx, err := strconv.ParseUint(contribution.SubcommitteeIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse subcommittee index `%s`", contribution.SubcommitteeIndex)
+	}
+
+	aggregationBits, err := hexutil.Decode(contribution.AggregationBits)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode aggregation bits `%s`", contribution.AggregationBits)
+	}
+
+	signature, err := hexutil.Decode(contribution.Signature)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode contribution signature `%s`", contribution.Signature)
+	}
+
+	return &ethpb.SyncCommitteeContribution{
+		Slot:              types.Slot(slot),
+		BlockRoot:         blockRoot,
+		SubcommitteeIndex: subcommitteeIdx,
+		AggregationBits:   aggregationBits,
+		Signature:         signature,
+	}, nil
+}
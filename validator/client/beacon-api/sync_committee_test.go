@@ -15,6 +15,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	"github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api/mock"
 )
 
@@ -88,3 +89,207 @@ func TestSubmitSyncMessage_BadRequest(t *testing.T) {
 	assert.ErrorContains(t, "failed to send POST data to `/eth/v1/beacon/pool/sync_committees` REST endpoint", err)
 	assert.ErrorContains(t, "foo error", err)
 }
+
+func TestGetSyncCommitteeContribution(t *testing.T) {
+	const blockRoot = "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"
+	const aggregationBits = "0x01"
+	const signature = "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"
+
+	request := &ethpb.SyncCommitteeContributionRequest{
+		Slot:      types.Slot(1),
+		PublicKey: nil,
+		SubnetId:  1,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/beacon/blocks/head/root",
+		&apimiddleware.BlockRootResponseJson{},
+	).SetArg(
+		2,
+		apimiddleware.BlockRootResponseJson{
+			Data: &apimiddleware.BlockRootContainerJson{Root: blockRoot},
+		},
+	).Return(
+		nil,
+		nil,
+	).Times(1)
+
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/validator/sync_committee_contribution?beacon_block_root="+blockRoot+"&slot=1&subcommittee_index=1",
+		&apimiddleware.ProduceSyncCommitteeContributionResponseJson{},
+	).SetArg(
+		2,
+		apimiddleware.ProduceSyncCommitteeContributionResponseJson{
+			Data: &apimiddleware.SyncCommitteeContributionJson{
+				Slot:              "1",
+				BeaconBlockRoot:   blockRoot,
+				SubcommitteeIndex: "1",
+				AggregationBits:   aggregationBits,
+				Signature:         signature,
+			},
+		},
+	).Return(
+		nil,
+		nil,
+	).Times(1)
+
+	decodedBlockRoot, err := hexutil.Decode(blockRoot)
+	require.NoError(t, err)
+
+	decodedAggregationBits, err := hexutil.Decode(aggregationBits)
+	require.NoError(t, err)
+
+	decodedSignature, err := hexutil.Decode(signature)
+	require.NoError(t, err)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	contribution, err := validatorClient.GetSyncCommitteeContribution(ctx, request)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.SyncCommitteeContribution{
+		Slot:              1,
+		BlockRoot:         decodedBlockRoot,
+		SubcommitteeIndex: 1,
+		AggregationBits:   decodedAggregationBits,
+		Signature:         decodedSignature,
+	}, contribution)
+}
+
+func TestGetSyncCommitteeContribution_NilData(t *testing.T) {
+	const blockRoot = "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		"/eth/v1/beacon/blocks/head/root",
+		&apimiddleware.BlockRootResponseJson{},
+	).SetArg(
+		2,
+		apimiddleware.BlockRootResponseJson{
+			Data: &apimiddleware.BlockRootContainerJson{Root: blockRoot},
+		},
+	).Return(
+		nil,
+		nil,
+	).Times(1)
+
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		gomock.Any(),
+		&apimiddleware.ProduceSyncCommitteeContributionResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	_, err := validatorClient.GetSyncCommitteeContribution(ctx, &ethpb.SyncCommitteeContributionRequest{Slot: 1, SubnetId: 1})
+	assert.ErrorContains(t, "sync committee contribution is nil", err)
+}
+
+func TestGetSyncSubCommitteeIndex(t *testing.T) {
+	const slot = types.Slot(33)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	pubKey, err := hexutil.Decode(stringPubKey)
+	require.NoError(t, err)
+
+	stateValidatorsProvider := mock.NewMockstateValidatorsProvider(ctrl)
+	stateValidatorsProvider.EXPECT().GetStateValidators(
+		ctx,
+		[]string{stringPubKey},
+		nil,
+		nil,
+	).Return(
+		&apimiddleware.StateValidatorsResponseJson{
+			Data: []*apimiddleware.ValidatorContainerJson{
+				{
+					Index:     "55293",
+					Status:    "active_ongoing",
+					Validator: &apimiddleware.ValidatorJson{PublicKey: stringPubKey},
+				},
+			},
+		},
+		nil,
+	).Times(1)
+
+	dutiesProvider := mock.NewMockdutiesProvider(ctrl)
+	dutiesProvider.EXPECT().GetSyncDuties(
+		ctx,
+		slots.ToEpoch(slot+1),
+		[]types.ValidatorIndex{55293},
+	).Return(
+		[]*apimiddleware.SyncCommitteeDuty{
+			{
+				Pubkey:                        stringPubKey,
+				ValidatorIndex:                "55293",
+				ValidatorSyncCommitteeIndices: []string{"0", "21"},
+			},
+		},
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{
+		stateValidatorsProvider: stateValidatorsProvider,
+		dutiesProvider:          dutiesProvider,
+	}
+	syncSubcommitteeIndexResponse, err := validatorClient.GetSyncSubcommitteeIndex(ctx, &ethpb.SyncSubcommitteeIndexRequest{
+		PublicKey: pubKey,
+		Slot:      slot,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.CommitteeIndex{0, 21}, syncSubcommitteeIndexResponse.Indices)
+}
+
+func TestGetSyncSubCommitteeIndex_DutiesError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	pubKey, err := hexutil.Decode(stringPubKey)
+	require.NoError(t, err)
+
+	stateValidatorsProvider := mock.NewMockstateValidatorsProvider(ctrl)
+	stateValidatorsProvider.EXPECT().GetStateValidators(ctx, gomock.Any(), nil, nil).Return(
+		&apimiddleware.StateValidatorsResponseJson{
+			Data: []*apimiddleware.ValidatorContainerJson{
+				{
+					Index:     "55293",
+					Status:    "active_ongoing",
+					Validator: &apimiddleware.ValidatorJson{PublicKey: stringPubKey},
+				},
+			},
+		},
+		nil,
+	).Times(1)
+
+	dutiesProvider := mock.NewMockdutiesProvider(ctrl)
+	dutiesProvider.EXPECT().GetSyncDuties(ctx, gomock.Any(), gomock.Any()).Return(
+		nil,
+		errors.New("foo error"),
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{
+		stateValidatorsProvider: stateValidatorsProvider,
+		dutiesProvider:          dutiesProvider,
+	}
+	_, err = validatorClient.GetSyncSubcommitteeIndex(ctx, &ethpb.SyncSubcommitteeIndexRequest{PublicKey: pubKey, Slot: 1})
+	assert.ErrorContains(t, "failed to get sync committee duties: foo error", err)
+}
@@ -0,0 +1,80 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/eth/helpers"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+)
+
+func NewBeaconApiNodeHealthClient(host string, timeout time.Duration) iface.NodeHealthClient {
+	return &beaconApiValidatorClient{
+		jsonRestHandler: beaconApiJsonRestHandler{
+			httpClient: http.Client{Timeout: timeout},
+			host:       host,
+		},
+	}
+}
+
+func (c *beaconApiValidatorClient) getSyncing(ctx context.Context) (*helpers.SyncDetailsJson, error) {
+	const endpoint = "/eth/v1/node/syncing"
+
+	syncingResponseJson := apimiddleware.SyncingResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, endpoint, &syncingResponseJson); err != nil {
+		return nil, errors.Wrapf(err, "failed to get json response from `%s` REST endpoint", endpoint)
+	}
+
+	if syncingResponseJson.Data == nil {
+		return nil, errors.Errorf("syncing data is nil in `%s` REST endpoint response", endpoint)
+	}
+
+	return syncingResponseJson.Data, nil
+}
+
+func (c *beaconApiValidatorClient) isSyncing(ctx context.Context) (bool, error) {
+	syncingData, err := c.getSyncing(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get syncing status")
+	}
+
+	return syncingData.IsSyncing, nil
+}
+
+func (c *beaconApiValidatorClient) isOptimistic(ctx context.Context) (bool, error) {
+	syncingData, err := c.getSyncing(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get syncing status")
+	}
+
+	return syncingData.IsOptimistic, nil
+}
+
+func (c *beaconApiValidatorClient) NodeHealth(ctx context.Context) (*iface.NodeHealth, error) {
+	syncingData, err := c.getSyncing(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get syncing status")
+	}
+
+	headSlot, err := strconv.ParseUint(syncingData.HeadSlot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse head slot: %s", syncingData.HeadSlot)
+	}
+
+	syncDistance, err := strconv.ParseUint(syncingData.SyncDistance, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse sync distance: %s", syncingData.SyncDistance)
+	}
+
+	return &iface.NodeHealth{
+		HeadSlot:     types.Slot(headSlot),
+		SyncDistance: types.Slot(syncDistance),
+		IsSyncing:    syncingData.IsSyncing,
+		IsOptimistic: syncingData.IsOptimistic,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package beacon_api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api/mock"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+)
+
+const syncingTestEndpoint = "/eth/v1/node/syncing"
+
+func TestGetSyncing_Valid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	expectedSyncDetails := &helpers.SyncDetailsJson{
+		HeadSlot:     "1",
+		SyncDistance: "2",
+		IsSyncing:    true,
+		IsOptimistic: false,
+	}
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		syncingTestEndpoint,
+		&apimiddleware.SyncingResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.SyncingResponseJson{Data: expectedSyncDetails},
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	syncDetails, err := validatorClient.getSyncing(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, expectedSyncDetails, syncDetails)
+}
+
+func TestGetSyncing_Error(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		syncingResponse      apimiddleware.SyncingResponseJson
+		restError            error
+		expectedErrorMessage string
+	}{
+		{
+			name:                 "query failed",
+			restError:            errors.New("foo error"),
+			expectedErrorMessage: "failed to get json response from `/eth/v1/node/syncing` REST endpoint: foo error",
+		},
+		{
+			name:                 "nil data",
+			syncingResponse:      apimiddleware.SyncingResponseJson{Data: nil},
+			expectedErrorMessage: "syncing data is nil in `/eth/v1/node/syncing` REST endpoint response",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+
+			jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+			jsonRestHandler.EXPECT().GetRestJsonResponse(
+				ctx,
+				syncingTestEndpoint,
+				&apimiddleware.SyncingResponseJson{},
+			).Return(
+				nil,
+				testCase.restError,
+			).SetArg(
+				2,
+				testCase.syncingResponse,
+			).Times(1)
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+			_, err := validatorClient.getSyncing(ctx)
+			assert.ErrorContains(t, testCase.expectedErrorMessage, err)
+		})
+	}
+}
+
+func TestIsOptimistic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		syncingTestEndpoint,
+		&apimiddleware.SyncingResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.SyncingResponseJson{Data: &helpers.SyncDetailsJson{IsOptimistic: true}},
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	isOptimistic, err := validatorClient.isOptimistic(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, isOptimistic)
+}
+
+func TestNodeHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		syncingTestEndpoint,
+		&apimiddleware.SyncingResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.SyncingResponseJson{Data: &helpers.SyncDetailsJson{
+			HeadSlot:     "10",
+			SyncDistance: "2",
+			IsSyncing:    false,
+			IsOptimistic: true,
+		}},
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	health, err := validatorClient.NodeHealth(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &iface.NodeHealth{
+		HeadSlot:     10,
+		SyncDistance: 2,
+		IsSyncing:    false,
+		IsOptimistic: true,
+	}, health)
+}
+
+func TestNodeHealth_InvalidHeadSlot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().GetRestJsonResponse(
+		ctx,
+		syncingTestEndpoint,
+		&apimiddleware.SyncingResponseJson{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		apimiddleware.SyncingResponseJson{Data: &helpers.SyncDetailsJson{HeadSlot: "foo", SyncDistance: "0"}},
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	_, err := validatorClient.NodeHealth(ctx)
+	assert.ErrorContains(t, "failed to parse head slot: foo", err)
+}
@@ -0,0 +1,363 @@
+// Package failover implements a validator client spreading its requests over several beacon nodes,
+// based on their health. Duties are requested from the healthiest node, failing over to the next
+// healthiest one when the node cannot serve them, while attestations and blocks are broadcast to
+// all the healthy nodes, except blinded blocks, which only the node producing them can unblind. The committee subscriptions and the proposer settings are broadcast as well,
+// so that any node is ready to serve the duties after a failover.
+package failover
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	beaconApi "github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxHeadSlotLag is how many slots the head of a node can be behind the highest head of
+// the nodes for the node to still be considered synced.
+const maxHeadSlotLag = 2
+
+// rank is the health of a node, the lower the healthier.
+type rank int
+
+const (
+	rankSynced rank = iota
+	// rankLagging nodes are synced, but their head is behind the head of the other nodes.
+	rankLagging
+	// rankOptimistic nodes are synced, but their head is not yet verified by the execution client,
+	// and so they can neither produce attestation data nor blocks.
+	rankOptimistic
+	rankSyncing
+	rankUnreachable
+)
+
+func (r rank) String() string {
+	switch r {
+	case rankSynced:
+		return "synced"
+	case rankLagging:
+		return "lagging"
+	case rankOptimistic:
+		return "optimistic"
+	case rankSyncing:
+		return "syncing"
+	default:
+		return "unreachable"
+	}
+}
+
+// Node is a beacon node the validator client sends its requests to.
+type Node struct {
+	Endpoint string
+	Client   iface.ValidatorClient
+	Health   iface.NodeHealthClient
+}
+
+type node struct {
+	*Node
+	health *iface.NodeHealth
+	// err is the error of the last health check, or of the last request since then
+	// the node could not serve.
+	err error
+}
+
+type validatorClient struct {
+	nodes         []*node
+	checkInterval time.Duration
+	lock          sync.RWMutex
+	// active is the healthiest node as of the last health check, and activeRank its health.
+	active     *node
+	activeRank rank
+	// blindedBlockNodes are the nodes which produced the blinded blocks of the recent slots, the only
+	// nodes able to unblind them once signed.
+	blindedBlockNodes map[types.Slot]*node
+}
+
+// NewValidatorClient returns a validator client sending the requests to the given beacon nodes. The
+// nodes are listed in order of preference, the first of the healthiest nodes serving the duties. The
+// health of the nodes is checked at the given interval until the context is canceled.
+func NewValidatorClient(ctx context.Context, nodes []*Node, checkInterval time.Duration) (iface.ValidatorClient, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("no beacon node")
+	}
+	if checkInterval <= 0 {
+		return nil, errors.New("health check interval must be positive")
+	}
+	c := &validatorClient{
+		nodes:         make([]*node, len(nodes)),
+		checkInterval: checkInterval,
+	}
+	for i, n := range nodes {
+		c.nodes[i] = &node{Node: n, err: errors.New("health not checked yet")}
+	}
+	go c.run(ctx)
+	return c, nil
+}
+
+func (c *validatorClient) run(ctx context.Context) {
+	ticker := time.NewTicker(c.checkInterval)
+	defer ticker.Stop()
+	for {
+		c.checkHealth(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealth updates the sync status of all nodes.
+func (c *validatorClient) checkHealth(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.checkInterval)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, n := range c.nodes {
+		wg.Add(1)
+		go func(n *node) {
+			defer wg.Done()
+			health, err := n.Health.NodeHealth(ctx)
+			c.lock.Lock()
+			n.health, n.err = health, err
+			c.lock.Unlock()
+		}(n)
+	}
+	wg.Wait()
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return
+	}
+
+	ranks := c.ranks()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, n := range c.nodes {
+		beaconNodeHealthGauge.WithLabelValues(n.Endpoint).Set(float64(ranks[n]))
+		if n.health != nil {
+			beaconNodeHeadSlotGauge.WithLabelValues(n.Endpoint).Set(float64(n.health.HeadSlot))
+		}
+		if n.err != nil {
+			log.WithError(n.err).WithField("endpoint", n.Endpoint).Debug("Could not check beacon node health")
+		}
+	}
+	best := c.sorted(ranks)[0]
+	if best == c.active && ranks[best] == c.activeRank {
+		return
+	}
+	c.active, c.activeRank = best, ranks[best]
+	fields := logrus.Fields{
+		"endpoint": best.Endpoint,
+		"health":   ranks[best].String(),
+	}
+	if best.health != nil {
+		fields["headSlot"] = best.health.HeadSlot
+	}
+	if ranks[best] == rankSynced {
+		log.WithFields(fields).Info("Sending duties to beacon node")
+	} else {
+		log.WithFields(fields).Warn("No synced beacon node, sending duties to the healthiest beacon node")
+	}
+}
+
+// ranks returns the health of the nodes.
+func (c *validatorClient) ranks() map[*node]rank {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var bestHead types.Slot
+	for _, n := range c.nodes {
+		if n.err == nil && n.health != nil && !n.health.IsSyncing && n.health.HeadSlot > bestHead {
+			bestHead = n.health.HeadSlot
+		}
+	}
+	ranks := make(map[*node]rank, len(c.nodes))
+	for _, n := range c.nodes {
+		switch {
+		case n.err != nil || n.health == nil:
+			ranks[n] = rankUnreachable
+		case n.health.IsSyncing:
+			ranks[n] = rankSyncing
+		case n.health.IsOptimistic:
+			ranks[n] = rankOptimistic
+		case n.health.HeadSlot+maxHeadSlotLag < bestHead:
+			ranks[n] = rankLagging
+		default:
+			ranks[n] = rankSynced
+		}
+	}
+	return ranks
+}
+
+// sorted returns the nodes from the healthiest, in order of preference for nodes equally healthy.
+func (c *validatorClient) sorted(ranks map[*node]rank) []*node {
+	nodes := make([]*node, len(c.nodes))
+	copy(nodes, c.nodes)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return ranks[nodes[i]] < ranks[nodes[j]]
+	})
+	return nodes
+}
+
+// candidates returns the nodes to try a request on, from the healthiest.
+func (c *validatorClient) candidates() []*node {
+	return c.sorted(c.ranks())
+}
+
+// healthy returns the reachable nodes which are not syncing, from the healthiest, or all nodes
+// should there be none.
+func (c *validatorClient) healthy() []*node {
+	ranks := c.ranks()
+	nodes := c.sorted(ranks)
+	for i, n := range nodes {
+		if ranks[n] >= rankSyncing {
+			if i == 0 {
+				return nodes
+			}
+			return nodes[:i]
+		}
+	}
+	return nodes
+}
+
+// markFailed considers the node unreachable until its next successful health check.
+func (c *validatorClient) markFailed(n *node, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	n.err = err
+}
+
+// setBlindedBlockNode records the node which produced the blinded block of the slot, forgetting
+// the nodes of the earlier slots.
+func (c *validatorClient) setBlindedBlockNode(slot types.Slot, n *node) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.blindedBlockNodes == nil {
+		c.blindedBlockNodes = make(map[types.Slot]*node)
+	}
+	for s := range c.blindedBlockNodes {
+		if s < slot {
+			delete(c.blindedBlockNodes, s)
+		}
+	}
+	c.blindedBlockNodes[slot] = n
+}
+
+// blindedBlockNode returns the node which produced the blinded block of the slot, if known.
+func (c *validatorClient) blindedBlockNode(slot types.Slot) *node {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.blindedBlockNodes[slot]
+}
+
+// route sends the request to the healthiest node, failing over to the next healthiest nodes
+// while the nodes cannot serve it.
+func route[T any](ctx context.Context, c *validatorClient, request func(iface.ValidatorClient) (T, error)) (T, error) {
+	res, _, err := routeNode(ctx, c, request)
+	return res, err
+}
+
+// routeNode is route, also returning the node which served the request.
+func routeNode[T any](ctx context.Context, c *validatorClient, request func(iface.ValidatorClient) (T, error)) (T, *node, error) {
+	candidates := c.candidates()
+	var res T
+	var err error
+	for i, n := range candidates {
+		res, err = request(n.Client)
+		if err == nil || ctx.Err() != nil || !isNodeFailure(err) {
+			return res, n, err
+		}
+		c.markFailed(n, err)
+		if i < len(candidates)-1 {
+			beaconNodeFailoversCount.Inc()
+			log.WithError(err).WithFields(logrus.Fields{
+				"endpoint": n.Endpoint,
+				"next":     candidates[i+1].Endpoint,
+			}).Warn("Beacon node failed, failing over to the next beacon node")
+		}
+	}
+	return res, nil, err
+}
+
+// send sends the request to the given node only.
+func send[T any](ctx context.Context, c *validatorClient, n *node, request func(iface.ValidatorClient) (T, error)) (T, error) {
+	res, err := request(n.Client)
+	if err != nil && ctx.Err() == nil && isNodeFailure(err) {
+		c.markFailed(n, err)
+	}
+	return res, err
+}
+
+// broadcast sends the request to all healthy nodes at once. The response of the healthiest node
+// accepting the request is returned, the request failing only if no node accepts it.
+func broadcast[T any](ctx context.Context, c *validatorClient, request func(iface.ValidatorClient) (T, error)) (T, error) {
+	nodes := c.healthy()
+	responses := make([]T, len(nodes))
+	errs := make([]error, len(nodes))
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		wg.Add(1)
+		go func(i int, n *node) {
+			defer wg.Done()
+			responses[i], errs[i] = request(n.Client)
+			if errs[i] != nil && ctx.Err() == nil && isNodeFailure(errs[i]) {
+				c.markFailed(n, errs[i])
+			}
+		}(i, n)
+	}
+	wg.Wait()
+
+	for i, n := range nodes {
+		if errs[i] != nil {
+			log.WithError(errs[i]).WithField("endpoint", n.Endpoint).Debug("Beacon node did not accept broadcast request")
+		}
+	}
+	for i := range nodes {
+		if errs[i] == nil {
+			return responses[i], nil
+		}
+	}
+	var res T
+	return res, errs[0]
+}
+
+// isNodeFailure returns whether the error comes from the node being unable to serve requests,
+// rather than from the request itself. Internal errors are caused by the request as often as by
+// the node, so they are returned to the caller without failing over.
+func isNodeFailure(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	if st, ok := status.FromError(errors.Cause(err)); ok {
+		switch st.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+			return true
+		default:
+			return false
+		}
+	}
+	var httpErr *beaconApi.HttpError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusBadGateway, http.StatusTooManyRequests:
+			return true
+		default:
+			return false
+		}
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
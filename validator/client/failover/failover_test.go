@@ -0,0 +1,233 @@
+package failover
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/mock"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	beaconApi "github.com/prysmaticlabs/prysm/v3/validator/client/beacon-api"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type healthClient struct {
+	health *iface.NodeHealth
+	err    error
+}
+
+func (c *healthClient) NodeHealth(_ context.Context) (*iface.NodeHealth, error) {
+	return c.health, c.err
+}
+
+func synced(headSlot types.Slot) *healthClient {
+	return &healthClient{health: &iface.NodeHealth{HeadSlot: headSlot}}
+}
+
+// testClient returns a failover client over mock nodes with the given health, without checking
+// their health in the background.
+func testClient(t *testing.T, healths ...*healthClient) (*validatorClient, []*mock.MockValidatorClient) {
+	ctrl := gomock.NewController(t)
+	c := &validatorClient{checkInterval: time.Second}
+	clients := make([]*mock.MockValidatorClient, len(healths))
+	for i, h := range healths {
+		clients[i] = mock.NewMockValidatorClient(ctrl)
+		c.nodes = append(c.nodes, &node{Node: &Node{
+			Endpoint: string(rune('a' + i)),
+			Client:   clients[i],
+			Health:   h,
+		}})
+	}
+	c.checkHealth(context.Background())
+	return c, clients
+}
+
+func endpoints(nodes []*node) []string {
+	e := make([]string, len(nodes))
+	for i, n := range nodes {
+		e[i] = n.Endpoint
+	}
+	return e
+}
+
+func TestNewValidatorClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := NewValidatorClient(ctx, nil, time.Second)
+	require.ErrorContains(t, "no beacon node", err)
+	_, err = NewValidatorClient(ctx, []*Node{{Endpoint: "a", Health: synced(1)}}, 0)
+	require.ErrorContains(t, "health check interval must be positive", err)
+	_, err = NewValidatorClient(ctx, []*Node{{Endpoint: "a", Health: synced(1)}}, time.Second)
+	require.NoError(t, err)
+}
+
+func TestValidatorClient_Ranks(t *testing.T) {
+	c, _ := testClient(t,
+		&healthClient{err: errors.New("connection refused")},
+		&healthClient{health: &iface.NodeHealth{HeadSlot: 50, SyncDistance: 50, IsSyncing: true}},
+		&healthClient{health: &iface.NodeHealth{HeadSlot: 100, IsOptimistic: true}},
+		synced(97),
+		synced(99),
+		synced(100),
+	)
+	ranks := c.ranks()
+	want := []rank{rankUnreachable, rankSyncing, rankOptimistic, rankLagging, rankSynced, rankSynced}
+	for i, n := range c.nodes {
+		assert.Equal(t, want[i], ranks[n], "Wrong rank for node %s", n.Endpoint)
+	}
+	assert.DeepEqual(t, []string{"e", "f", "d", "c", "b", "a"}, endpoints(c.candidates()))
+	assert.DeepEqual(t, []string{"e", "f", "d", "c"}, endpoints(c.healthy()))
+	assert.Equal(t, "e", c.active.Endpoint)
+
+	// All nodes are returned when none is healthy.
+	c, _ = testClient(t,
+		&healthClient{err: errors.New("connection refused")},
+		&healthClient{health: &iface.NodeHealth{IsSyncing: true}},
+	)
+	assert.DeepEqual(t, []string{"b", "a"}, endpoints(c.healthy()))
+}
+
+func TestValidatorClient_Route(t *testing.T) {
+	ctx := context.Background()
+	c, clients := testClient(t, synced(10), synced(10))
+
+	data := &ethpb.AttestationData{Slot: 10}
+	clients[0].EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "node down")).Times(1)
+	clients[1].EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(data, nil).Times(2)
+	res, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.Equal(t, data, res)
+	// The failed node is not used until its health is checked again.
+	_, err = c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: 10})
+	require.NoError(t, err)
+
+	c.checkHealth(ctx)
+	assert.DeepEqual(t, []string{"a", "b"}, endpoints(c.candidates()))
+	// Errors caused by the request are not failed over.
+	clients[0].EXPECT().ValidatorIndex(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "unknown validator")).Times(1)
+	_, err = c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{})
+	require.ErrorContains(t, "unknown validator", err)
+	assert.DeepEqual(t, []string{"a", "b"}, endpoints(c.candidates()))
+
+	// The error of the last node is returned when all nodes fail.
+	clients[0].EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "node a down")).Times(1)
+	clients[1].EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "node b down")).Times(1)
+	_, err = c.GetDuties(ctx, &ethpb.DutiesRequest{})
+	require.ErrorContains(t, "node b down", err)
+}
+
+func TestValidatorClient_Route_Rest(t *testing.T) {
+	ctx := context.Background()
+	c, clients := testClient(t, synced(10), synced(10))
+
+	// A node answering with a server error, such as a node that is syncing, is failed over.
+	data := &ethpb.AttestationData{Slot: 10}
+	syncing := errors.Wrap(&beaconApi.HttpError{StatusCode: http.StatusServiceUnavailable, Message: "beacon node is currently syncing"}, "failed to query REST API")
+	clients[0].EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(nil, syncing).Times(1)
+	clients[1].EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(data, nil).Times(1)
+	res, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.Equal(t, data, res)
+	assert.Equal(t, rankUnreachable, c.ranks()[c.nodes[0]])
+
+	// Client errors are caused by the request and are not failed over.
+	c.checkHealth(ctx)
+	notFound := errors.Wrap(&beaconApi.HttpError{StatusCode: http.StatusNotFound, Message: "unknown validator"}, "failed to query REST API")
+	clients[0].EXPECT().ValidatorIndex(gomock.Any(), gomock.Any()).Return(nil, notFound).Times(1)
+	_, err = c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{})
+	require.ErrorContains(t, "unknown validator", err)
+	assert.DeepEqual(t, []string{"a", "b"}, endpoints(c.candidates()))
+}
+
+func TestValidatorClient_Broadcast(t *testing.T) {
+	ctx := context.Background()
+	c, clients := testClient(t,
+		synced(10),
+		synced(10),
+		&healthClient{health: &iface.NodeHealth{IsSyncing: true}},
+	)
+
+	att := &ethpb.Attestation{}
+	resp := &ethpb.AttestResponse{AttestationDataRoot: []byte{'b'}}
+	clients[0].EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, status.Error(codes.Unavailable, "node down")).Times(1)
+	clients[1].EXPECT().ProposeAttestation(gomock.Any(), att).Return(resp, nil).Times(1)
+	res, err := c.ProposeAttestation(ctx, att)
+	require.NoError(t, err)
+	assert.Equal(t, resp, res)
+	assert.Equal(t, rankUnreachable, c.ranks()[c.nodes[0]])
+
+	c.checkHealth(ctx)
+	blk := &ethpb.GenericSignedBeaconBlock{}
+	clients[0].EXPECT().ProposeBeaconBlock(gomock.Any(), blk).Return(nil, status.Error(codes.InvalidArgument, "invalid block")).Times(1)
+	clients[1].EXPECT().ProposeBeaconBlock(gomock.Any(), blk).Return(nil, status.Error(codes.InvalidArgument, "invalid block")).Times(1)
+	_, err = c.ProposeBeaconBlock(ctx, blk)
+	require.ErrorContains(t, "invalid block", err)
+	assert.Equal(t, rankSynced, c.ranks()[c.nodes[0]])
+}
+
+func TestValidatorClient_ProposeBlindedBlock(t *testing.T) {
+	ctx := context.Background()
+	c, clients := testClient(t, synced(10), synced(10))
+
+	// The blinded block is only sent to the node which produced it.
+	blinded := &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedCapella{
+		BlindedCapella: &ethpb.BlindedBeaconBlockCapella{Slot: 11},
+	}}
+	clients[0].EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "node down")).Times(1)
+	clients[1].EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(blinded, nil).Times(1)
+	_, err := c.GetBeaconBlock(ctx, &ethpb.BlockRequest{Slot: 11})
+	require.NoError(t, err)
+	c.checkHealth(ctx)
+	blk := &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedCapella{
+		BlindedCapella: &ethpb.SignedBlindedBeaconBlockCapella{Block: &ethpb.BlindedBeaconBlockCapella{Slot: 11}},
+	}}
+	resp := &ethpb.ProposeResponse{BlockRoot: []byte{'b'}}
+	clients[1].EXPECT().ProposeBeaconBlock(gomock.Any(), blk).Return(resp, nil).Times(1)
+	res, err := c.ProposeBeaconBlock(ctx, blk)
+	require.NoError(t, err)
+	assert.Equal(t, resp, res)
+
+	// Blinded blocks of unknown origin are sent to the healthiest node.
+	blk = &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedBellatrix{
+		BlindedBellatrix: &ethpb.SignedBlindedBeaconBlockBellatrix{Block: &ethpb.BlindedBeaconBlockBellatrix{Slot: 12}},
+	}}
+	clients[0].EXPECT().ProposeBeaconBlock(gomock.Any(), blk).Return(resp, nil).Times(1)
+	_, err = c.ProposeBeaconBlock(ctx, blk)
+	require.NoError(t, err)
+}
+
+func TestIsNodeFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "unavailable", err: status.Error(codes.Unavailable, ""), want: true},
+		{name: "wrapped internal", err: errors.Wrap(status.Error(codes.Internal, ""), "wrapped"), want: false},
+		{name: "aborted", err: status.Error(codes.Aborted, ""), want: false},
+		{name: "resource exhausted", err: status.Error(codes.ResourceExhausted, ""), want: true},
+		{name: "not found", err: status.Error(codes.NotFound, ""), want: false},
+		{name: "url error", err: errors.Wrap(&url.Error{Op: "Get", URL: "http://a", Err: errors.New("connection refused")}, "wrapped"), want: true},
+		{name: "http service unavailable", err: errors.Wrap(&beaconApi.HttpError{StatusCode: http.StatusServiceUnavailable}, "wrapped"), want: true},
+		{name: "http internal server error", err: &beaconApi.HttpError{StatusCode: http.StatusInternalServerError}, want: false},
+		{name: "http too many requests", err: &beaconApi.HttpError{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "http bad request", err: errors.Wrap(&beaconApi.HttpError{StatusCode: http.StatusBadRequest}, "wrapped"), want: false},
+		{name: "deadline", err: errors.Wrap(context.DeadlineExceeded, "wrapped"), want: true},
+		{name: "other", err: errors.New("error 400: bad request"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isNodeFailure(tt.err))
+		})
+	}
+}
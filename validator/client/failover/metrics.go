@@ -0,0 +1,36 @@
+package failover
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	beaconNodeHealthGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_health",
+			Help:      "Beacon node health: 0 synced, 1 lagging, 2 optimistic, 3 syncing, 4 unreachable",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	beaconNodeHeadSlotGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_head_slot",
+			Help:      "Head slot of the beacon node",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	beaconNodeFailoversCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_failovers_total",
+			Help:      "Number of requests failed over to another beacon node",
+		},
+	)
+)
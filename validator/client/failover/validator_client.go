@@ -0,0 +1,184 @@
+package failover
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+)
+
+func (c *validatorClient) GetDuties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.DutiesResponse, error) {
+		return vc.GetDuties(ctx, in)
+	})
+}
+
+func (c *validatorClient) StreamDuties(ctx context.Context, in *ethpb.DutiesRequest) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+		return vc.StreamDuties(ctx, in)
+	})
+}
+
+func (c *validatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.DomainResponse, error) {
+		return vc.DomainData(ctx, in)
+	})
+}
+
+func (c *validatorClient) WaitForChainStart(ctx context.Context, in *empty.Empty) (*ethpb.ChainStartResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.ChainStartResponse, error) {
+		return vc.WaitForChainStart(ctx, in)
+	})
+}
+
+func (c *validatorClient) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+		return vc.WaitForActivation(ctx, in)
+	})
+}
+
+func (c *validatorClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.ValidatorIndexResponse, error) {
+		return vc.ValidatorIndex(ctx, in)
+	})
+}
+
+func (c *validatorClient) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest) (*ethpb.ValidatorStatusResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.ValidatorStatusResponse, error) {
+		return vc.ValidatorStatus(ctx, in)
+	})
+}
+
+func (c *validatorClient) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest) (*ethpb.MultipleValidatorStatusResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.MultipleValidatorStatusResponse, error) {
+		return vc.MultipleValidatorStatus(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetBeaconBlock(ctx context.Context, in *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	res, n, err := routeNode(ctx, c, func(vc iface.ValidatorClient) (*ethpb.GenericBeaconBlock, error) {
+		return vc.GetBeaconBlock(ctx, in)
+	})
+	if err == nil && (res.GetBlindedBellatrix() != nil || res.GetBlindedCapella() != nil) {
+		c.setBlindedBlockNode(in.Slot, n)
+	}
+	return res, err
+}
+
+func (c *validatorClient) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	request := func(vc iface.ValidatorClient) (*ethpb.ProposeResponse, error) {
+		return vc.ProposeBeaconBlock(ctx, in)
+	}
+	var slot types.Slot
+	switch {
+	case in.GetBlindedBellatrix() != nil:
+		slot = in.GetBlindedBellatrix().GetBlock().GetSlot()
+	case in.GetBlindedCapella() != nil:
+		slot = in.GetBlindedCapella().GetBlock().GetSlot()
+	default:
+		return broadcast(ctx, c, request)
+	}
+	// Only the node which requested the payload header from the builder can get the payload back.
+	if n := c.blindedBlockNode(slot); n != nil {
+		return send(ctx, c, n, request)
+	}
+	return route(ctx, c, request)
+}
+
+func (c *validatorClient) PrepareBeaconProposer(ctx context.Context, in *ethpb.PrepareBeaconProposerRequest) (*empty.Empty, error) {
+	return broadcast(ctx, c, func(vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.PrepareBeaconProposer(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetFeeRecipientByPubKey(ctx context.Context, in *ethpb.FeeRecipientByPubKeyRequest) (*ethpb.FeeRecipientByPubKeyResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.FeeRecipientByPubKeyResponse, error) {
+		return vc.GetFeeRecipientByPubKey(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.AttestationData, error) {
+		return vc.GetAttestationData(ctx, in)
+	})
+}
+
+func (c *validatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	return broadcast(ctx, c, func(vc iface.ValidatorClient) (*ethpb.AttestResponse, error) {
+		return vc.ProposeAttestation(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest) (*ethpb.AggregateSelectionResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.AggregateSelectionResponse, error) {
+		return vc.SubmitAggregateSelectionProof(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	return broadcast(ctx, c, func(vc iface.ValidatorClient) (*ethpb.SignedAggregateSubmitResponse, error) {
+		return vc.SubmitSignedAggregateSelectionProof(ctx, in)
+	})
+}
+
+func (c *validatorClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
+	return broadcast(ctx, c, func(vc iface.ValidatorClient) (*ethpb.ProposeExitResponse, error) {
+		return vc.ProposeExit(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, validatorIndices []types.ValidatorIndex) (*empty.Empty, error) {
+	return broadcast(ctx, c, func(vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.SubscribeCommitteeSubnets(ctx, in, validatorIndices)
+	})
+}
+
+func (c *validatorClient) CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.DoppelGangerResponse, error) {
+		return vc.CheckDoppelGanger(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetSyncMessageBlockRoot(ctx context.Context, in *empty.Empty) (*ethpb.SyncMessageBlockRootResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.SyncMessageBlockRootResponse, error) {
+		return vc.GetSyncMessageBlockRoot(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage) (*empty.Empty, error) {
+	return broadcast(ctx, c, func(vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.SubmitSyncMessage(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+		return vc.GetSyncSubcommitteeIndex(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetSyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest) (*ethpb.SyncCommitteeContribution, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (*ethpb.SyncCommitteeContribution, error) {
+		return vc.GetSyncCommitteeContribution(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof) (*empty.Empty, error) {
+	return broadcast(ctx, c, func(vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.SubmitSignedContributionAndProof(ctx, in)
+	})
+}
+
+func (c *validatorClient) StreamBlocksAltair(ctx context.Context, in *ethpb.StreamBlocksRequest) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
+	return route(ctx, c, func(vc iface.ValidatorClient) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
+		return vc.StreamBlocksAltair(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitValidatorRegistrations(ctx context.Context, in *ethpb.SignedValidatorRegistrationsV1) (*empty.Empty, error) {
+	return broadcast(ctx, c, func(vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.SubmitValidatorRegistrations(ctx, in)
+	})
+}
@@ -0,0 +1,35 @@
+package grpc_api
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"google.golang.org/grpc"
+)
+
+type grpcNodeHealthClient struct {
+	beaconNodeClient ethpbservice.BeaconNodeClient
+}
+
+func (c *grpcNodeHealthClient) NodeHealth(ctx context.Context) (*iface.NodeHealth, error) {
+	resp, err := c.beaconNodeClient.GetSyncStatus(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync status")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("sync status is nil")
+	}
+	return &iface.NodeHealth{
+		HeadSlot:     resp.Data.HeadSlot,
+		SyncDistance: resp.Data.SyncDistance,
+		IsSyncing:    resp.Data.IsSyncing,
+		IsOptimistic: resp.Data.IsOptimistic,
+	}, nil
+}
+
+func NewGrpcNodeHealthClient(cc grpc.ClientConnInterface) iface.NodeHealthClient {
+	return &grpcNodeHealthClient{ethpbservice.NewBeaconNodeClient(cc)}
+}
@@ -0,0 +1,46 @@
+package grpc_api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"google.golang.org/grpc"
+)
+
+type syncStatusBeaconNodeClient struct {
+	ethpbservice.BeaconNodeClient
+	resp *ethpbv1.SyncingResponse
+}
+
+func (c *syncStatusBeaconNodeClient) GetSyncStatus(_ context.Context, _ *empty.Empty, _ ...grpc.CallOption) (*ethpbv1.SyncingResponse, error) {
+	return c.resp, nil
+}
+
+func TestNodeHealth(t *testing.T) {
+	healthClient := &grpcNodeHealthClient{&syncStatusBeaconNodeClient{resp: &ethpbv1.SyncingResponse{
+		Data: &ethpbv1.SyncInfo{
+			HeadSlot:     10,
+			SyncDistance: 2,
+			IsSyncing:    true,
+			IsOptimistic: true,
+		},
+	}}}
+	health, err := healthClient.NodeHealth(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, &iface.NodeHealth{
+		HeadSlot:     10,
+		SyncDistance: 2,
+		IsSyncing:    true,
+		IsOptimistic: true,
+	}, health)
+
+	healthClient = &grpcNodeHealthClient{&syncStatusBeaconNodeClient{resp: &ethpbv1.SyncingResponse{}}}
+	_, err = healthClient.NodeHealth(context.Background())
+	assert.ErrorContains(t, "sync status is nil", err)
+}
@@ -0,0 +1,20 @@
+package iface
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+)
+
+// NodeHealth is the sync status of a beacon node.
+type NodeHealth struct {
+	HeadSlot     types.Slot
+	SyncDistance types.Slot
+	IsSyncing    bool
+	IsOptimistic bool
+}
+
+// NodeHealthClient retrieves the sync status of a beacon node.
+type NodeHealthClient interface {
+	NodeHealth(ctx context.Context) (*NodeHealth, error)
+}
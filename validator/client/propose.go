@@ -205,24 +205,10 @@ func ProposeExit(
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeExit")
 	defer span.End()
 
-	indexResponse, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
-	if err != nil {
-		return errors.Wrap(err, "gRPC call to get validator index failed")
-	}
-	genesisResponse, err := nodeClient.GetGenesis(ctx, &emptypb.Empty{})
-	if err != nil {
-		return errors.Wrap(err, "gRPC call to get genesis time failed")
-	}
-	totalSecondsPassed := prysmTime.Now().Unix() - genesisResponse.GenesisTime.Seconds
-	currentEpoch := types.Epoch(uint64(totalSecondsPassed) / uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)))
-	currentSlot := slots.CurrentSlot(uint64(genesisResponse.GenesisTime.AsTime().Unix()))
-	exit := &ethpb.VoluntaryExit{Epoch: currentEpoch, ValidatorIndex: indexResponse.Index}
-	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit, currentSlot)
+	signedExit, err := CreateSignedVoluntaryExit(ctx, validatorClient, nodeClient, signer, pubKey, nil)
 	if err != nil {
-		return errors.Wrap(err, "failed to sign voluntary exit")
+		return err
 	}
-
-	signedExit := &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}
 	exitResp, err := validatorClient.ProposeExit(ctx, signedExit)
 	if err != nil {
 		return errors.Wrap(err, "failed to propose voluntary exit")
@@ -235,6 +221,43 @@ func ProposeExit(
 	return nil
 }
 
+// CreateSignedVoluntaryExit builds a voluntary exit for a validator and signs it without broadcasting it.
+// The exit becomes valid at the provided epoch, or at the current epoch if none is provided.
+func CreateSignedVoluntaryExit(
+	ctx context.Context,
+	validatorClient iface.ValidatorClient,
+	nodeClient ethpb.NodeClient,
+	signer iface.SigningFunc,
+	pubKey []byte,
+	exitEpoch *types.Epoch,
+) (*ethpb.SignedVoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.CreateSignedVoluntaryExit")
+	defer span.End()
+
+	indexResponse, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "gRPC call to get validator index failed")
+	}
+	genesisResponse, err := nodeClient.GetGenesis(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "gRPC call to get genesis time failed")
+	}
+	var epoch types.Epoch
+	if exitEpoch != nil {
+		epoch = *exitEpoch
+	} else {
+		totalSecondsPassed := prysmTime.Now().Unix() - genesisResponse.GenesisTime.Seconds
+		epoch = types.Epoch(uint64(totalSecondsPassed) / uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)))
+	}
+	currentSlot := slots.CurrentSlot(uint64(genesisResponse.GenesisTime.AsTime().Unix()))
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: indexResponse.Index}
+	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit, currentSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign voluntary exit")
+	}
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}, nil
+}
+
 // Sign randao reveal with randao domain and private key.
 func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, epoch types.Epoch, slot types.Slot) ([]byte, error) {
 	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainRandao[:])
@@ -336,9 +359,33 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
-// Gets the graffiti from cli or file for the validator public key.
+// Gets the graffiti from the keymanager API, cli or file for the validator public key.
 func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
-	// When specified, default graffiti from the command line takes the first priority.
+	return v.resolveGraffiti(ctx, pubKey, true)
+}
+
+// Graffiti returns the graffiti the validator would propose a block with, following the same priority as the
+// proposals. The next graffiti of the ordered list of the graffiti file is returned without being consumed, and
+// a graffiti of the random list is picked at random.
+func (v *validator) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
+	return v.resolveGraffiti(ctx, pubKey, false)
+}
+
+// resolveGraffiti returns the graffiti of the validator, moving on to the next graffiti of the ordered list
+// of the graffiti file when consumeOrdered is set.
+func (v *validator) resolveGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, consumeOrdered bool) ([]byte, error) {
+	// When specified, graffiti set for the public key through the keymanager API takes the first priority.
+	if v.db != nil {
+		g, err := v.db.GraffitiForPubKey(ctx, pubKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get graffiti for public key")
+		}
+		if len(g) != 0 {
+			return g, nil
+		}
+	}
+
+	// When specified, default graffiti from the command line takes the second priority.
 	if len(v.graffiti) != 0 {
 		return v.graffiti, nil
 	}
@@ -347,7 +394,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the second priority.
+	// When specified, individual validator specified graffiti takes the third priority.
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
 		return []byte{}, err
@@ -357,9 +404,14 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return []byte(g), nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take third priority.
+	// When specified, a graffiti from the ordered list in the file take fourth priority.
+	v.graffitiOrderedIndexLock.Lock()
+	defer v.graffitiOrderedIndexLock.Unlock()
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
 		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
+		if !consumeOrdered {
+			return []byte(graffiti), nil
+		}
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
 		err := v.db.SaveGraffitiOrderedIndex(ctx, v.graffitiOrderedIndex)
 		if err != nil {
@@ -368,7 +420,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return []byte(graffiti), nil
 	}
 
-	// When specified, a graffiti from the random list in the file take fourth priority.
+	// When specified, a graffiti from the random list in the file take fifth priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
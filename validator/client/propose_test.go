@@ -1039,3 +1039,73 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 		require.DeepEqual(t, want, got)
 	}
 }
+
+func TestGetGraffiti_KeymanagerAPITakesPrecedence(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockValidatorClient(ctrl),
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+
+	v := &validator{
+		db:              valDB,
+		validatorClient: m.validatorClient,
+		graffiti:        []byte{'b'},
+		graffitiStruct: &graffiti.Graffiti{
+			Default: "c",
+			Specific: map[types.ValidatorIndex]string{
+				2: "g",
+			},
+		},
+	}
+	require.NoError(t, valDB.SaveGraffitiForPubKey(context.Background(), pubKey, []byte("api")))
+	got, err := v.getGraffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("api"), got)
+
+	// Falls back to the cli graffiti once the keymanager API graffiti is removed.
+	require.NoError(t, valDB.DeleteGraffitiForPubKey(context.Background(), pubKey))
+	got, err = v.getGraffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'b'}, got)
+
+	// Falls back to the validator file graffiti without cli graffiti.
+	v.graffiti = nil
+	got, err = v.getGraffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'g'}, got)
+}
+
+func TestGraffiti_DoesNotConsumeOrderedGraffiti(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockValidatorClient(ctrl),
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil).Times(3)
+
+	v := &validator{
+		db:              valDB,
+		validatorClient: m.validatorClient,
+		graffitiStruct: &graffiti.Graffiti{
+			Default: "c",
+			Ordered: []string{"d", "e"},
+		},
+	}
+	got, err := v.Graffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'d'}, got)
+	got, err = v.getGraffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'d'}, got)
+	got, err = v.Graffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'e'}, got)
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/prysmaticlabs/prysm/v3/async"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	"github.com/sirupsen/logrus"
+)
+
+// proposerSettingsDebounceInterval is how long the proposer settings file must be left unchanged before it is
+// reloaded, so that it is not read while it is being written.
+const proposerSettingsDebounceInterval = time.Second
+
+// ProposerSettingsReload configures the reloading of the proposer settings when they change.
+type ProposerSettingsReload struct {
+	// Load reads and validates the proposer settings.
+	Load func() (*validatorserviceconfig.ProposerSettings, error)
+	// File is the proposer settings file to watch for changes. If empty, the settings are loaded every PollInterval.
+	File         string
+	PollInterval time.Duration
+}
+
+// watchProposerSettings reloads the proposer settings whenever their file changes, or periodically if they
+// are not read from a file.
+func (v *ValidatorService) watchProposerSettings(ctx context.Context) {
+	r := v.settingsReload
+	if r.File == "" {
+		ticker := time.NewTicker(r.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				v.reloadProposerSettings(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	// The directory is watched rather than the file, as editors may replace the file instead of writing to it.
+	path := filepath.Clean(r.File)
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		log.WithError(err).Errorf("Could not add the directory of %s to file watcher", path)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fileChangesChan := make(chan interface{}, 100)
+	defer close(fileChangesChan)
+	go async.Debounce(ctx, proposerSettingsDebounceInterval, fileChangesChan, func(interface{}) {
+		v.reloadProposerSettings(ctx)
+	})
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) == path && event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+				fileChangesChan <- event
+			}
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reloadProposerSettings loads the proposer settings and, if they changed since they were last loaded, makes them
+// the active settings and pushes the settings of the validators whose settings changed to the beacon node and
+// builders. Invalid settings are rejected, keeping the active settings.
+func (v *ValidatorService) reloadProposerSettings(ctx context.Context) {
+	settings, err := v.settingsReload.Load()
+	if err != nil {
+		log.WithError(err).Error("Could not reload proposer settings, keeping the active settings")
+		return
+	}
+	if settings == nil {
+		log.Error("Reloaded proposer settings are empty, keeping the active settings")
+		return
+	}
+	// Settings changed through the keymanager API are kept until the loaded settings change.
+	if reflect.DeepEqual(settings, v.loadedSettings) {
+		return
+	}
+
+	var pubkeys [][fieldparams.BLSPubkeyLength]byte
+	km, err := v.validator.Keymanager()
+	if err == nil {
+		pubkeys, err = km.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			log.WithError(err).Error("Could not fetch validating public keys, keeping the active proposer settings")
+			return
+		}
+		for _, k := range settings.UnknownPubkeys(pubkeys) {
+			log.WithField("pubkey", fmt.Sprintf("%#x", k)).Warn("Reloaded proposer settings are set for an unknown validator")
+		}
+	}
+	diff := validatorserviceconfig.DiffProposerSettings(v.ProposerSettings(), settings, pubkeys)
+	v.SetProposerSettings(settings)
+	v.loadedSettings = settings.Clone()
+	if diff.Empty() {
+		log.Info("Reloaded proposer settings, no validator settings changed")
+		return
+	}
+
+	if diff.DefaultConfig != nil {
+		log.WithField("change", diff.DefaultConfig.String()).Info("Reloaded default proposer settings")
+	}
+	known := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(pubkeys))
+	for _, k := range pubkeys {
+		known[k] = true
+	}
+	var changed [][fieldparams.BLSPubkeyLength]byte
+	for _, k := range diff.Pubkeys() {
+		log.WithFields(logrus.Fields{
+			"pubkey": fmt.Sprintf("%#x", k),
+			"change": diff.Changes[k].String(),
+		}).Info("Reloaded proposer settings")
+		if known[k] {
+			changed = append(changed, k)
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+	if err := v.validator.PushProposerSettingsForKeys(ctx, km, changed); err != nil {
+		log.WithError(err).Warn("Could not push reloaded proposer settings")
+	}
+}
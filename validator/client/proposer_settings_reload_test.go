@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestValidatorService_ReloadProposerSettings(t *testing.T) {
+	hook := logTest.NewGlobal()
+	key1 := [fieldparams.BLSPubkeyLength]byte{1}
+	key2 := [fieldparams.BLSPubkeyLength]byte{2}
+	unknownKey := [fieldparams.BLSPubkeyLength]byte{3}
+	feeA := common.HexToAddress("0x046Fb65722E7b2455043BFEBf6177F1D2e9738D9")
+	feeB := common.HexToAddress("0x055Fb65722E7b2455043BFEBf6177F1D2e9738D9")
+	active := &validatorserviceconfig.ProposerSettings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+			key1: {FeeRecipient: feeA},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOption{FeeRecipient: feeA},
+	}
+	fv := &testutil.FakeValidator{
+		Km: &mockKeymanager{keysMap: map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey{key1: nil, key2: nil}},
+	}
+	fv.SetProposerSettings(active)
+
+	loaded := active.Clone()
+	var loadErr error
+	v := &ValidatorService{
+		validator: fv,
+		settingsReload: &ProposerSettingsReload{
+			Load: func() (*validatorserviceconfig.ProposerSettings, error) {
+				return loaded, loadErr
+			},
+		},
+		loadedSettings: active.Clone(),
+	}
+
+	// Unchanged settings are not pushed.
+	v.reloadProposerSettings(context.Background())
+	assert.Equal(t, 0, len(fv.PushedProposerSettingsKeys))
+
+	// Invalid settings keep the active settings.
+	loadErr = errors.New("invalid gas limit")
+	loaded = nil
+	v.reloadProposerSettings(context.Background())
+	require.LogsContain(t, hook, "keeping the active settings")
+	assert.Equal(t, active, v.ProposerSettings())
+	assert.Equal(t, 0, len(fv.PushedProposerSettingsKeys))
+
+	// Only the validator whose settings changed is pushed.
+	loadErr = nil
+	loaded = active.Clone()
+	loaded.ProposeConfig[key2] = &validatorserviceconfig.ProposerOption{FeeRecipient: feeB}
+	loaded.ProposeConfig[unknownKey] = &validatorserviceconfig.ProposerOption{FeeRecipient: feeB}
+	v.reloadProposerSettings(context.Background())
+	require.LogsContain(t, hook, "Reloaded proposer settings are set for an unknown validator")
+	assert.DeepEqual(t, loaded, v.ProposerSettings())
+	assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{key2}, fv.PushedProposerSettingsKeys)
+
+	// Settings changed through the keymanager API are kept while the loaded settings are unchanged.
+	apiSettings := loaded.Clone()
+	apiSettings.ProposeConfig[key1].FeeRecipient = feeB
+	v.SetProposerSettings(apiSettings)
+	v.reloadProposerSettings(context.Background())
+	assert.Equal(t, apiSettings, v.ProposerSettings())
+	assert.Equal(t, 1, len(fv.PushedProposerSettingsKeys))
+}
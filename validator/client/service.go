@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -14,6 +15,7 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/v3/api/grpc"
 	"github.com/prysmaticlabs/prysm/v3/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/v3/cache/lru"
+	"github.com/prysmaticlabs/prysm/v3/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
@@ -21,6 +23,7 @@ import (
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/failover"
 	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
 	validatorClientFactory "github.com/prysmaticlabs/prysm/v3/validator/client/validator-client-factory"
 	"github.com/prysmaticlabs/prysm/v3/validator/db"
@@ -72,6 +75,12 @@ type ValidatorService struct {
 	graffiti              []byte
 	Web3SignerConfig      *remoteweb3signer.SetupConfig
 	proposerSettings      *validatorserviceconfig.ProposerSettings
+	proposerSettingsLock  sync.Mutex
+	settingsReload        *ProposerSettingsReload
+	loadedSettings        *validatorserviceconfig.ProposerSettings
+	failoverEndpoints     []string
+	failoverConns         []validatorHelpers.NodeConnection
+	healthCheckInterval   time.Duration
 }
 
 // Config for the validator service.
@@ -95,8 +104,11 @@ type Config struct {
 	Endpoint                   string
 	Web3SignerConfig           *remoteweb3signer.SetupConfig
 	ProposerSettings           *validatorserviceconfig.ProposerSettings
+	ProposerSettingsReload     *ProposerSettingsReload
 	BeaconApiEndpoint          string
 	BeaconApiTimeout           time.Duration
+	BeaconNodeFailover         bool
+	HealthCheckInterval        time.Duration
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -125,6 +137,9 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		graffitiStruct:        cfg.GraffitiStruct,
 		Web3SignerConfig:      cfg.Web3SignerConfig,
 		proposerSettings:      cfg.ProposerSettings,
+		settingsReload:        cfg.ProposerSettingsReload,
+		loadedSettings:        cfg.ProposerSettings.Clone(),
+		healthCheckInterval:   cfg.HealthCheckInterval,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -152,9 +167,44 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		cfg.BeaconApiTimeout,
 	)
 
+	if cfg.BeaconNodeFailover {
+		if err := s.dialFailoverNodes(ctx, dialOpts, cfg); err != nil {
+			return s, errors.Wrap(err, "could not connect to beacon nodes")
+		}
+	}
+
 	return s, nil
 }
 
+// dialFailoverNodes connects to each of the comma separated beacon node endpoints separately, for
+// the validator client to fail over between them.
+func (v *ValidatorService) dialFailoverNodes(ctx context.Context, dialOpts []grpc.DialOption, cfg *Config) error {
+	if features.Get().EnableBeaconRESTApi {
+		for _, endpoint := range strings.Split(cfg.BeaconApiEndpoint, ",") {
+			v.failoverEndpoints = append(v.failoverEndpoints, endpoint)
+			v.failoverConns = append(v.failoverConns, validatorHelpers.NewNodeConnection(
+				v.conn.GetGrpcClientConn(),
+				endpoint,
+				cfg.BeaconApiTimeout,
+			))
+		}
+		return nil
+	}
+	for _, endpoint := range strings.Split(v.endpoint, ",") {
+		grpcConn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+		if err != nil {
+			return errors.Wrapf(err, "could not dial beacon node %s", endpoint)
+		}
+		v.failoverEndpoints = append(v.failoverEndpoints, endpoint)
+		v.failoverConns = append(v.failoverConns, validatorHelpers.NewNodeConnection(
+			grpcConn,
+			cfg.BeaconApiEndpoint,
+			cfg.BeaconApiTimeout,
+		))
+	}
+	return nil
+}
+
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
@@ -185,9 +235,26 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
+	validatorClient := validatorClientFactory.NewValidatorClient(v.conn)
+	if len(v.failoverConns) > 0 {
+		nodes := make([]*failover.Node, len(v.failoverConns))
+		for i, conn := range v.failoverConns {
+			nodes[i] = &failover.Node{
+				Endpoint: v.failoverEndpoints[i],
+				Client:   validatorClientFactory.NewValidatorClient(conn),
+				Health:   validatorClientFactory.NewNodeHealthClient(conn),
+			}
+		}
+		validatorClient, err = failover.NewValidatorClient(v.ctx, nodes, v.healthCheckInterval)
+		if err != nil {
+			log.WithError(err).Error("Could not set up the failover between beacon nodes")
+			return
+		}
+	}
+
 	valStruct := &validator{
 		db:                             v.db,
-		validatorClient:                validatorClientFactory.NewValidatorClient(v.conn),
+		validatorClient:                validatorClient,
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn.GetGrpcClientConn()),
 		slashingProtectionClient:       ethpb.NewSlasherClient(v.conn.GetGrpcClientConn()),
 		node:                           ethpb.NewNodeClient(v.conn.GetGrpcClientConn()),
@@ -227,12 +294,23 @@ func (v *ValidatorService) Start() {
 
 	v.validator = valStruct
 	go run(v.ctx, v.validator)
+	if v.settingsReload != nil {
+		go v.watchProposerSettings(v.ctx)
+	}
 }
 
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	for _, conn := range v.failoverConns {
+		if conn.GetGrpcClientConn() == v.conn.GetGrpcClientConn() {
+			continue
+		}
+		if err := conn.GetGrpcClientConn().Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon node connection")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.GetGrpcClientConn().Close()
 	}
@@ -261,10 +339,20 @@ func (v *ValidatorService) ProposerSettings() *validatorserviceconfig.ProposerSe
 }
 
 func (v *ValidatorService) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
+	v.proposerSettingsLock.Lock()
+	defer v.proposerSettingsLock.Unlock()
 	v.proposerSettings = settings
 	v.validator.SetProposerSettings(settings)
 }
 
+// Graffiti returns the graffiti the validator with the given public key would propose a block with.
+func (v *ValidatorService) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
+	if v.validator == nil {
+		return nil, errors.New("validator is not ready")
+	}
+	return v.validator.Graffiti(ctx, pubKey)
+}
+
 // ConstructDialOptions constructs a list of grpc dial options
 func ConstructDialOptions(
 	maxCallRecvMsgSize int,
@@ -47,11 +47,13 @@ type FakeValidator struct {
 	PublicKey                         string
 	UpdateDutiesRet                   error
 	ProposerSettingsErr               error
+	GraffitiRet                       []byte
 	RolesAtRet                        []iface.ValidatorRole
 	Balances                          map[[fieldparams.BLSPubkeyLength]byte]uint64
 	IndexToPubkeyMap                  map[uint64][fieldparams.BLSPubkeyLength]byte
 	PubkeyToIndexMap                  map[[fieldparams.BLSPubkeyLength]byte]uint64
 	PubkeysToStatusesMap              map[[fieldparams.BLSPubkeyLength]byte]ethpb.ValidatorStatus
+	PushedProposerSettingsKeys        [][fieldparams.BLSPubkeyLength]byte
 	proposerSettings                  *validatorserviceconfig.ProposerSettings
 	Km                                keymanager.IKeymanager
 }
@@ -266,6 +268,15 @@ func (fv *FakeValidator) PushProposerSettings(_ context.Context, _ keymanager.IK
 	return nil
 }
 
+// PushProposerSettingsForKeys for mocking
+func (fv *FakeValidator) PushProposerSettingsForKeys(_ context.Context, _ keymanager.IKeymanager, pubkeys [][fieldparams.BLSPubkeyLength]byte) error {
+	if fv.ProposerSettingsErr != nil {
+		return fv.ProposerSettingsErr
+	}
+	fv.PushedProposerSettingsKeys = append(fv.PushedProposerSettingsKeys, pubkeys...)
+	return nil
+}
+
 // SetPubKeyToValidatorIndexMap for mocking
 func (_ *FakeValidator) SetPubKeyToValidatorIndexMap(_ context.Context, _ keymanager.IKeymanager) error {
 	return nil
@@ -285,3 +296,8 @@ func (f *FakeValidator) ProposerSettings() *validatorserviceconfig.ProposerSetti
 func (f *FakeValidator) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
 	f.proposerSettings = settings
 }
+
+// Graffiti for mocking
+func (f *FakeValidator) Graffiti(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
+	return f.GraffitiRet, nil
+}
@@ -9,12 +9,21 @@ import (
 )
 
 func NewValidatorClient(validatorConn validatorHelpers.NodeConnection) iface.ValidatorClient {
-	grpcClient := grpcApi.NewGrpcValidatorClient(validatorConn.GetGrpcClientConn())
 	featureFlags := features.Get()
 
 	if featureFlags.EnableBeaconRESTApi {
-		return beaconApi.NewBeaconApiValidatorClientWithFallback(validatorConn.GetBeaconApiUrl(), validatorConn.GetBeaconApiTimeout(), grpcClient)
+		return beaconApi.NewBeaconApiValidatorClient(validatorConn.GetBeaconApiUrl(), validatorConn.GetBeaconApiTimeout())
 	} else {
-		return grpcClient
+		return grpcApi.NewGrpcValidatorClient(validatorConn.GetGrpcClientConn())
+	}
+}
+
+func NewNodeHealthClient(validatorConn validatorHelpers.NodeConnection) iface.NodeHealthClient {
+	featureFlags := features.Get()
+
+	if featureFlags.EnableBeaconRESTApi {
+		return beaconApi.NewBeaconApiNodeHealthClient(validatorConn.GetBeaconApiUrl(), validatorConn.GetBeaconApiTimeout())
+	} else {
+		return grpcApi.NewGrpcNodeHealthClient(validatorConn.GetGrpcClientConn())
 	}
 }
@@ -73,6 +73,9 @@ type validator struct {
 	highestValidSlotLock               sync.Mutex
 	prevBalanceLock                    sync.RWMutex
 	slashableKeysLock                  sync.RWMutex
+	pushProposerSettingsLock           sync.Mutex
+	proposerSettingsLock               sync.RWMutex
+	graffitiOrderedIndexLock           sync.Mutex
 	eipImportBlacklistedPublicKeys     map[[fieldparams.BLSPubkeyLength]byte]bool
 	walletInitializedFeed              *event.Feed
 	attLogs                            map[[32]byte]*attSubmitted
@@ -144,7 +147,11 @@ func (v *validator) WaitForKeymanagerInitialization(ctx context.Context) error {
 			if v.Web3SignerConfig != nil {
 				v.Web3SignerConfig.GenesisValidatorsRoot = genesisRoot
 			}
-			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{ListenForChanges: true, Web3SignerConfig: v.Web3SignerConfig})
+			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{
+				ListenForChanges:     true,
+				Web3SignerConfig:     v.Web3SignerConfig,
+				SlashingProtectionDB: v.db,
+			})
 			if err != nil {
 				return errors.Wrap(err, "could not initialize key manager")
 			}
@@ -626,6 +633,7 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 	subscribeSlots := make([]types.Slot, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
 	subscribeCommitteeIndices := make([]types.CommitteeIndex, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
 	subscribeIsAggregator := make([]bool, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
+	subscribeValidatorIndices := make([]types.ValidatorIndex, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
 	alreadySubscribed := make(map[[64]byte]bool)
 
 	for _, duty := range res.CurrentEpochDuties {
@@ -650,6 +658,7 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 			subscribeSlots = append(subscribeSlots, attesterSlot)
 			subscribeCommitteeIndices = append(subscribeCommitteeIndices, committeeIndex)
 			subscribeIsAggregator = append(subscribeIsAggregator, aggregator)
+			subscribeValidatorIndices = append(subscribeValidatorIndices, duty.ValidatorIndex)
 		}
 	}
 
@@ -674,14 +683,18 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 			subscribeSlots = append(subscribeSlots, attesterSlot)
 			subscribeCommitteeIndices = append(subscribeCommitteeIndices, committeeIndex)
 			subscribeIsAggregator = append(subscribeIsAggregator, aggregator)
+			subscribeValidatorIndices = append(subscribeValidatorIndices, duty.ValidatorIndex)
 		}
 	}
 
-	_, err := v.validatorClient.SubscribeCommitteeSubnets(ctx, &ethpb.CommitteeSubnetsSubscribeRequest{
-		Slots:        subscribeSlots,
-		CommitteeIds: subscribeCommitteeIndices,
-		IsAggregator: subscribeIsAggregator,
-	})
+	_, err := v.validatorClient.SubscribeCommitteeSubnets(ctx,
+		&ethpb.CommitteeSubnetsSubscribeRequest{
+			Slots:        subscribeSlots,
+			CommitteeIds: subscribeCommitteeIndices,
+			IsAggregator: subscribeIsAggregator,
+		},
+		subscribeValidatorIndices,
+	)
 
 	return err
 }
@@ -967,10 +980,14 @@ func (v *validator) logDuties(slot types.Slot, duties []*ethpb.DutiesResponse_Du
 }
 
 func (v *validator) ProposerSettings() *validatorserviceconfig.ProposerSettings {
+	v.proposerSettingsLock.RLock()
+	defer v.proposerSettingsLock.RUnlock()
 	return v.proposerSettings
 }
 
 func (v *validator) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
+	v.proposerSettingsLock.Lock()
+	defer v.proposerSettingsLock.Unlock()
 	v.proposerSettings = settings
 }
 
@@ -992,6 +1009,31 @@ func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKey
 		log.Info("No imported public keys. Skipping prepare proposer routine")
 		return nil
 	}
+	return v.pushProposerSettings(ctx, km, pubkeys)
+}
+
+// PushProposerSettingsForKeys calls the prepareBeaconProposer RPC and the register validator API like PushProposerSettings,
+// but only for the validators with the given public keys.
+func (v *validator) PushProposerSettingsForKeys(ctx context.Context, km keymanager.IKeymanager, pubkeys [][fieldparams.BLSPubkeyLength]byte) error {
+	if km == nil {
+		return errors.New("keymanager is nil when calling PrepareBeaconProposer")
+	}
+
+	deadline := v.SlotDeadline(slots.RoundUpToNearestEpoch(slots.CurrentSlot(v.genesisTime)))
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	if len(pubkeys) == 0 {
+		return nil
+	}
+	return v.pushProposerSettings(ctx, km, pubkeys)
+}
+
+func (v *validator) pushProposerSettings(ctx context.Context, km keymanager.IKeymanager, pubkeys [][fieldparams.BLSPubkeyLength]byte) error {
+	// Settings may be pushed for all validators each epoch while they are pushed for those whose settings were reloaded.
+	v.pushProposerSettingsLock.Lock()
+	defer v.pushProposerSettingsLock.Unlock()
+
 	proposerReqs, err := v.buildPrepProposerReqs(ctx, pubkeys)
 	if err != nil {
 		return err
@@ -1041,18 +1083,29 @@ func (v *validator) buildPrepProposerReqs(ctx context.Context, pubkeys [][fieldp
 			v.pubkeyToValidatorIndex[k] = i
 		}
 		feeRecipient := common.HexToAddress(params.BeaconConfig().EthBurnAddressHex)
+		var relays []string
 		if v.ProposerSettings().DefaultConfig != nil {
 			feeRecipient = v.ProposerSettings().DefaultConfig.FeeRecipient // Use cli config for fee recipient.
+			if config := v.ProposerSettings().DefaultConfig.BuilderConfig; config != nil && config.Enabled {
+				relays = config.Relays // Use cli config for relays.
+			}
 		}
 		if v.ProposerSettings().ProposeConfig != nil {
 			config, ok := v.ProposerSettings().ProposeConfig[k]
 			if ok && config != nil {
 				feeRecipient = config.FeeRecipient // Use file config for fee recipient.
+				if config.BuilderConfig != nil {
+					relays = nil
+					if config.BuilderConfig.Enabled {
+						relays = config.BuilderConfig.Relays // Use file config for relays.
+					}
+				}
 			}
 		}
 		prepareProposerReqs = append(prepareProposerReqs, &ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
 			ValidatorIndex: validatorIndex,
 			FeeRecipient:   feeRecipient[:],
+			Relays:         relays,
 		})
 		if hexutil.Encode(feeRecipient.Bytes()) == params.BeaconConfig().EthBurnAddressHex {
 			log.WithFields(logrus.Fields{
@@ -539,7 +539,8 @@ func TestUpdateDuties_OK(t *testing.T) {
 	client.EXPECT().SubscribeCommitteeSubnets(
 		gomock.Any(),
 		gomock.Any(),
-	).DoAndReturn(func(_ context.Context, _ *ethpb.CommitteeSubnetsSubscribeRequest) (*emptypb.Empty, error) {
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ *ethpb.CommitteeSubnetsSubscribeRequest, _ []types.ValidatorIndex) (*emptypb.Empty, error) {
 		wg.Done()
 		return nil, nil
 	})
@@ -594,7 +595,8 @@ func TestUpdateDuties_OK_FilterBlacklistedPublicKeys(t *testing.T) {
 	client.EXPECT().SubscribeCommitteeSubnets(
 		gomock.Any(),
 		gomock.Any(),
-	).DoAndReturn(func(_ context.Context, _ *ethpb.CommitteeSubnetsSubscribeRequest) (*emptypb.Empty, error) {
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ *ethpb.CommitteeSubnetsSubscribeRequest, _ []types.ValidatorIndex) (*emptypb.Empty, error) {
 		wg.Done()
 		return nil, nil
 	})
@@ -1451,8 +1453,8 @@ func TestValidator_PushProposerSettings(t *testing.T) {
 				}, nil)
 				client.EXPECT().PrepareBeaconProposer(gomock.Any(), &ethpb.PrepareBeaconProposerRequest{
 					Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
-						{FeeRecipient: common.HexToAddress("0x055Fb65722E7b2455043BFEBf6177F1D2e9738D9").Bytes(), ValidatorIndex: 1},
-						{FeeRecipient: common.HexToAddress(defaultFeeHex).Bytes(), ValidatorIndex: 2},
+						{FeeRecipient: common.HexToAddress("0x055Fb65722E7b2455043BFEBf6177F1D2e9738D9").Bytes(), ValidatorIndex: 1, Relays: []string{"https://relay-a.example.com"}},
+						{FeeRecipient: common.HexToAddress(defaultFeeHex).Bytes(), ValidatorIndex: 2, Relays: []string{"https://relay-b.example.com"}},
 					},
 				}).Return(nil, nil)
 				config[keys[0]] = &validatorserviceconfig.ProposerOption{
@@ -1460,6 +1462,7 @@ func TestValidator_PushProposerSettings(t *testing.T) {
 					BuilderConfig: &validatorserviceconfig.BuilderConfig{
 						Enabled:  true,
 						GasLimit: 40000000,
+						Relays:   []string{"https://relay-a.example.com"},
 					},
 				}
 				v.SetProposerSettings(&validatorserviceconfig.ProposerSettings{
@@ -1469,6 +1472,7 @@ func TestValidator_PushProposerSettings(t *testing.T) {
 						BuilderConfig: &validatorserviceconfig.BuilderConfig{
 							Enabled:  true,
 							GasLimit: 35000000,
+							Relays:   []string{"https://relay-b.example.com"},
 						},
 					},
 				})
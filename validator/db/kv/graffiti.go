@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	bolt "go.etcd.io/bbolt"
 )
@@ -37,3 +38,35 @@ func (s *Store) GraffitiOrderedIndex(_ context.Context, fileHash [32]byte) (uint
 	})
 	return orderedIndex, err
 }
+
+// SaveGraffitiForPubKey writes the graffiti set for an individual validator public key to the db.
+func (s *Store) SaveGraffitiForPubKey(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(graffitiBucket)
+		return bkt.Put(pubKey[:], graffiti)
+	})
+}
+
+// GraffitiForPubKey fetches the graffiti set for an individual validator public key.
+// A nil graffiti is returned if none was set.
+func (s *Store) GraffitiForPubKey(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
+	var graffiti []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(graffitiBucket)
+		g := bkt.Get(pubKey[:])
+		if g != nil {
+			graffiti = make([]byte, len(g))
+			copy(graffiti, g)
+		}
+		return nil
+	})
+	return graffiti, err
+}
+
+// DeleteGraffitiForPubKey removes the graffiti set for an individual validator public key.
+func (s *Store) DeleteGraffitiForPubKey(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(graffitiBucket)
+		return bkt.Delete(pubKey[:])
+	})
+}
@@ -58,3 +58,35 @@ func TestStore_GraffitiOrderedIndex_ReadAndWrite(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_GraffitiForPubKey(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	got, err := db.GraffitiForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(got))
+
+	require.NoError(t, db.SaveGraffitiForPubKey(ctx, pubKey, []byte("api graffiti")))
+	got, err = db.GraffitiForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("api graffiti"), got)
+
+	// Ordered index bookkeeping is unaffected by per key graffiti.
+	fileHash := hash.Hash([]byte("file"))
+	_, err = db.GraffitiOrderedIndex(ctx, fileHash)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGraffitiOrderedIndex(ctx, 5))
+	index, err := db.GraffitiOrderedIndex(ctx, fileHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), index)
+	got, err = db.GraffitiForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("api graffiti"), got)
+
+	require.NoError(t, db.DeleteGraffitiForPubKey(ctx, pubKey))
+	got, err = db.GraffitiForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(got))
+}
@@ -31,7 +31,7 @@ var (
 	// Migrations
 	migrationsBucket = []byte("migrations")
 
-	// Graffiti
+	// Graffiti, also keyed by validator public key for graffiti set through the keymanager API.
 	graffitiBucket = []byte("graffiti")
 
 	// Graffiti ordered index and hash keys
@@ -0,0 +1,35 @@
+/*
+Package threshold defines a keymanager implementation signing with validator keys which are
+split among several remote signers using Shamir's secret sharing, so that no single signer
+holds a validator key.
+
+Each signer is a remote signer server, as used by the remote keymanager, holding one share of
+each validator key as if it were a regular key. To sign for a validator, the keymanager requests
+a signature from every signer holding a share of the validator key, signing with the share
+public key. The signatures of the first signers reaching the threshold are then combined into
+the signature of the validator key. Blocks and attestations are checked against the local
+slashing protection database before any signature share is requested, so that the signers
+never see a slashable signing request from this keymanager.
+
+The keymanager is configured with a JSON file listing the threshold, the signers, and the
+public keys of the validators along with those of their shares:
+
+	{
+	  "threshold": 2,
+	  "signers": [
+	    {"index": 1, "remote_address": "signer-1:4000", "remote_cert": {...}},
+	    {"index": 2, "remote_address": "signer-2:4000", "remote_cert": {...}},
+	    {"index": 3, "remote_address": "signer-3:4000", "remote_cert": {...}}
+	  ],
+	  "validators": [
+	    {
+	      "public_key": "0x...",
+	      "share_public_keys": {"1": "0x...", "2": "0x...", "3": "0x..."}
+	    }
+	  ]
+	}
+
+The shares and the configuration are generated from an EIP-2335 keystore with
+`prysmctl threshold split`.
+*/
+package threshold
@@ -0,0 +1,358 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
+	remoteutils "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-utils"
+)
+
+// KeymanagerOpts for a threshold keymanager.
+type KeymanagerOpts struct {
+	// Threshold is the number of signature shares needed to sign for a validator.
+	Threshold  uint64             `json:"threshold"`
+	Signers    []*SignerConfig    `json:"signers"`
+	Validators []*ValidatorConfig `json:"validators"`
+}
+
+// SignerConfig defines a remote signer holding one share of the validator keys.
+type SignerConfig struct {
+	// Index of the share held by the signer, as output when splitting the keys.
+	Index             uint64                    `json:"index"`
+	RemoteAddr        string                    `json:"remote_address"`
+	RemoteCertificate *remote.CertificateConfig `json:"remote_cert"`
+}
+
+// ValidatorConfig defines a validator key split among the signers, with the public keys
+// of its shares by signer index.
+type ValidatorConfig struct {
+	PublicKey       string            `json:"public_key"`
+	SharePublicKeys map[uint64]string `json:"share_public_keys"`
+}
+
+// SetupConfig includes configuration values for initializing a threshold keymanager.
+type SetupConfig struct {
+	Opts           *KeymanagerOpts
+	MaxMessageSize int
+	// SlashingProtectionDB is checked before requesting any signature share of
+	// blocks and attestations. Blocks and attestations are not signed without it.
+	SlashingProtectionDB SlashingProtectionDB
+}
+
+type validator struct {
+	publicKey       bls.PublicKey
+	sharePublicKeys map[uint64][]byte
+}
+
+// Keymanager implementation combining the signature shares of remote signers, each holding
+// a share of the validator keys.
+type Keymanager struct {
+	opts                *KeymanagerOpts
+	signers             map[uint64]keymanager.Signer
+	validators          map[[fieldparams.BLSPubkeyLength]byte]*validator
+	orderedPubKeys      [][fieldparams.BLSPubkeyLength]byte
+	protection          *slashingProtection
+	accountsChangedFeed *event.Feed
+}
+
+// NewKeymanager instantiates a new threshold keymanager, connecting to the signers of the configuration options.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if err := cfg.Opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid threshold keymanager configuration")
+	}
+	signers := make(map[uint64]keymanager.Signer, len(cfg.Opts.Signers))
+	for _, s := range cfg.Opts.Signers {
+		km, err := remote.NewKeymanager(ctx, &remote.SetupConfig{
+			Opts: &remote.KeymanagerOpts{
+				RemoteCertificate: s.RemoteCertificate,
+				RemoteAddr:        s.RemoteAddr,
+			},
+			MaxMessageSize: cfg.MaxMessageSize,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not connect to signer %d", s.Index)
+		}
+		signers[s.Index] = km
+	}
+	return newKeymanager(cfg.Opts, signers, cfg.SlashingProtectionDB)
+}
+
+func newKeymanager(opts *KeymanagerOpts, signers map[uint64]keymanager.Signer, db SlashingProtectionDB) (*Keymanager, error) {
+	km := &Keymanager{
+		opts:                opts,
+		signers:             signers,
+		validators:          make(map[[fieldparams.BLSPubkeyLength]byte]*validator, len(opts.Validators)),
+		orderedPubKeys:      make([][fieldparams.BLSPubkeyLength]byte, 0, len(opts.Validators)),
+		protection:          &slashingProtection{db: db},
+		accountsChangedFeed: new(event.Feed),
+	}
+	for _, v := range opts.Validators {
+		pubKey, err := bls.PublicKeyFromBytes(hexDecode(v.PublicKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid validator public key %s", v.PublicKey)
+		}
+		val := &validator{
+			publicKey:       pubKey,
+			sharePublicKeys: make(map[uint64][]byte, len(v.SharePublicKeys)),
+		}
+		for index, sharePubKey := range v.SharePublicKeys {
+			val.sharePublicKeys[index] = hexDecode(sharePubKey)
+		}
+		key := bytesutil.ToBytes48(pubKey.Marshal())
+		km.validators[key] = val
+		km.orderedPubKeys = append(km.orderedPubKeys, key)
+	}
+	sort.Slice(km.orderedPubKeys, func(i, j int) bool {
+		return bytes.Compare(km.orderedPubKeys[i][:], km.orderedPubKeys[j][:]) == -1
+	})
+	return km, nil
+}
+
+// Validate the keymanager options: the threshold must be reachable with the configured signers,
+// and every validator must have a share for enough of the signers.
+func (opts *KeymanagerOpts) Validate() error {
+	if opts == nil {
+		return errors.New("no configuration")
+	}
+	if opts.Threshold == 0 {
+		return errors.New("threshold must be positive")
+	}
+	if uint64(len(opts.Signers)) < opts.Threshold {
+		return fmt.Errorf("threshold %d is greater than the number of signers %d", opts.Threshold, len(opts.Signers))
+	}
+	signers := make(map[uint64]bool, len(opts.Signers))
+	for _, s := range opts.Signers {
+		if s.Index == 0 {
+			return errors.New("signer index must be positive")
+		}
+		if signers[s.Index] {
+			return fmt.Errorf("duplicate signer index %d", s.Index)
+		}
+		if s.RemoteAddr == "" {
+			return fmt.Errorf("signer %d has no remote address", s.Index)
+		}
+		signers[s.Index] = true
+	}
+	validators := make(map[string]bool, len(opts.Validators))
+	for _, v := range opts.Validators {
+		pubKey := hexDecode(v.PublicKey)
+		if len(pubKey) != fieldparams.BLSPubkeyLength {
+			return fmt.Errorf("invalid validator public key %s", v.PublicKey)
+		}
+		if validators[string(pubKey)] {
+			return fmt.Errorf("duplicate validator public key %s", v.PublicKey)
+		}
+		validators[string(pubKey)] = true
+		if uint64(len(v.SharePublicKeys)) < opts.Threshold {
+			return fmt.Errorf("validator %s has %d shares, fewer than the threshold %d", v.PublicKey, len(v.SharePublicKeys), opts.Threshold)
+		}
+		for index, sharePubKey := range v.SharePublicKeys {
+			if !signers[index] {
+				return fmt.Errorf("validator %s has a share for unknown signer %d", v.PublicKey, index)
+			}
+			if len(hexDecode(sharePubKey)) != fieldparams.BLSPubkeyLength {
+				return fmt.Errorf("invalid share public key %s of validator %s", sharePubKey, v.PublicKey)
+			}
+		}
+	}
+	return nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.WithError(err).Error("Could not close keymanager config file")
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	for _, s := range opts.Signers {
+		if s.RemoteCertificate == nil {
+			s.RemoteCertificate = &remote.CertificateConfig{RequireTls: true}
+		}
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// KeymanagerOpts for the threshold keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys that should be used to validate with.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(km.orderedPubKeys))
+	copy(pubKeys, km.orderedPubKeys)
+	return pubKeys, nil
+}
+
+// Sign signs a message for a validator key by combining the signature shares of the signers. Blocks and
+// attestations are checked against the local slashing protection before any share is requested.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	v, ok := km.validators[pubKey]
+	if !ok {
+		return nil, fmt.Errorf("no threshold configuration for validator %#x", req.PublicKey)
+	}
+	if len(req.SigningRoot) != fieldparams.RootLength {
+		return nil, errors.New("invalid signing root")
+	}
+	if err := km.protection.check(ctx, pubKey, req); err != nil {
+		return nil, err
+	}
+	return km.combineShares(ctx, v, req)
+}
+
+type signatureShare struct {
+	index uint64
+	sig   bls.Signature
+	err   error
+}
+
+// combineShares requests the signature shares of the validator from all its signers at once, combining
+// the signatures of the first signers reaching the threshold.
+func (km *Keymanager) combineShares(ctx context.Context, v *validator, req *validatorpb.SignRequest) (bls.Signature, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	shares := make(chan *signatureShare, len(v.sharePublicKeys))
+	for index, sharePubKey := range v.sharePublicKeys {
+		go func(index uint64, sharePubKey []byte) {
+			sig, err := km.signers[index].Sign(ctx, &validatorpb.SignRequest{
+				PublicKey:       sharePubKey,
+				SigningRoot:     req.SigningRoot,
+				SignatureDomain: req.SignatureDomain,
+				Object:          req.Object,
+				SigningSlot:     req.SigningSlot,
+			})
+			if err == nil && !verify(sig, sharePubKey, req.SigningRoot) {
+				err = errors.New("invalid signature share")
+			}
+			shares <- &signatureShare{index: index, sig: sig, err: err}
+		}(index, sharePubKey)
+	}
+
+	indices := make([]uint64, 0, km.opts.Threshold)
+	sigs := make([]bls.Signature, 0, km.opts.Threshold)
+	var errs []error
+	for range v.sharePublicKeys {
+		share := <-shares
+		if share.err != nil {
+			log.WithError(share.err).WithField("signer", share.index).Warn("Could not get signature share")
+			errs = append(errs, errors.Wrapf(share.err, "signer %d", share.index))
+			continue
+		}
+		indices = append(indices, share.index)
+		sigs = append(sigs, share.sig)
+		if uint64(len(sigs)) < km.opts.Threshold {
+			continue
+		}
+		sig, err := bls.CombineSignatureShares(indices, sigs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not combine signature shares")
+		}
+		if !sig.Verify(v.publicKey, req.SigningRoot) {
+			return nil, errors.New("combined signature is invalid")
+		}
+		return sig, nil
+	}
+	return nil, fmt.Errorf(
+		"got %d signature shares, fewer than the threshold %d: %v", len(sigs), km.opts.Threshold, errs,
+	)
+}
+
+func verify(sig bls.Signature, pubKey, msg []byte) bool {
+	pk, err := bls.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return false
+	}
+	return sig.Verify(pk, msg)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime. The public keys of the threshold keymanager
+// are those of its configuration, which does not change at runtime.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores is not supported for the threshold keymanager type.
+func (*Keymanager) ExtractKeystores(
+	_ context.Context, _ []bls.PublicKey, _ string,
+) ([]*keymanager.Keystore, error) {
+	return nil, errors.New("extracting keys not supported for a threshold keymanager")
+}
+
+// DeleteKeystores is not supported for the threshold keymanager type.
+func (*Keymanager) DeleteKeystores(context.Context, [][]byte) ([]*ethpbservice.DeletedKeystoreStatus, error) {
+	return nil, errors.New("Wrong wallet type: threshold. Only Imported or Derived wallets can delete accounts")
+}
+
+// ListKeymanagerAccounts lists the validators of the threshold keymanager along with its signers.
+func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, cfg keymanager.ListKeymanagerAccountConfig) error {
+	au := aurora.NewAurora(true)
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("threshold signer").Bold())
+	fmt.Printf(
+		"(configuration file path) %s\n",
+		au.BrightGreen(filepath.Join(cfg.WalletAccountsDir, cfg.KeymanagerConfigFileName)).Bold(),
+	)
+	fmt.Println(" ")
+	fmt.Printf("%s\n", au.BrightGreen("Configuration options").Bold())
+	fmt.Printf("%s: %d of %d\n", au.BrightMagenta("Threshold"), km.opts.Threshold, len(km.opts.Signers))
+	for _, s := range km.opts.Signers {
+		fmt.Printf("%s %d: %s\n", au.BrightMagenta("Signer"), s.Index, s.RemoteAddr)
+	}
+	fmt.Println(" ")
+	validatingPubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(validatingPubKeys) == 1 {
+		fmt.Print("Showing 1 validator account\n")
+	} else if len(validatingPubKeys) == 0 {
+		fmt.Print("No accounts found\n")
+		return nil
+	} else {
+		fmt.Printf("Showing %d validator accounts\n", len(validatingPubKeys))
+	}
+	remoteutils.DisplayRemotePublicKeys(validatingPubKeys)
+	return nil
+}
+
+// hexDecode decodes a 0x-prefixed hex string, returning nil if it is not valid hex.
+func hexDecode(s string) []byte {
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil
+	}
+	return b
+}
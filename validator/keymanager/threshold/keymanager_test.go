@@ -0,0 +1,264 @@
+package threshold
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+)
+
+// shareSigner is a remote signer holding secret key shares.
+type shareSigner struct {
+	keys map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
+	err  error
+	lock sync.Mutex
+	// requested are the signing roots the signer was requested to sign.
+	requested map[[32]byte]bool
+}
+
+func (s *shareSigner) Sign(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.requested[bytesutil.ToBytes32(req.SigningRoot)] = true
+	if s.err != nil {
+		return nil, s.err
+	}
+	key, ok := s.keys[bytesutil.ToBytes48(req.PublicKey)]
+	if !ok {
+		return nil, errors.New("unknown key")
+	}
+	return key.Sign(req.SigningRoot), nil
+}
+
+// setError makes the signer fail with the error, or sign again if it is nil.
+func (s *shareSigner) setError(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.err = err
+}
+
+// setKey makes the signer sign with the wrong key.
+func (s *shareSigner) setKey(key bls.SecretKey) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for k := range s.keys {
+		s.keys[k] = key
+	}
+}
+
+// testKeymanager returns a 2-of-3 threshold keymanager for a validator key, with signers holding its shares.
+func testKeymanager(t *testing.T, db SlashingProtectionDB) (*Keymanager, bls.SecretKey, []*shareSigner) {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(sk, 2, 3)
+	require.NoError(t, err)
+
+	opts := &KeymanagerOpts{Threshold: 2}
+	v := &ValidatorConfig{
+		PublicKey:       hexutil.Encode(sk.PublicKey().Marshal()),
+		SharePublicKeys: make(map[uint64]string),
+	}
+	signers := make(map[uint64]keymanager.Signer)
+	shareSigners := make([]*shareSigner, len(shares))
+	for i, share := range shares {
+		index := uint64(i + 1)
+		opts.Signers = append(opts.Signers, &SignerConfig{Index: index, RemoteAddr: "localhost:4000"})
+		v.SharePublicKeys[index] = hexutil.Encode(share.PublicKey().Marshal())
+		shareSigners[i] = &shareSigner{
+			keys: map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey{
+				bytesutil.ToBytes48(share.PublicKey().Marshal()): share,
+			},
+			requested: make(map[[32]byte]bool),
+		}
+		signers[index] = shareSigners[i]
+	}
+	opts.Validators = []*ValidatorConfig{v}
+	require.NoError(t, opts.Validate())
+	km, err := newKeymanager(opts, signers, db)
+	require.NoError(t, err)
+	return km, sk, shareSigners
+}
+
+func signingRoot(b byte) []byte {
+	return bytesutil.PadTo([]byte{b}, 32)
+}
+
+// assertNotRequested checks that no signer was requested to sign the signing root.
+func assertNotRequested(t *testing.T, signers []*shareSigner, root []byte) {
+	for i, s := range signers {
+		s.lock.Lock()
+		assert.Equal(t, false, s.requested[bytesutil.ToBytes32(root)], "Signer %d was requested a slashable signature", i+1)
+		s.lock.Unlock()
+	}
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctx := context.Background()
+	km, sk, signers := testKeymanager(t, nil)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(sk.PublicKey().Marshal())}, pubKeys)
+
+	req := &validatorpb.SignRequest{
+		PublicKey:   sk.PublicKey().Marshal(),
+		SigningRoot: signingRoot(1),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	}
+	sig, err := km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, sk.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+
+	// A signer may fail as long as the threshold is reached.
+	signers[0].setError(errors.New("signer down"))
+	sig, err = km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, sk.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+
+	signers[1].setError(errors.New("signer down"))
+	_, err = km.Sign(ctx, req)
+	require.ErrorContains(t, "got 1 signature shares, fewer than the threshold 2", err)
+
+	// Invalid signature shares are not combined.
+	signers[1].setError(nil)
+	other, err := bls.RandKey()
+	require.NoError(t, err)
+	signers[1].setKey(other)
+	_, err = km.Sign(ctx, req)
+	require.ErrorContains(t, "invalid signature share", err)
+
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: other.PublicKey().Marshal(), SigningRoot: signingRoot(1)})
+	require.ErrorContains(t, "no threshold configuration for validator", err)
+}
+
+func TestKeymanager_Sign_SlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	km, sk, signers := testKeymanager(t, nil)
+	pubKey := sk.PublicKey().Marshal()
+	block := &validatorpb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: signingRoot(1),
+		Object:      &validatorpb.SignRequest_BlockBellatrix{BlockBellatrix: &ethpb.BeaconBlockBellatrix{Slot: 10}},
+	}
+	_, err := km.Sign(ctx, block)
+	require.ErrorContains(t, "no slashing protection database", err)
+	assertNotRequested(t, signers, block.SigningRoot)
+
+	km, sk, signers = testKeymanager(t, nil)
+	pubKey = sk.PublicKey().Marshal()
+	km.protection.db = dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(pubKey)})
+	block.PublicKey = pubKey
+	_, err = km.Sign(ctx, block)
+	require.NoError(t, err)
+	// Signing the same block again is allowed.
+	_, err = km.Sign(ctx, block)
+	require.NoError(t, err)
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: signingRoot(2),
+		Object:      &validatorpb.SignRequest_BlockBellatrix{BlockBellatrix: &ethpb.BeaconBlockBellatrix{Slot: 10}},
+	})
+	require.ErrorContains(t, "attempted to sign a double proposal", err)
+	assertNotRequested(t, signers, signingRoot(2))
+
+	att := &ethpb.AttestationData{
+		Source: &ethpb.Checkpoint{Epoch: 1},
+		Target: &ethpb.Checkpoint{Epoch: 2},
+	}
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: signingRoot(3),
+		Object:      &validatorpb.SignRequest_AttestationData{AttestationData: att},
+	})
+	require.NoError(t, err)
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: signingRoot(4),
+		Object:      &validatorpb.SignRequest_AttestationData{AttestationData: att},
+	})
+	require.ErrorContains(t, "attempted to make slashable attestation", err)
+	assertNotRequested(t, signers, signingRoot(4))
+}
+
+func TestKeymanagerOpts_Validate(t *testing.T) {
+	pubKey := hexutil.Encode(make([]byte, fieldparams.BLSPubkeyLength))
+	signers := []*SignerConfig{
+		{Index: 1, RemoteAddr: "a"},
+		{Index: 2, RemoteAddr: "b"},
+	}
+	tests := []struct {
+		name    string
+		opts    *KeymanagerOpts
+		wantErr string
+	}{
+		{
+			name:    "nil",
+			wantErr: "no configuration",
+		},
+		{
+			name:    "zero threshold",
+			opts:    &KeymanagerOpts{Signers: signers},
+			wantErr: "threshold must be positive",
+		},
+		{
+			name:    "too few signers",
+			opts:    &KeymanagerOpts{Threshold: 3, Signers: signers},
+			wantErr: "threshold 3 is greater than the number of signers 2",
+		},
+		{
+			name: "duplicate signer",
+			opts: &KeymanagerOpts{Threshold: 2, Signers: []*SignerConfig{
+				{Index: 1, RemoteAddr: "a"},
+				{Index: 1, RemoteAddr: "b"},
+			}},
+			wantErr: "duplicate signer index 1",
+		},
+		{
+			name: "too few shares",
+			opts: &KeymanagerOpts{Threshold: 2, Signers: signers, Validators: []*ValidatorConfig{
+				{PublicKey: pubKey, SharePublicKeys: map[uint64]string{1: pubKey}},
+			}},
+			wantErr: "has 1 shares, fewer than the threshold 2",
+		},
+		{
+			name: "unknown signer",
+			opts: &KeymanagerOpts{Threshold: 2, Signers: signers, Validators: []*ValidatorConfig{
+				{PublicKey: pubKey, SharePublicKeys: map[uint64]string{1: pubKey, 3: pubKey}},
+			}},
+			wantErr: "has a share for unknown signer 3",
+		},
+		{
+			name: "invalid public key",
+			opts: &KeymanagerOpts{Threshold: 2, Signers: signers, Validators: []*ValidatorConfig{
+				{PublicKey: "0x1234", SharePublicKeys: map[uint64]string{1: pubKey, 2: pubKey}},
+			}},
+			wantErr: "invalid validator public key 0x1234",
+		},
+		{
+			name: "valid",
+			opts: &KeymanagerOpts{Threshold: 2, Signers: signers, Validators: []*ValidatorConfig{
+				{PublicKey: pubKey, SharePublicKeys: map[uint64]string{1: pubKey, 2: pubKey}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package threshold
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/slashings"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+)
+
+var (
+	errNoSlashingProtection = errors.New("no slashing protection database, refusing to sign")
+	errDoubleProposal       = errors.New("attempted to sign a double proposal, block rejected by local protection")
+	errSlashableAttestation = errors.New("attempted to make slashable attestation, rejected by local slashing protection")
+)
+
+// SlashingProtectionDB is the part of the validator database used to enforce
+// slashing protection before requesting signature shares.
+type SlashingProtectionDB interface {
+	ProposalHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) ([32]byte, bool, error)
+	LowestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Slot, bool, error)
+	SaveProposalHistoryForSlot(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot []byte) error
+	LowestSignedSourceEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error)
+	LowestSignedTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error)
+	SigningRootAtTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, target types.Epoch) ([32]byte, error)
+	CheckSlashableAttestation(
+		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+	) (kv.SlashingKind, error)
+	SaveAttestationForPubKey(
+		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+	) error
+}
+
+// slashingProtection records the blocks and attestations to sign in the slashing protection
+// database, refusing the slashable ones. The validator client runs the same checks after signing,
+// which pass for the already recorded signing roots.
+type slashingProtection struct {
+	db SlashingProtectionDB
+	// lock ensures that no two concurrent requests for a validator both pass the checks
+	// before either of them is recorded.
+	lock sync.Mutex
+}
+
+// check returns an error if the request is for a slashable block or attestation, and
+// records it otherwise. Other requests are not slashable and always pass.
+func (p *slashingProtection) check(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, req *validatorpb.SignRequest) error {
+	var slot types.Slot
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		slot = o.Block.Slot
+	case *validatorpb.SignRequest_BlockAltair:
+		slot = o.BlockAltair.Slot
+	case *validatorpb.SignRequest_BlockBellatrix:
+		slot = o.BlockBellatrix.Slot
+	case *validatorpb.SignRequest_BlindedBlockBellatrix:
+		slot = o.BlindedBlockBellatrix.Slot
+	case *validatorpb.SignRequest_BlockCapella:
+		slot = o.BlockCapella.Slot
+	case *validatorpb.SignRequest_BlindedBlockCapella:
+		slot = o.BlindedBlockCapella.Slot
+	case *validatorpb.SignRequest_AttestationData:
+		return p.checkAttestation(ctx, pubKey, o.AttestationData, bytesutil.ToBytes32(req.SigningRoot))
+	default:
+		return nil
+	}
+	return p.checkProposal(ctx, pubKey, slot, bytesutil.ToBytes32(req.SigningRoot))
+}
+
+func (p *slashingProtection) checkProposal(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot [32]byte,
+) error {
+	if p.db == nil {
+		return errNoSlashingProtection
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	prevSigningRoot, proposalAtSlotExists, err := p.db.ProposalHistoryForSlot(ctx, pubKey, slot)
+	if err != nil {
+		return errors.Wrap(err, "failed to get proposal history")
+	}
+	lowestSignedProposalSlot, lowestProposalExists, err := p.db.LowestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	signingRootIsDifferent := prevSigningRoot == params.BeaconConfig().ZeroHash || prevSigningRoot != signingRoot
+	if proposalAtSlotExists && signingRootIsDifferent {
+		return errDoubleProposal
+	}
+	// Based on EIP3076, refuse to sign any proposal with slot less than or equal to the
+	// minimum signed proposal present in the DB for that public key.
+	if lowestProposalExists && signingRootIsDifferent && lowestSignedProposalSlot >= slot {
+		return fmt.Errorf(
+			"could not sign block with slot <= lowest signed slot in db, lowest signed slot: %d >= block slot: %d",
+			lowestSignedProposalSlot,
+			slot,
+		)
+	}
+	if err := p.db.SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot[:]); err != nil {
+		return errors.Wrap(err, "failed to save updated proposal history")
+	}
+	return nil
+}
+
+func (p *slashingProtection) checkAttestation(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, data *ethpb.AttestationData, signingRoot [32]byte,
+) error {
+	if p.db == nil {
+		return errNoSlashingProtection
+	}
+	if data == nil || data.Source == nil || data.Target == nil {
+		return errors.New("invalid attestation data")
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// Based on EIP3076, refuse to sign any attestation with source epoch less
+	// than the minimum source epoch present in that signer’s attestations.
+	lowestSourceEpoch, exists, err := p.db.LowestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	if exists && data.Source.Epoch < lowestSourceEpoch {
+		return fmt.Errorf(
+			"could not sign attestation lower than lowest source epoch in db, %d < %d",
+			data.Source.Epoch,
+			lowestSourceEpoch,
+		)
+	}
+	existingSigningRoot, err := p.db.SigningRootAtTargetEpoch(ctx, pubKey, data.Target.Epoch)
+	if err != nil {
+		return err
+	}
+	// Based on EIP3076, refuse to sign any attestation with target epoch less
+	// than or equal to the minimum target epoch present in that signer’s attestations.
+	lowestTargetEpoch, exists, err := p.db.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	if slashings.SigningRootsDiffer(existingSigningRoot, signingRoot) && exists && data.Target.Epoch <= lowestTargetEpoch {
+		return fmt.Errorf(
+			"could not sign attestation lower than or equal to lowest target epoch in db, %d <= %d",
+			data.Target.Epoch,
+			lowestTargetEpoch,
+		)
+	}
+	indexedAtt := &ethpb.IndexedAttestation{Data: data}
+	if _, err := p.db.CheckSlashableAttestation(ctx, pubKey, signingRoot, indexedAtt); err != nil {
+		return errors.Wrap(err, errSlashableAttestation.Error())
+	}
+	if err := p.db.SaveAttestationForPubKey(ctx, pubKey, signingRoot, indexedAtt); err != nil {
+		return errors.Wrap(err, "could not save attestation history for validator public key")
+	}
+	return nil
+}
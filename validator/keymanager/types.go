@@ -104,6 +104,8 @@ const (
 	Remote
 	// Web3Signer keymanager capable of signing data using a remote signer called Web3Signer.
 	Web3Signer
+	// Threshold keymanager combining signature shares of keys split among several remote signers.
+	Threshold
 )
 
 // IncorrectPasswordErrMsg defines a common error string representing an EIP-2335
@@ -125,6 +127,8 @@ func (k Kind) String() string {
 		return "remote"
 	case Web3Signer:
 		return "web3signer"
+	case Threshold:
+		return "threshold"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -141,6 +145,8 @@ func ParseKind(k string) (Kind, error) {
 		return Remote, nil
 	case "web3signer":
 		return Web3Signer, nil
+	case "threshold":
+		return Threshold, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
@@ -431,8 +431,11 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		GraffitiStruct:             gStruct,
 		Web3SignerConfig:           wsc,
 		ProposerSettings:           bpc,
+		ProposerSettingsReload:     proposerSettingsReload(c.cliCtx),
 		BeaconApiTimeout:           time.Second * 30,
 		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		BeaconNodeFailover:         c.cliCtx.Bool(flags.EnableBeaconNodeFailoverFlag.Name),
+		HealthCheckInterval:        c.cliCtx.Duration(flags.BeaconNodeHealthCheckIntervalFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -529,6 +532,57 @@ func proposerSettings(cliCtx *cli.Context) (*validatorServiceConfig.ProposerSett
 	if fileConfig == nil {
 		return nil, nil
 	}
+	return proposerSettingsFromPayload(cliCtx, fileConfig)
+}
+
+// proposerSettingsReload returns how the proposer settings are reloaded, or nil if they are not.
+func proposerSettingsReload(cliCtx *cli.Context) *client.ProposerSettingsReload {
+	if !cliCtx.Bool(flags.ProposerSettingsReloadFlag.Name) {
+		return nil
+	}
+	r := &client.ProposerSettingsReload{
+		Load: func() (*validatorServiceConfig.ProposerSettings, error) {
+			return proposerSettings(cliCtx)
+		},
+	}
+	switch {
+	case cliCtx.IsSet(flags.ProposerSettingsFlag.Name):
+		r.File = cliCtx.String(flags.ProposerSettingsFlag.Name)
+	case cliCtx.IsSet(flags.ProposerSettingsURLFlag.Name):
+		r.PollInterval = cliCtx.Duration(flags.ProposerSettingsURLPollIntervalFlag.Name)
+		if r.PollInterval <= 0 {
+			log.Warnf("%s must be positive, proposer settings will not be reloaded", flags.ProposerSettingsURLPollIntervalFlag.Name)
+			return nil
+		}
+	default:
+		log.Warnf("%s is set without %s or %s, proposer settings will not be reloaded",
+			flags.ProposerSettingsReloadFlag.Name, flags.ProposerSettingsFlag.Name, flags.ProposerSettingsURLFlag.Name)
+		return nil
+	}
+	return r
+}
+
+// LoadProposerSettings reads and validates the proposer settings in the file at the given path, or at the given
+// URL if it is one. Proposer options without a builder configuration use the one of the builder flags.
+func LoadProposerSettings(cliCtx *cli.Context, from string) (*validatorServiceConfig.ProposerSettings, error) {
+	var fileConfig *validatorServiceConfig.ProposerSettingsPayload
+	if strings.HasPrefix(from, "http://") || strings.HasPrefix(from, "https://") {
+		if err := unmarshalFromURL(cliCtx.Context, from, &fileConfig); err != nil {
+			return nil, err
+		}
+	} else if err := unmarshalFromFile(cliCtx.Context, from, &fileConfig); err != nil {
+		return nil, err
+	}
+	if fileConfig == nil {
+		return nil, fmt.Errorf("no proposer settings in %s", from)
+	}
+	return proposerSettingsFromPayload(cliCtx, fileConfig)
+}
+
+// proposerSettingsFromPayload validates the proposer settings payload and converts it to proposer settings.
+func proposerSettingsFromPayload(
+	cliCtx *cli.Context, fileConfig *validatorServiceConfig.ProposerSettingsPayload,
+) (*validatorServiceConfig.ProposerSettings, error) {
 	// convert file config to proposer config for internal use
 	vpSettings := &validatorServiceConfig.ProposerSettings{}
 
@@ -556,6 +610,9 @@ func proposerSettings(cliCtx *cli.Context) (*validatorServiceConfig.ProposerSett
 
 	if vpSettings.DefaultConfig.BuilderConfig != nil {
 		vpSettings.DefaultConfig.BuilderConfig.GasLimit = reviewGasLimit(vpSettings.DefaultConfig.BuilderConfig.GasLimit)
+		if err := validateGasLimit(vpSettings.DefaultConfig.BuilderConfig.GasLimit); err != nil {
+			return nil, errors.Wrap(err, "invalid default fileConfig gas limit")
+		}
 	}
 
 	if fileConfig.ProposerConfig != nil {
@@ -579,6 +636,9 @@ func proposerSettings(cliCtx *cli.Context) (*validatorServiceConfig.ProposerSett
 			}
 			if option.BuilderConfig != nil {
 				option.BuilderConfig.GasLimit = reviewGasLimit(option.BuilderConfig.GasLimit)
+				if err := validateGasLimit(option.BuilderConfig.GasLimit); err != nil {
+					return nil, errors.Wrapf(err, "invalid gas limit for proposer %s", key)
+				}
 			} else {
 				builderConfig, err := BuilderSettingsFromFlags(cliCtx)
 				if err != nil {
@@ -640,6 +700,16 @@ func reviewGasLimit(gasLimit validatorServiceConfig.Uint64) validatorServiceConf
 	return gasLimit
 }
 
+// minGasLimit is the lowest gas limit of an execution block.
+const minGasLimit = 5000
+
+func validateGasLimit(gasLimit validatorServiceConfig.Uint64) error {
+	if gasLimit < minGasLimit {
+		return fmt.Errorf("gas limit %d is lower than the minimum gas limit %d", gasLimit, minGasLimit)
+	}
+	return nil
+}
+
 func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
 	var vs *client.ValidatorService
 	if err := c.services.FetchService(&vs); err != nil {
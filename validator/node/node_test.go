@@ -602,6 +602,20 @@ func TestProposerSettings(t *testing.T) {
 			},
 			wantErr: "failed to unmarshal yaml file",
 		},
+		{
+			name: "Bad Gas Limit, lower than the minimum",
+			args: args{
+				proposerSettingsFlagValues: &proposerSettingsFlag{
+					dir:        "./testdata/bad-low-gas-limit-proposer-settings.json",
+					url:        "",
+					defaultfee: "",
+				},
+			},
+			want: func() *validatorserviceconfig.ProposerSettings {
+				return nil
+			},
+			wantErr: "invalid gas limit for proposer",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -652,3 +666,30 @@ func TestProposerSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadProposerSettings(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	fromFile, err := LoadProposerSettings(cliCtx, "./testdata/good-prepare-beacon-proposer-config-multiple.json")
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(fromFile.ProposeConfig))
+	assert.Equal(t, validatorserviceconfig.Uint64(40000000), fromFile.DefaultConfig.BuilderConfig.GasLimit)
+
+	content, err := os.ReadFile("./testdata/good-prepare-beacon-proposer-config-multiple.json")
+	require.NoError(t, err)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, err := fmt.Fprintf(w, "%s", content)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	fromURL, err := LoadProposerSettings(cliCtx, srv.URL)
+	require.NoError(t, err)
+	require.DeepEqual(t, fromFile, fromURL)
+
+	_, err = LoadProposerSettings(cliCtx, "./testdata/bad-low-gas-limit-proposer-settings.json")
+	require.ErrorContains(t, "lower than the minimum gas limit", err)
+}
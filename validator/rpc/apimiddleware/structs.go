@@ -96,3 +96,30 @@ type SetGasLimitRequestJson struct {
 type DeleteGasLimitRequestJson struct {
 	Pubkey string `json:"pubkey" hex:"true"`
 }
+
+type GraffitiJson struct {
+	Pubkey   string `json:"pubkey" hex:"true"`
+	Graffiti string `json:"graffiti"`
+}
+
+type GetGraffitiResponseJson struct {
+	Data *GraffitiJson `json:"data"`
+}
+
+type SetGraffitiRequestJson struct {
+	Graffiti string `json:"graffiti"`
+}
+
+type VoluntaryExitJson struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+type SignedVoluntaryExitJson struct {
+	Exit      *VoluntaryExitJson `json:"message"`
+	Signature string             `json:"signature" hex:"true"`
+}
+
+type SetVoluntaryExitResponseJson struct {
+	Data *SignedVoluntaryExitJson `json:"data"`
+}
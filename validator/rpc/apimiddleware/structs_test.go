@@ -7,6 +7,7 @@ import (
 
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 )
 
@@ -228,6 +229,62 @@ func TestDeleteRemoteKeys_JSONisEqual(t *testing.T) {
 	require.Equal(t, resp, true)
 }
 
+func TestGraffiti_JSONisEqual(t *testing.T) {
+	middlewareResponse := &GetGraffitiResponseJson{
+		Data: &GraffitiJson{
+			Pubkey:   "0x0",
+			Graffiti: "a",
+		},
+	}
+	protoResponse := &service.GetGraffitiResponse{
+		Data: &service.GetGraffitiResponse_Graffiti{
+			Pubkey:   make([]byte, fieldparams.BLSPubkeyLength),
+			Graffiti: "a",
+		},
+	}
+
+	getResp, err := areJsonPropertyNamesEqual(middlewareResponse, protoResponse)
+	require.NoError(t, err)
+	require.Equal(t, getResp, true)
+
+	resp, err := areJsonPropertyNamesEqual(middlewareResponse.Data, protoResponse.Data)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+}
+
+func TestSetVoluntaryExit_JSONisEqual(t *testing.T) {
+	middlewareResponse := &SetVoluntaryExitResponseJson{
+		Data: &SignedVoluntaryExitJson{
+			Exit: &VoluntaryExitJson{
+				Epoch:          "1",
+				ValidatorIndex: "1",
+			},
+			Signature: "0x0",
+		},
+	}
+	protoResponse := &service.SetVoluntaryExitResponse{
+		Data: &ethpbv1.SignedVoluntaryExit{
+			Message: &ethpbv1.VoluntaryExit{
+				Epoch:          1,
+				ValidatorIndex: 1,
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+	}
+
+	exitResp, err := areJsonPropertyNamesEqual(middlewareResponse, protoResponse)
+	require.NoError(t, err)
+	require.Equal(t, exitResp, true)
+
+	signedResp, err := areJsonPropertyNamesEqual(middlewareResponse.Data, protoResponse.Data)
+	require.NoError(t, err)
+	require.Equal(t, signedResp, true)
+
+	resp, err := areJsonPropertyNamesEqual(middlewareResponse.Data.Exit, protoResponse.Data.Message)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+}
+
 // note: this does not do a deep comparison of the structs
 func areJsonPropertyNamesEqual(internal, proto interface{}) (bool, error) {
 	internalJSON, err := json.Marshal(internal)
@@ -14,7 +14,9 @@ import (
 	validatorServiceConfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/v3/proto/migration"
 	eth "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/validator/client"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
 	slashingprotection "github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history"
@@ -25,7 +27,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-const nonExistantPublicKey = "0x0"
+const (
+	nonExistantPublicKey = "0x0"
+	maxGraffitiLength    = 32
+)
 
 // ListKeystores implements the standard validator key management API.
 func (s *Server) ListKeystores(
@@ -657,6 +662,108 @@ func (s *Server) DeleteFeeRecipientByPubkey(ctx context.Context, req *ethpbservi
 	return &empty.Empty{}, nil
 }
 
+// GetGraffiti returns the graffiti used by the validator when proposing blocks, resolved with the same priority
+// as the proposals: the graffiti set through the keymanager API, then the graffiti flag, then the graffiti file.
+func (s *Server) GetGraffiti(ctx context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetGraffitiResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	g, err := s.validatorService.Graffiti(ctx, bytesutil.ToBytes48(validatorKey))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get graffiti: %v", err)
+	}
+	return &ethpbservice.GetGraffitiResponse{
+		Data: &ethpbservice.GetGraffitiResponse_Graffiti{
+			Pubkey:   validatorKey,
+			Graffiti: string(g),
+		},
+	}, nil
+}
+
+// SetGraffiti persists the graffiti for the specific public key, overriding the graffiti file settings.
+func (s *Server) SetGraffiti(ctx context.Context, req *ethpbservice.SetGraffitiRequest) (*empty.Empty, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if len(req.Graffiti) > maxGraffitiLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Graffiti cannot be longer than %d bytes", maxGraffitiLength)
+	}
+	if err := s.valDB.SaveGraffitiForPubKey(ctx, bytesutil.ToBytes48(validatorKey), []byte(req.Graffiti)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save graffiti: %v", err)
+	}
+	// override the 200 success with 202 according to the specs
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "202")); err != nil {
+		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteGraffiti removes the graffiti set for the specific public key, falling back to the graffiti file settings.
+func (s *Server) DeleteGraffiti(ctx context.Context, req *ethpbservice.PubkeyRequest) (*empty.Empty, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err := s.valDB.DeleteGraffitiForPubKey(ctx, bytesutil.ToBytes48(validatorKey)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete graffiti: %v", err)
+	}
+	// override the 200 success with 204 according to the specs
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")); err != nil {
+		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetVoluntaryExit signs a voluntary exit for the specific public key with the active keymanager.
+// The signed exit is returned without being broadcast to the beacon node.
+func (s *Server) SetVoluntaryExit(ctx context.Context, req *ethpbservice.SetVoluntaryExitRequest) (*ethpbservice.SetVoluntaryExitResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get keymanager (possibly due to beacon node unavailable): %v", err)
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve public keys: %v", err)
+	}
+	found := false
+	for _, pk := range pubKeys {
+		if bytes.Equal(pk[:], validatorKey) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "No validator key found for pubkey: %q", hexutil.Encode(validatorKey))
+	}
+	signedExit, err := client.CreateSignedVoluntaryExit(
+		ctx, s.beaconNodeValidatorClient, s.beaconNodeClient, km.Sign, validatorKey, req.Epoch,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not create signed voluntary exit: %v", err)
+	}
+	return &ethpbservice.SetVoluntaryExitResponse{
+		Data: migration.V1Alpha1ExitToV1(signedExit),
+	}, nil
+}
+
 func validatePublicKey(pubkey []byte) error {
 	if len(pubkey) != fieldparams.BLSPubkeyLength {
 		return status.Errorf(
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -13,9 +14,11 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
@@ -30,6 +33,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v3/validator/client"
 	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-web3signer"
@@ -38,6 +42,7 @@ import (
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestServer_ListKeystores(t *testing.T) {
@@ -1310,3 +1315,109 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_Graffiti(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	pubkey, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator: &mock.MockValidator{GraffitiRet: []byte("file graffiti")},
+	})
+	require.NoError(t, err)
+	s := &Server{
+		validatorService: vs,
+		valDB:            dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(pubkey)}),
+	}
+
+	// The graffiti is resolved by the validator, as for its proposals.
+	resp, err := s.GetGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubkey})
+	require.NoError(t, err)
+	require.DeepEqual(t, pubkey, resp.Data.Pubkey)
+	require.Equal(t, "file graffiti", resp.Data.Graffiti)
+
+	_, err = s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: pubkey, Graffiti: "api graffiti"})
+	require.NoError(t, err)
+	g, err := s.valDB.GraffitiForPubKey(ctx, bytesutil.ToBytes48(pubkey))
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("api graffiti"), g)
+
+	_, err = s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: pubkey, Graffiti: "this graffiti is longer than thirty two bytes"})
+	require.ErrorContains(t, "Graffiti cannot be longer than 32 bytes", err)
+
+	_, err = s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: []byte{0x01}, Graffiti: "api graffiti"})
+	require.ErrorContains(t, "not a valid bls public key", err)
+
+	_, err = s.DeleteGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubkey})
+	require.NoError(t, err)
+	g, err = s.valDB.GraffitiForPubKey(ctx, bytesutil.ToBytes48(pubkey))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(g))
+}
+
+func TestServer_SetVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock2.NewMockValidatorClient(ctrl)
+	nodeClient := mock2.NewMockNodeClient(ctrl)
+
+	srv := setupServerWithWallet(t)
+	srv.beaconNodeValidatorClient = beaconClient
+	srv.beaconNodeClient = nodeClient
+	km, err := srv.validatorService.Keymanager()
+	require.NoError(t, err)
+	dr, ok := km.(*derived.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, dr.RecoverAccountsFromMnemonic(ctx, mocks.TestMnemonic, "", "", 1))
+	pubKeys, err := dr.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	pubKey := pubKeys[0][:]
+
+	t.Run("unknown public key", func(t *testing.T) {
+		unknown := make([]byte, fieldparams.BLSPubkeyLength)
+		_, err := srv.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: unknown})
+		require.ErrorContains(t, "No validator key found for pubkey", err)
+	})
+	t.Run("signs exit without broadcasting", func(t *testing.T) {
+		epoch := types.Epoch(10)
+		beaconClient.EXPECT().ValidatorIndex(gomock.Any(), &eth.ValidatorIndexRequest{PublicKey: pubKey}).
+			Return(&eth.ValidatorIndexResponse{Index: 5}, nil)
+		nodeClient.EXPECT().GetGenesis(gomock.Any(), gomock.Any()).
+			Return(&eth.Genesis{GenesisTime: timestamppb.Now()}, nil)
+		domain := make([]byte, 32)
+		beaconClient.EXPECT().DomainData(gomock.Any(), &eth.DomainRequest{
+			Epoch:  epoch,
+			Domain: params.BeaconConfig().DomainVoluntaryExit[:],
+		}).Return(&eth.DomainResponse{SignatureDomain: domain}, nil)
+		beaconClient.EXPECT().ProposeExit(gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := srv.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: pubKey, Epoch: &epoch})
+		require.NoError(t, err)
+		require.Equal(t, epoch, resp.Data.Message.Epoch)
+		require.Equal(t, types.ValidatorIndex(5), resp.Data.Message.ValidatorIndex)
+
+		root, err := signing.ComputeSigningRoot(&eth.VoluntaryExit{Epoch: epoch, ValidatorIndex: 5}, domain)
+		require.NoError(t, err)
+		sig, err := bls.SignatureFromBytes(resp.Data.Signature)
+		require.NoError(t, err)
+		pk, err := bls.PublicKeyFromBytes(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, true, sig.Verify(pk, root[:]))
+	})
+	t.Run("explicit genesis epoch", func(t *testing.T) {
+		epoch := types.Epoch(0)
+		genesis := time.Now().Add(-time.Duration(10*uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+		beaconClient.EXPECT().ValidatorIndex(gomock.Any(), &eth.ValidatorIndexRequest{PublicKey: pubKey}).
+			Return(&eth.ValidatorIndexResponse{Index: 5}, nil)
+		nodeClient.EXPECT().GetGenesis(gomock.Any(), gomock.Any()).
+			Return(&eth.Genesis{GenesisTime: timestamppb.New(genesis)}, nil)
+		beaconClient.EXPECT().DomainData(gomock.Any(), &eth.DomainRequest{
+			Epoch:  epoch,
+			Domain: params.BeaconConfig().DomainVoluntaryExit[:],
+		}).Return(&eth.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
+
+		resp, err := srv.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: pubKey, Epoch: &epoch})
+		require.NoError(t, err)
+		require.Equal(t, epoch, resp.Data.Message.Epoch)
+	})
+}
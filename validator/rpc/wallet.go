@@ -49,7 +49,7 @@ func (s *Server) CreateWallet(ctx context.Context, req *pb.CreateWalletRequest)
 		switch s.wallet.KeymanagerKind() {
 		case keymanager.Derived:
 			keymanagerKind = pb.KeymanagerKind_DERIVED
-		case keymanager.Remote:
+		case keymanager.Remote, keymanager.Threshold:
 			keymanagerKind = pb.KeymanagerKind_REMOTE
 		case keymanager.Web3Signer:
 			keymanagerKind = pb.KeymanagerKind_WEB3SIGNER
@@ -130,7 +130,7 @@ func (s *Server) WalletConfig(_ context.Context, _ *empty.Empty) (*pb.WalletResp
 		keymanagerKind = pb.KeymanagerKind_DERIVED
 	case keymanager.Local:
 		keymanagerKind = pb.KeymanagerKind_IMPORTED
-	case keymanager.Remote:
+	case keymanager.Remote, keymanager.Threshold:
 		keymanagerKind = pb.KeymanagerKind_REMOTE
 	case keymanager.Web3Signer:
 		keymanagerKind = pb.KeymanagerKind_WEB3SIGNER
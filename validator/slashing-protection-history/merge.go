@@ -0,0 +1,389 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/slashings"
+	"github.com/prysmaticlabs/prysm/v3/validator/db"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
+)
+
+// MergeMode defines which part of an EIP-3076 interchange is merged into the slashing protection
+// history of the validator database, following the minimal and complete interchange formats of the EIP.
+type MergeMode string
+
+const (
+	// MinimalMerge only saves the signed block with the highest slot and the signed attestations with
+	// the highest source and target epochs of each validator, as the validator refuses to sign below them.
+	MinimalMerge MergeMode = "minimal"
+	// CompleteMerge saves every signed block and attestation of the interchange.
+	CompleteMerge MergeMode = "complete"
+)
+
+// MergeReport describes the outcome of merging an EIP-3076 interchange into the slashing protection
+// history of the validator database.
+type MergeReport struct {
+	Mode       MergeMode               `json:"mode"`
+	Validators []*ValidatorMergeReport `json:"validators"`
+}
+
+// ValidatorMergeReport describes the outcome of the merge for a validator public key.
+type ValidatorMergeReport struct {
+	Pubkey string `json:"pubkey"`
+	// Merged is false if the incoming history of the validator conflicts with its existing history. None of
+	// it is then saved, and the public key is blacklisted from signing as with a slashable import.
+	Merged            bool `json:"merged"`
+	SavedBlocks       int  `json:"saved_blocks"`
+	SavedAttestations int  `json:"saved_attestations"`
+	// DuplicateBlocks and DuplicateAttestations are the incoming records already in the history. A block at
+	// the same slot, or an attestation with the same source and target epochs, as a record of the history
+	// is taken as a duplicate when either signing root is unknown.
+	DuplicateBlocks       int                `json:"duplicate_blocks"`
+	DuplicateAttestations int                `json:"duplicate_attestations"`
+	DoubleProposals       []*DoubleProposal  `json:"double_proposals,omitempty"`
+	DoubleVotes           []*DoubleVote      `json:"double_votes,omitempty"`
+	SurroundingVotes      []*SurroundingVote `json:"surrounding_votes,omitempty"`
+	// LostBlocks and LostAttestations are the incoming records left out by a minimal merge which the
+	// validator does not refuse to sign again, as they are above its lowest signed slot, or not below its
+	// lowest signed source epoch and above its lowest signed target epoch.
+	LostBlocks       []*format.SignedBlock       `json:"lost_blocks,omitempty"`
+	LostAttestations []*format.SignedAttestation `json:"lost_attestations,omitempty"`
+}
+
+// DoubleProposal is an incoming signed block at the same slot as a block of the history, which is
+// either in the database or earlier in the interchange, with a different known signing root.
+type DoubleProposal struct {
+	Existing *format.SignedBlock `json:"existing"`
+	Incoming *format.SignedBlock `json:"incoming"`
+}
+
+// DoubleVote is an incoming signed attestation with the same target epoch as an attestation of the
+// history with either a different source epoch or a different known signing root.
+type DoubleVote struct {
+	Existing *format.SignedAttestation `json:"existing"`
+	Incoming *format.SignedAttestation `json:"incoming"`
+}
+
+// SurroundingVote is an incoming signed attestation which surrounds, or is surrounded by, an
+// attestation of the history.
+type SurroundingVote struct {
+	Existing          *format.SignedAttestation `json:"existing"`
+	Incoming          *format.SignedAttestation `json:"incoming"`
+	IncomingSurrounds bool                      `json:"incoming_surrounds"`
+}
+
+// Conflicts returns true if the incoming history of the validator conflicts with its existing history.
+func (r *ValidatorMergeReport) Conflicts() bool {
+	return len(r.DoubleProposals) > 0 || len(r.DoubleVotes) > 0 || len(r.SurroundingVotes) > 0
+}
+
+type validatorMerge struct {
+	pubKey    [fieldparams.BLSPubkeyLength]byte
+	report    *ValidatorMergeReport
+	proposals []kv.Proposal
+	atts      []*kv.AttestationRecord
+}
+
+// MergeStandardProtectionJSON merges an EIP-3076 compliant slashing protection JSON file into the
+// existing slashing protection history of the validator database, unlike ImportStandardProtectionJSON
+// which does not compare the file with the database. Each incoming signed block and attestation is
+// checked against the history in the database and the records of the file before it, and the history
+// of a validator is only merged if none of them conflict. The returned report lists the conflicts and,
+// for a minimal merge, the records which are lost to minification.
+func MergeStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader, mode MergeMode) (*MergeReport, error) {
+	if mode != MinimalMerge && mode != CompleteMerge {
+		return nil, fmt.Errorf("merge mode %q is not supported, wanted %q or %q", mode, MinimalMerge, CompleteMerge)
+	}
+	encodedJSON, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read slashing protection JSON file")
+	}
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+	}
+	report := &MergeReport{Mode: mode, Validators: make([]*ValidatorMergeReport, 0)}
+	if interchangeJSON.Data == nil {
+		log.Warn("No slashing protection data to merge")
+		return report, nil
+	}
+	if err := validateMetadata(ctx, validatorDB, interchangeJSON); err != nil {
+		return nil, errors.Wrap(err, "slashing protection JSON metadata was incorrect")
+	}
+
+	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(interchangeJSON.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for blocks by public key")
+	}
+	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(interchangeJSON.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(interchangeJSON.Data))
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(interchangeJSON.Data))
+	for _, validatorData := range interchangeJSON.Data {
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
+		}
+		if !seen[pubKey] {
+			seen[pubKey] = true
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return string(pubKeys[i][:]) < string(pubKeys[j][:])
+	})
+
+	merges := make([]*validatorMerge, 0, len(pubKeys))
+	conflictingPubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for _, pubKey := range pubKeys {
+		proposalHistory, err := transformSignedBlocks(ctx, signedBlocksByPubKey[pubKey])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+		}
+		atts, err := transformSignedAttestations(pubKey, signedAttsByPubKey[pubKey])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+		}
+		m, err := mergeValidatorHistory(ctx, validatorDB, pubKey, proposalHistory.Proposals, atts, mode)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not merge slashing protection history for key %#x", pubKey)
+		}
+		if !m.report.Merged {
+			conflictingPubKeys = append(conflictingPubKeys, pubKey)
+		}
+		report.Validators = append(report.Validators, m.report)
+		merges = append(merges, m)
+	}
+
+	// As with an import, nothing is saved until the whole JSON file is parsed and compared with the database.
+	if err := validatorDB.SaveEIPImportBlacklistedPublicKeys(ctx, conflictingPubKeys); err != nil {
+		return nil, errors.Wrap(err, "could not save slashable public keys to database")
+	}
+	for _, m := range merges {
+		for _, proposal := range m.proposals {
+			if err := validatorDB.SaveProposalHistoryForSlot(ctx, m.pubKey, proposal.Slot, proposal.SigningRoot); err != nil {
+				return nil, errors.Wrap(err, "could not save proposal history from merged JSON to database")
+			}
+		}
+		if len(m.atts) == 0 {
+			continue
+		}
+		indexedAtts := make([]*ethpb.IndexedAttestation, len(m.atts))
+		signingRoots := make([][32]byte, len(m.atts))
+		for i, att := range m.atts {
+			indexedAtts[i] = createAttestation(att.Source, att.Target)
+			signingRoots[i] = att.SigningRoot
+		}
+		if err := validatorDB.SaveAttestationsForPubKey(ctx, m.pubKey, signingRoots, indexedAtts); err != nil {
+			return nil, errors.Wrap(err, "could not save attestations from merged JSON to database")
+		}
+	}
+	return report, nil
+}
+
+// mergeValidatorHistory compares the incoming signed blocks and attestations of a validator with its
+// history, and returns the records to save if none of them conflict.
+func mergeValidatorHistory(
+	ctx context.Context,
+	validatorDB db.Database,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	incomingProposals []kv.Proposal,
+	incomingAtts []*kv.AttestationRecord,
+	mode MergeMode,
+) (*validatorMerge, error) {
+	m := &validatorMerge{
+		pubKey: pubKey,
+		report: &ValidatorMergeReport{Pubkey: fmt.Sprintf("%#x", pubKey)},
+	}
+	rep := m.report
+
+	existingProposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposal history")
+	}
+	signingRootsBySlot := make(map[types.Slot][32]byte, len(existingProposals)+len(incomingProposals))
+	for _, p := range existingProposals {
+		signingRootsBySlot[p.Slot] = bytesutil.ToBytes32(p.SigningRoot)
+	}
+	for _, p := range incomingProposals {
+		signingRoot := bytesutil.ToBytes32(p.SigningRoot)
+		existing, ok := signingRootsBySlot[p.Slot]
+		switch {
+		case !ok:
+			signingRootsBySlot[p.Slot] = signingRoot
+			m.proposals = append(m.proposals, p)
+		case mayBeSameRecord(existing, signingRoot):
+			rep.DuplicateBlocks++
+		default:
+			rep.DoubleProposals = append(rep.DoubleProposals, &DoubleProposal{
+				Existing: signedBlock(p.Slot, existing),
+				Incoming: signedBlock(p.Slot, signingRoot),
+			})
+		}
+	}
+
+	history, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation history")
+	}
+	for _, att := range incomingAtts {
+		duplicate, conflicts := false, false
+		incoming := createAttestation(att.Source, att.Target)
+		for _, h := range history {
+			if h.Target == att.Target {
+				// Only the very same attestation may be signed twice for a target epoch.
+				if h.Source == att.Source && mayBeSameRecord(h.SigningRoot, att.SigningRoot) {
+					duplicate = true
+				} else {
+					rep.DoubleVotes = append(rep.DoubleVotes, &DoubleVote{
+						Existing: signedAttestation(h),
+						Incoming: signedAttestation(att),
+					})
+					conflicts = true
+				}
+				continue
+			}
+			existing := createAttestation(h.Source, h.Target)
+			if surrounds := slashings.IsSurround(incoming, existing); surrounds || slashings.IsSurround(existing, incoming) {
+				rep.SurroundingVotes = append(rep.SurroundingVotes, &SurroundingVote{
+					Existing:          signedAttestation(h),
+					Incoming:          signedAttestation(att),
+					IncomingSurrounds: surrounds,
+				})
+				conflicts = true
+			}
+		}
+		if duplicate {
+			rep.DuplicateAttestations++
+		} else if !conflicts {
+			history = append(history, att)
+			m.atts = append(m.atts, att)
+		}
+	}
+
+	if rep.Conflicts() {
+		m.proposals, m.atts = nil, nil
+		return m, nil
+	}
+	rep.Merged = true
+	if mode == MinimalMerge {
+		if err := minimize(ctx, validatorDB, m); err != nil {
+			return nil, err
+		}
+	}
+	rep.SavedBlocks = len(m.proposals)
+	rep.SavedAttestations = len(m.atts)
+	return m, nil
+}
+
+// minimize only keeps the signed block with the highest slot and the signed attestations with the
+// highest source and target epochs to save, as in the minimal interchange format of EIP-3076, and
+// reports the records left out which the validator would not refuse to sign once the rest is saved.
+func minimize(ctx context.Context, validatorDB db.Database, m *validatorMerge) error {
+	if len(m.proposals) > 0 {
+		highest := m.proposals[0]
+		for _, p := range m.proposals[1:] {
+			if p.Slot > highest.Slot {
+				highest = p
+			}
+		}
+		// The validator refuses to sign blocks at or below its lowest signed slot.
+		lowestSlot, exists, err := validatorDB.LowestSignedProposal(ctx, m.pubKey)
+		if err != nil {
+			return errors.Wrap(err, "could not get lowest signed proposal")
+		}
+		if !exists || highest.Slot < lowestSlot {
+			lowestSlot = highest.Slot
+		}
+		for _, p := range m.proposals {
+			if p.Slot != highest.Slot && p.Slot > lowestSlot {
+				m.report.LostBlocks = append(m.report.LostBlocks, signedBlock(p.Slot, bytesutil.ToBytes32(p.SigningRoot)))
+			}
+		}
+		m.proposals = []kv.Proposal{highest}
+	}
+
+	if len(m.atts) > 0 {
+		highestSource, highestTarget := m.atts[0], m.atts[0]
+		for _, att := range m.atts[1:] {
+			if att.Source > highestSource.Source {
+				highestSource = att
+			}
+			if att.Target > highestTarget.Target {
+				highestTarget = att
+			}
+		}
+		kept := []*kv.AttestationRecord{highestTarget}
+		if highestSource != highestTarget {
+			kept = append(kept, highestSource)
+		}
+		// The validator refuses to sign attestations with a source epoch below its lowest signed source
+		// epoch, or a target epoch at or below its lowest signed target epoch.
+		lowestSource, sourceExists, err := validatorDB.LowestSignedSourceEpoch(ctx, m.pubKey)
+		if err != nil {
+			return errors.Wrap(err, "could not get lowest signed source epoch")
+		}
+		lowestTarget, targetExists, err := validatorDB.LowestSignedTargetEpoch(ctx, m.pubKey)
+		if err != nil {
+			return errors.Wrap(err, "could not get lowest signed target epoch")
+		}
+		for _, att := range kept {
+			if !sourceExists || att.Source < lowestSource {
+				lowestSource, sourceExists = att.Source, true
+			}
+			if !targetExists || att.Target < lowestTarget {
+				lowestTarget, targetExists = att.Target, true
+			}
+		}
+		for _, att := range m.atts {
+			if att == highestSource || att == highestTarget {
+				continue
+			}
+			if att.Source >= lowestSource && att.Target > lowestTarget {
+				m.report.LostAttestations = append(m.report.LostAttestations, signedAttestation(att))
+			}
+		}
+		m.atts = kept
+	}
+	return nil
+}
+
+// mayBeSameRecord returns true if two blocks at the same slot, or two attestations with the same source
+// and target epochs, may be the very same record. Without both signing roots this can not be ruled out,
+// so the records are then taken as duplicates rather than conflicts.
+func mayBeSameRecord(existingSigningRoot, incomingSigningRoot [32]byte) bool {
+	zeroHash := params.BeaconConfig().ZeroHash
+	return existingSigningRoot == zeroHash || incomingSigningRoot == zeroHash || existingSigningRoot == incomingSigningRoot
+}
+
+func signedBlock(slot types.Slot, signingRoot [32]byte) *format.SignedBlock {
+	b := &format.SignedBlock{Slot: fmt.Sprintf("%d", slot)}
+	if signingRoot != params.BeaconConfig().ZeroHash {
+		b.SigningRoot = fmt.Sprintf("%#x", signingRoot)
+	}
+	return b
+}
+
+func signedAttestation(att *kv.AttestationRecord) *format.SignedAttestation {
+	a := &format.SignedAttestation{
+		SourceEpoch: fmt.Sprintf("%d", att.Source),
+		TargetEpoch: fmt.Sprintf("%d", att.Target),
+	}
+	if att.SigningRoot != params.BeaconConfig().ZeroHash {
+		a.SigningRoot = fmt.Sprintf("%#x", att.SigningRoot)
+	}
+	return a
+}
@@ -0,0 +1,335 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/db"
+	dbtest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
+)
+
+func TestMergeStandardProtectionJSON_BadMode(t *testing.T) {
+	validatorDB := dbtest.SetupDB(t, nil)
+	_, err := MergeStandardProtectionJSON(context.Background(), validatorDB, bytes.NewBuffer([]byte("{}")), "partial")
+	require.ErrorContains(t, "merge mode \"partial\" is not supported", err)
+}
+
+func TestMergeStandardProtectionJSON_Conflicts(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	conflicting, selfConflicting := publicKeys[0], publicKeys[1]
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, conflicting, 10, root(1)))
+	saveAttestations(t, validatorDB, conflicting, [][2]types.Epoch{{2, 3}, {3, 4}})
+
+	interchange := mergeInterchange(t, map[[fieldparams.BLSPubkeyLength]byte]*format.ProtectionData{
+		conflicting: {
+			SignedBlocks: []*format.SignedBlock{{Slot: "10", SigningRoot: fmt.Sprintf("%#x", root(2))}},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "5", SigningRoot: fmt.Sprintf("%#x", root(3))},
+			},
+		},
+		selfConflicting: {
+			SignedBlocks: []*format.SignedBlock{{Slot: "5", SigningRoot: fmt.Sprintf("%#x", root(4))}},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", root(5))},
+				{SourceEpoch: "0", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", root(6))},
+			},
+		},
+	})
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(interchange), CompleteMerge)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(report.Validators))
+	reports := make(map[string]*ValidatorMergeReport)
+	for _, v := range report.Validators {
+		reports[v.Pubkey] = v
+	}
+
+	c := reports[fmt.Sprintf("%#x", conflicting)]
+	require.NotNil(t, c)
+	assert.Equal(t, false, c.Merged)
+	require.Equal(t, 1, len(c.DoubleProposals))
+	assert.Equal(t, fmt.Sprintf("%#x", root(1)), c.DoubleProposals[0].Existing.SigningRoot)
+	require.Equal(t, 2, len(c.SurroundingVotes))
+	assert.Equal(t, true, c.SurroundingVotes[0].IncomingSurrounds)
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(blacklisted))
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, conflicting)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(proposals))
+
+	// The second attestation of the validator surrounds its first one.
+	m := reports[fmt.Sprintf("%#x", selfConflicting)]
+	require.NotNil(t, m)
+	assert.Equal(t, false, m.Merged)
+	require.Equal(t, 1, len(m.SurroundingVotes))
+	assert.Equal(t, "2", m.SurroundingVotes[0].Existing.TargetEpoch)
+	assert.Equal(t, "3", m.SurroundingVotes[0].Incoming.TargetEpoch)
+}
+
+func TestMergeStandardProtectionJSON_DoubleVotes(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	otherSource, otherRoot := publicKeys[0], publicKeys[1]
+	for _, pubKey := range publicKeys {
+		saveAttestations(t, validatorDB, pubKey, [][2]types.Epoch{{2, 3}})
+	}
+
+	interchange := mergeInterchange(t, map[[fieldparams.BLSPubkeyLength]byte]*format.ProtectionData{
+		// The same signing root for another source is still a double vote.
+		otherSource: {
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", root(3))},
+			},
+		},
+		// An attestation without signing root for another source is a double vote as well.
+		otherRoot: {
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", root(4))},
+				{SourceEpoch: "1", TargetEpoch: "3"},
+			},
+		},
+	})
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(interchange), CompleteMerge)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(report.Validators))
+	for _, v := range report.Validators {
+		assert.Equal(t, false, v.Merged, v.Pubkey)
+		assert.Equal(t, 0, v.DuplicateAttestations, v.Pubkey)
+	}
+	reports := make(map[string]*ValidatorMergeReport)
+	for _, v := range report.Validators {
+		reports[v.Pubkey] = v
+	}
+	assert.Equal(t, 1, len(reports[fmt.Sprintf("%#x", otherSource)].DoubleVotes))
+	assert.Equal(t, 2, len(reports[fmt.Sprintf("%#x", otherRoot)].DoubleVotes))
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(blacklisted))
+}
+
+func TestMergeStandardProtectionJSON_UnknownSigningRoots(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	knownExisting, unknownExisting := publicKeys[0], publicKeys[1]
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, knownExisting, 10, root(1)))
+	saveAttestations(t, validatorDB, knownExisting, [][2]types.Epoch{{2, 3}})
+	zeroRoot := make([]byte, 32)
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, unknownExisting, 10, zeroRoot))
+	require.NoError(t, validatorDB.SaveAttestationsForPubKey(
+		ctx, unknownExisting, [][32]byte{{}}, []*ethpb.IndexedAttestation{createAttestation(2, 3)},
+	))
+
+	interchange := mergeInterchange(t, map[[fieldparams.BLSPubkeyLength]byte]*format.ProtectionData{
+		// Only the incoming signing roots are unknown.
+		knownExisting: {
+			SignedBlocks:       []*format.SignedBlock{{Slot: "10"}},
+			SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "3"}},
+		},
+		// Only the existing signing roots are unknown, except for the second records where both are.
+		unknownExisting: {
+			SignedBlocks: []*format.SignedBlock{
+				{Slot: "10", SigningRoot: fmt.Sprintf("%#x", root(2))},
+				{Slot: "10"},
+			},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", root(3))},
+				{SourceEpoch: "2", TargetEpoch: "3"},
+			},
+		},
+	})
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(interchange), CompleteMerge)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(report.Validators))
+	reports := make(map[string]*ValidatorMergeReport)
+	for _, v := range report.Validators {
+		assert.Equal(t, true, v.Merged, v.Pubkey)
+		assert.Equal(t, false, v.Conflicts(), v.Pubkey)
+		assert.Equal(t, 0, v.SavedBlocks+v.SavedAttestations, v.Pubkey)
+		reports[v.Pubkey] = v
+	}
+	k := reports[fmt.Sprintf("%#x", knownExisting)]
+	assert.Equal(t, 1, k.DuplicateBlocks)
+	assert.Equal(t, 1, k.DuplicateAttestations)
+	u := reports[fmt.Sprintf("%#x", unknownExisting)]
+	assert.Equal(t, 2, u.DuplicateBlocks)
+	assert.Equal(t, 2, u.DuplicateAttestations)
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blacklisted))
+}
+
+func TestMergeStandardProtectionJSON_Complete(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := [][fieldparams.BLSPubkeyLength]byte{{1}}
+	pubKey := publicKeys[0]
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 3, root(1)))
+	saveAttestations(t, validatorDB, pubKey, [][2]types.Epoch{{1, 2}})
+
+	interchange := mergeInterchange(t, map[[fieldparams.BLSPubkeyLength]byte]*format.ProtectionData{
+		pubKey: {
+			SignedBlocks: []*format.SignedBlock{
+				{Slot: "3", SigningRoot: fmt.Sprintf("%#x", root(1))},
+				{Slot: "5", SigningRoot: fmt.Sprintf("%#x", root(2))},
+			},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", root(2))},
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", root(3))},
+			},
+		},
+	})
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(interchange), CompleteMerge)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.Validators))
+	v := report.Validators[0]
+	assert.Equal(t, true, v.Merged)
+	assert.Equal(t, 1, v.SavedBlocks)
+	assert.Equal(t, 1, v.DuplicateBlocks)
+	assert.Equal(t, 1, v.SavedAttestations)
+	assert.Equal(t, 1, v.DuplicateAttestations)
+
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(proposals))
+	atts, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(atts))
+
+	// Merging the same history again only finds duplicates.
+	report, err = MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(interchange), CompleteMerge)
+	require.NoError(t, err)
+	v = report.Validators[0]
+	assert.Equal(t, true, v.Merged)
+	assert.Equal(t, 0, v.SavedBlocks+v.SavedAttestations)
+	assert.Equal(t, 2, v.DuplicateBlocks)
+	assert.Equal(t, 2, v.DuplicateAttestations)
+}
+
+func TestMergeStandardProtectionJSON_Minimal(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	withHistory, withoutHistory := publicKeys[0], publicKeys[1]
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, withHistory, 3, root(1)))
+	saveAttestations(t, validatorDB, withHistory, [][2]types.Epoch{{1, 2}})
+
+	incoming := &format.ProtectionData{
+		SignedBlocks: []*format.SignedBlock{
+			{Slot: "5", SigningRoot: fmt.Sprintf("%#x", root(5))},
+			{Slot: "8", SigningRoot: fmt.Sprintf("%#x", root(8))},
+			{Slot: "12", SigningRoot: fmt.Sprintf("%#x", root(12))},
+		},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", root(3))},
+			{SourceEpoch: "3", TargetEpoch: "4", SigningRoot: fmt.Sprintf("%#x", root(4))},
+			{SourceEpoch: "4", TargetEpoch: "5", SigningRoot: fmt.Sprintf("%#x", root(5))},
+		},
+	}
+	interchange := mergeInterchange(t, map[[fieldparams.BLSPubkeyLength]byte]*format.ProtectionData{
+		withHistory:    incoming,
+		withoutHistory: incoming,
+	})
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(interchange), MinimalMerge)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(report.Validators))
+	for _, v := range report.Validators {
+		assert.Equal(t, true, v.Merged)
+		assert.Equal(t, 1, v.SavedBlocks)
+		assert.Equal(t, 1, v.SavedAttestations)
+		if v.Pubkey == fmt.Sprintf("%#x", withoutHistory) {
+			// The validator refuses to sign below the highest slot and epochs, so nothing is lost.
+			assert.Equal(t, 0, len(v.LostBlocks))
+			assert.Equal(t, 0, len(v.LostAttestations))
+			continue
+		}
+		// The lowest signed slot and epochs of the existing history are below the records left out.
+		assert.DeepEqual(t, []*format.SignedBlock{
+			{Slot: "5", SigningRoot: fmt.Sprintf("%#x", root(5))},
+			{Slot: "8", SigningRoot: fmt.Sprintf("%#x", root(8))},
+		}, v.LostBlocks)
+		assert.Equal(t, 2, len(v.LostAttestations))
+	}
+
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, withHistory)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(proposals))
+	lowest, exists, err := validatorDB.LowestSignedProposal(ctx, withoutHistory)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(12), lowest)
+	lowestTarget, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, withoutHistory)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(5), lowestTarget)
+}
+
+func TestMergeStandardProtectionJSON_MinimalLowerTarget(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := [][fieldparams.BLSPubkeyLength]byte{{1}}
+	pubKey := publicKeys[0]
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	saveAttestations(t, validatorDB, pubKey, [][2]types.Epoch{{2, 5}})
+
+	// The validator refuses to sign the first attestation again as its target epoch is not above the
+	// lowest signed target epoch.
+	interchange := mergeInterchange(t, map[[fieldparams.BLSPubkeyLength]byte]*format.ProtectionData{
+		pubKey: {
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "4", SigningRoot: fmt.Sprintf("%#x", root(4))},
+				{SourceEpoch: "5", TargetEpoch: "6", SigningRoot: fmt.Sprintf("%#x", root(6))},
+			},
+		},
+	})
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(interchange), MinimalMerge)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.Validators))
+	v := report.Validators[0]
+	assert.Equal(t, true, v.Merged)
+	assert.Equal(t, 1, v.SavedAttestations)
+	assert.Equal(t, 0, len(v.LostAttestations))
+}
+
+func mergeInterchange(t *testing.T, dataByPubKey map[[fieldparams.BLSPubkeyLength]byte]*format.ProtectionData) []byte {
+	interchange := &format.EIPSlashingProtectionFormat{}
+	interchange.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+	interchange.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	for pubKey, data := range dataByPubKey {
+		interchange.Data = append(interchange.Data, &format.ProtectionData{
+			Pubkey:             fmt.Sprintf("%#x", pubKey),
+			SignedBlocks:       data.SignedBlocks,
+			SignedAttestations: data.SignedAttestations,
+		})
+	}
+	encoded, err := json.Marshal(interchange)
+	require.NoError(t, err)
+	return encoded
+}
+
+func saveAttestations(t *testing.T, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, sourceTargets [][2]types.Epoch) {
+	signingRoots := make([][32]byte, len(sourceTargets))
+	atts := make([]*ethpb.IndexedAttestation, len(sourceTargets))
+	for i, st := range sourceTargets {
+		signingRoots[i] = bytesutil.ToBytes32(root(byte(st[1])))
+		atts[i] = createAttestation(st[0], st[1])
+	}
+	require.NoError(t, validatorDB.SaveAttestationsForPubKey(context.Background(), pubKey, signingRoots, atts))
+}
+
+func root(b byte) []byte {
+	r := [32]byte{b}
+	return r[:]
+}